@@ -0,0 +1,190 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/bnf"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+// evalValue is a value on the parser's stack when evaluating while parsing: the token of a
+// shifted terminal, or a number.
+type evalValue[T any] struct {
+	token  *tokens.Token
+	number T
+	// literal is the text of a number written as a literal, from which exponents are parsed.
+	literal string
+}
+
+// reduction is how evalHandler computes the value of a production from its right-hand side.
+type reduction int
+
+const (
+	reducePassThrough reduction = iota + 1
+	reduceLiteral
+	reduceParen
+	reduceUnary
+	reduceBinary
+	reduceNegatedExponent
+)
+
+// rhsCount is the number of right-hand-side symbols a production reduced this way has.
+func (r reduction) rhsCount() int {
+	switch r {
+	case reduceUnary:
+		return 2
+	case reduceParen, reduceBinary:
+		return 3
+	case reduceNegatedExponent:
+		return 4
+	default:
+		return 1
+	}
+}
+
+// pemdasProduction names a production by its rule and the index of the alternative within it.
+type pemdasProduction struct {
+	lhs         asts.NodeType
+	alternative int
+}
+
+// pemdasReductions gives the reduction for each alternative of each rule of the PEMDAS grammars.
+// They list their rules' alternatives in the same order, with the float and mod grammars only
+// adding literal kinds.
+var pemdasReductions = map[pemdasProduction]reduction{
+	{"Root", 0}:                 reducePassThrough,
+	{"Rvalue", 0}:               reducePassThrough,
+	{"PrecedenceChainStart", 0}: reducePassThrough,
+	{"AddSubTerm", 0}:           reduceBinary,
+	{"AddSubTerm", 1}:           reduceBinary,
+	{"AddSubTerm", 2}:           reducePassThrough,
+	{"MulDivTerm", 0}:           reduceBinary,
+	{"MulDivTerm", 1}:           reduceBinary,
+	{"MulDivTerm", 2}:           reduceBinary,
+	{"MulDivTerm", 3}:           reducePassThrough,
+	{"UnaryTerm", 0}:            reduceUnary,
+	{"UnaryTerm", 1}:            reduceUnary,
+	{"UnaryTerm", 2}:            reducePassThrough,
+	{"ExponentiationTerm", 0}:   reduceBinary,
+	{"ExponentiationTerm", 1}:   reduceNegatedExponent,
+	{"ExponentiationTerm", 2}:   reducePassThrough,
+	{"ParenTerm", 0}:            reduceParen,
+	{"ParenTerm", 1}:            reducePassThrough,
+	{"PrecedenceChainEnd", 0}:   reduceLiteral,
+	{"PrecedenceChainEnd", 1}:   reduceLiteral,
+}
+
+// evalHandler evaluates arithmetic as the parser reduces, without building an AST.
+type evalHandler[T, E any] struct {
+	numeric Numeric[T, E]
+	// reductions holds the reduction for each production index of the parser's tables.
+	reductions []reduction
+}
+
+// newEvalHandler returns a handler for the tables of one of the PEMDAS grammars. It is an error
+// for a production of the tables to have no reduction in pemdasReductions, or to have a different
+// number of right-hand-side symbols than its reduction expects, as when the grammar's alternatives
+// have been reordered.
+func newEvalHandler[T, E any](numeric Numeric[T, E], tables *lr.Tables) (evalHandler[T, E], error) {
+	reductions := make([]reduction, len(tables.Productions))
+	alternatives := map[asts.NodeType]int{}
+	for i, prod := range tables.Productions {
+		alternative := alternatives[prod.LHS]
+		alternatives[prod.LHS]++
+		if strings.HasPrefix(string(prod.LHS), bnf.SyntheticPrefix) {
+			continue
+		}
+		r, ok := pemdasReductions[pemdasProduction{prod.LHS, alternative}]
+		if !ok {
+			return evalHandler[T, E]{}, fmt.Errorf("no reduction for production %d (%s alternative %d)", i, prod.LHS, alternative)
+		}
+		if r.rhsCount() != prod.RHSCount {
+			return evalHandler[T, E]{}, fmt.Errorf("production %d (%s alternative %d) has %d symbols; its reduction expects %d",
+				i, prod.LHS, alternative, prod.RHSCount, r.rhsCount())
+		}
+		reductions[i] = r
+	}
+	return evalHandler[T, E]{numeric: numeric, reductions: reductions}, nil
+}
+
+func (handler evalHandler[T, E]) Shift(token *tokens.Token) (evalValue[T], error) {
+	return evalValue[T]{token: token}, nil
+}
+
+func (handler evalHandler[T, E]) Reduce(production int, lhs asts.NodeType, rhs []evalValue[T]) (evalValue[T], error) {
+	if production < 0 || production >= len(handler.reductions) {
+		return evalValue[T]{}, fmt.Errorf("production index %d out of range for %s", production, lhs)
+	}
+	switch handler.reductions[production] {
+	case reducePassThrough:
+		return rhs[0], nil
+	case reduceLiteral:
+		literal := string(rhs[0].token.Lexeme)
+		number, err := handler.numeric.FromString(literal)
+		return evalValue[T]{number: number, literal: literal}, err
+	case reduceParen:
+		return rhs[1], nil
+	case reduceUnary:
+		number, err := handler.unary(rhs[0].token, rhs[1].number)
+		return evalValue[T]{number: number}, err
+	case reduceBinary:
+		number, err := handler.binary(rhs[1].token, rhs[0].number, rhs[2])
+		return evalValue[T]{number: number}, err
+	case reduceNegatedExponent:
+		exponent := evalValue[T]{number: handler.numeric.Negate(rhs[3].number)}
+		if rhs[3].literal != "" {
+			exponent.literal = "-" + rhs[3].literal
+		}
+		number, err := handler.binary(rhs[1].token, rhs[0].number, exponent)
+		return evalValue[T]{number: number}, err
+	default:
+		return evalValue[T]{}, fmt.Errorf("unhandled production %d (%s)", production, lhs)
+	}
+}
+
+func (handler evalHandler[T, E]) unary(opToken *tokens.Token, v T) (T, error) {
+	switch op := string(opToken.Lexeme); op {
+	case "+":
+		return v, nil
+	case "-":
+		return handler.numeric.Negate(v), nil
+	default:
+		var zero T
+		return zero, fmt.Errorf("unhandled unary operator %q", op)
+	}
+}
+
+func (handler evalHandler[T, E]) binary(opToken *tokens.Token, a T, b evalValue[T]) (T, error) {
+	numeric := handler.numeric
+	switch op := string(opToken.Lexeme); op {
+	case "+":
+		return numeric.Add(a, b.number), nil
+	case "-":
+		return numeric.Subtract(a, b.number), nil
+	case "*":
+		return numeric.Multiply(a, b.number), nil
+	case "/":
+		return numeric.Divide(a, b.number)
+	case "%":
+		return numeric.Mod(a, b.number)
+	case "**":
+		var exp E
+		var err error
+		if b.literal != "" {
+			exp, err = numeric.ParseExponent(b.literal)
+		} else {
+			exp, err = numeric.ToExponent(b.number)
+		}
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		return numeric.Exponentiate(a, exp)
+	default:
+		var zero T
+		return zero, fmt.Errorf("unhandled operator %q", op)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	generatedparsers "github.com/johnkerl/pgpg/apps/go/generated/pkg/parsers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+)
+
+// pemdasCases are expressions on which evaluating while parsing and walking the AST must agree.
+var pemdasCases = []string{
+	"7",
+	"1 + 2 * 3",
+	"(1 + 2) * 3",
+	"10 - 4 - 3",
+	"-2 ** 2",
+	"+2 ** 2",
+	"- -3",
+	"2 ** 3 ** 2",
+	"17 / 5",
+	"17 % 5",
+	"2 * (3 + 4) - 5 % 3",
+	"((1))",
+}
+
+// compareEvaluators checks that evaluateWithMode and the AST evaluator give the same value for
+// each case.
+func compareEvaluators[T, E any](t *testing.T, mode string, numeric Numeric[T, E], cases []string) {
+	t.Helper()
+	for _, input := range cases {
+		got, err := evaluateWithMode(strings.NewReader(input), mode, numeric)
+		if err != nil {
+			t.Errorf("%s %q: evaluateWithMode: %v", mode, input, err)
+			continue
+		}
+		ast, err := parseWithMode(strings.NewReader(input), mode)
+		if err != nil {
+			t.Errorf("%s %q: parseWithMode: %v", mode, input, err)
+			continue
+		}
+		want, err := evaluateAST(ast, numeric, false)
+		if err != nil {
+			t.Errorf("%s %q: evaluateAST: %v", mode, input, err)
+			continue
+		}
+		if numeric.String(got) != numeric.String(want) {
+			t.Errorf("%s %q: got %s, AST evaluator gives %s", mode, input, numeric.String(got), numeric.String(want))
+		}
+	}
+}
+
+func TestEvalHandlerMatchesAST(t *testing.T) {
+	compareEvaluators[int, int](t, "int", IntNumeric{}, pemdasCases)
+	compareEvaluators[float64, float64](t, "float", FloatNumeric{}, append(pemdasCases, "1.5 * 4", "2.5 ** 2"))
+	numeric, err := NewModNumeric(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compareEvaluators[ModInt, int](t, "mod", numeric, append(pemdasCases, "0x10 + 1"))
+}
+
+// TestEvalHandlerNegatedExponent checks "a ** -b", whose AST drops the minus sign: the grammars'
+// hint keeps only the base and the exponent's magnitude.
+func TestEvalHandlerNegatedExponent(t *testing.T) {
+	got, err := evaluateWithMode(strings.NewReader("2 ** -2"), "float", FloatNumeric{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 0.25 {
+		t.Errorf("got %v, want 0.25", got)
+	}
+	_, err = evaluateWithMode(strings.NewReader("2 ** -2"), "int", IntNumeric{})
+	if err == nil {
+		t.Error("int: got no error for a negative exponent")
+	}
+}
+
+// TestEvalHandlerTables checks pemdasReductions against the PEMDAS grammars' tables, so that
+// reordering or adding alternatives in the grammars fails here rather than evaluating wrongly.
+func TestEvalHandlerTables(t *testing.T) {
+	used := map[reduction]bool{}
+	for name, tables := range map[string]*lr.Tables{
+		"pemdas_int":   generatedparsers.PEMDASIntParserTables,
+		"pemdas_float": generatedparsers.PEMDASFloatParserTables,
+		"pemdas_mod":   generatedparsers.PEMDASModParserTables,
+	} {
+		handler, err := newEvalHandler(IntNumeric{}, tables)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		for _, r := range handler.reductions {
+			used[r] = true
+		}
+	}
+	for r := reducePassThrough; r <= reduceNegatedExponent; r++ {
+		if !used[r] {
+			t.Errorf("reduction %d is used by no production", r)
+		}
+	}
+
+	// Swapping the first and last alternatives of ExponentiationTerm is caught.
+	reordered := *generatedparsers.PEMDASIntParserTables
+	reordered.Productions = slices.Clone(reordered.Productions)
+	var exponentiation []int
+	for i, prod := range reordered.Productions {
+		if prod.LHS == "ExponentiationTerm" {
+			exponentiation = append(exponentiation, i)
+		}
+	}
+	first, last := exponentiation[0], exponentiation[len(exponentiation)-1]
+	reordered.Productions[first], reordered.Productions[last] = reordered.Productions[last], reordered.Productions[first]
+	if _, err := newEvalHandler(IntNumeric{}, &reordered); err == nil {
+		t.Error("reordered ExponentiationTerm: got no error")
+	}
+}
@@ -32,7 +32,7 @@ func main() {
 	var prompt string
 	var mode string
 	var modN int
-	flag.BoolVar(&verbose, "v", false, "Print AST before evaluation")
+	flag.BoolVar(&verbose, "v", false, "Build and print the AST, then evaluate it, rather than evaluating while parsing")
 	flag.BoolVar(&exprMode, "e", false, "Arguments are expressions to parse (at least one required)")
 	flag.BoolVar(&lineMode, "l", false, "Read stdin line-by-line, evaluate each, print result (REPL)")
 	flag.StringVar(&prompt, "p", "> ", "In -l mode with TTY stdin, prompt string (default \"> \"; use \"\" to disable)")
@@ -138,21 +138,17 @@ func runParserOnFiles(filenames []string, verbose bool, mode string, modN int) e
 }
 
 func runParserOnce(r io.Reader, verbose bool, mode string, modN int) error {
-	ast, err := parseWithMode(r, mode)
-	if err != nil {
-		return err
-	}
 	switch mode {
 	case "int":
 		var b IntNumeric
-		result, err := evaluateAST[int, int](ast, b, verbose)
+		result, err := evaluate[int, int](r, mode, b, verbose)
 		if err != nil {
 			return err
 		}
 		fmt.Println(b.String(result))
 	case "float":
 		var b FloatNumeric
-		result, err := evaluateAST[float64, float64](ast, b, verbose)
+		result, err := evaluate[float64, float64](r, mode, b, verbose)
 		if err != nil {
 			return err
 		}
@@ -162,7 +158,7 @@ func runParserOnce(r io.Reader, verbose bool, mode string, modN int) error {
 		if err != nil {
 			return err
 		}
-		result, err := evaluateAST[ModInt, int](ast, numeric, verbose)
+		result, err := evaluate[ModInt, int](r, mode, numeric, verbose)
 		if err != nil {
 			return err
 		}
@@ -173,6 +169,53 @@ func runParserOnce(r io.Reader, verbose bool, mode string, modN int) error {
 	return nil
 }
 
+// evaluate returns the value of the input, computed while parsing, or, if verbose, by building
+// and printing the AST and then walking it.
+func evaluate[T, E any](r io.Reader, mode string, numeric Numeric[T, E], verbose bool) (T, error) {
+	if verbose {
+		ast, err := parseWithMode(r, mode)
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		return evaluateAST(ast, numeric, verbose)
+	}
+	return evaluateWithMode(r, mode, numeric)
+}
+
+// evaluateWithMode evaluates while parsing, using the lexer/parser for the given mode.
+func evaluateWithMode[T, E any](r io.Reader, mode string, numeric Numeric[T, E]) (T, error) {
+	var zero T
+	switch mode {
+	case "int":
+		handler, err := newEvalHandler(numeric, generatedparsers.PEMDASIntParserTables)
+		if err != nil {
+			return zero, err
+		}
+		lexer := generatedlexers.NewPEMDASIntLexer(r)
+		value, err := generatedparsers.ParseWithPEMDASIntParser[evalValue[T]](generatedparsers.NewPEMDASIntParser(), lexer, handler)
+		return value.number, err
+	case "float":
+		handler, err := newEvalHandler(numeric, generatedparsers.PEMDASFloatParserTables)
+		if err != nil {
+			return zero, err
+		}
+		lexer := generatedlexers.NewPEMDASFloatLexer(r)
+		value, err := generatedparsers.ParseWithPEMDASFloatParser[evalValue[T]](generatedparsers.NewPEMDASFloatParser(), lexer, handler)
+		return value.number, err
+	case "mod":
+		handler, err := newEvalHandler(numeric, generatedparsers.PEMDASModParserTables)
+		if err != nil {
+			return zero, err
+		}
+		lexer := generatedlexers.NewPEMDASModLexer(r)
+		value, err := generatedparsers.ParseWithPEMDASModParser[evalValue[T]](generatedparsers.NewPEMDASModParser(), lexer, handler)
+		return value.number, err
+	default:
+		return zero, fmt.Errorf("unsupported mode %q", mode)
+	}
+}
+
 // parseWithMode returns an AST using the lexer/parser for the given mode.
 func parseWithMode(r io.Reader, mode string) (*asts.AST, error) {
 	switch mode {
@@ -1,14 +1,20 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/johnkerl/pgpg/apps/go/manual/lexers"
+	genrun "github.com/johnkerl/pgpg/go/generators/pkg/run"
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
 	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
 
 	generatedlexers "github.com/johnkerl/pgpg/apps/go/generated/pkg/lexers"
@@ -21,6 +27,9 @@ type lexerInfoT struct {
 	help  string
 }
 
+// watchInterval is how often -watch checks the grammar file for changes.
+const watchInterval = 500 * time.Millisecond
+
 var lexerMakerTable = map[string]lexerInfoT{
 	"m:canned":       lexerInfoT{lexers.NewCannedTextLexer, "Does string-split on the input at startup."},
 	"m:rune":         lexerInfoT{lexers.NewRuneLexer, "Each UTF-8 character is its own token."},
@@ -34,18 +43,23 @@ var lexerMakerTable = map[string]lexerInfoT{
 	"m:ebnf":         lexerInfoT{liblexers.NewEBNFLexer, "EBNF grammar with identifiers, literals, and operators."},
 	"g:signd":        lexerInfoT{generatedlexers.NewSignDigitLexer, "Generated sign/digit lexer from apps/bnfs/sign-digit.bnf."},
 	"g:pemdas-plain": lexerInfoT{generatedlexers.NewPEMDASPlainLexer, "Generated PEMDAS lexer from apps/bnfs/pemdas-plain.bnf."},
+	"g:pemdas-flat":  lexerInfoT{generatedlexers.NewPEMDASFlatLexer, "Generated PEMDAS lexer from apps/bnfs/pemdas_flat.bnf."},
 	"g:pemdas":       lexerInfoT{generatedlexers.NewPEMDASLexer, "Generated PEMDAS hinted lexer from apps/bnfs/pemdas.bnf."},
 	"g:stmts":        lexerInfoT{generatedlexers.NewStatementsLexer, "Generated statements lexer from apps/bnfs/statements.bnf."},
 	"g:seng":         lexerInfoT{generatedlexers.NewSENGLexer, "Generated statements lexer from apps/bnfs/seng.bnf."},
 	"g:lisp":         lexerInfoT{generatedlexers.NewLISPLexer, "Generated LISP lexer from apps/bnfs/lisp.bnf."},
 	"g:json":         lexerInfoT{generatedlexers.NewJSONLexer, "Generated JSON lexer from apps/bnfs/json.bnf."},
 	"g:json-plain":   lexerInfoT{generatedlexers.NewJSONPlainLexer, "Generated JSON lexer from apps/bnfs/json_plain.bnf."},
+	"g:interp":       lexerInfoT{generatedlexers.NewInterpLexer, "Generated interpolated-string lexer from apps/bnfs/interp.bnf."},
 }
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage: %s [options] {lexer name} [file ...]\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "       %s [options] -bnf {grammar file} [file ...]\n", os.Args[0])
 	fmt.Fprintf(os.Stderr, "  With -e (before lexer name): one or more positional args are expressions (error if none).\n")
 	fmt.Fprintf(os.Stderr, "  Without -e: zero args = read from stdin; one or more = read from those files.\n")
+	fmt.Fprintf(os.Stderr, "  With -bnf: build lexer tables from the grammar in process, in place of a lexer name.\n")
+	fmt.Fprintf(os.Stderr, "  With -watch (and -bnf): rebuild and relex the -e expressions or files whenever the grammar\n    file changes, until interrupted.\n")
 	flag.PrintDefaults()
 	fmt.Fprintf(os.Stderr, "Lexer names:\n")
 	names := make([]string, 0, len(lexerMakerTable))
@@ -62,21 +76,52 @@ func usage() {
 
 func main() {
 	var exprMode bool
+	var bnfPath string
+	var watch bool
 	flag.BoolVar(&exprMode, "e", false, "Arguments are expressions to lex (at least one required)")
+	flag.StringVar(&bnfPath, "bnf", "", "Build the lexer from this grammar file in place of a lexer name")
+	flag.BoolVar(&watch, "watch", false, "Relex the inputs whenever the -bnf grammar file changes")
 	flag.Usage = usage
 	flag.Parse()
 
-	if flag.NArg() < 1 {
-		usage()
+	if watch {
+		if bnfPath == "" {
+			fmt.Fprintln(os.Stderr, "trylex: -watch requires -bnf")
+			os.Exit(1)
+		}
+		if flag.NArg() == 0 {
+			fmt.Fprintln(os.Stderr, "trylex: -watch requires -e expressions or files to lex")
+			os.Exit(1)
+		}
+		err := watchGrammar(bnfPath, flag.Args(), exprMode)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
 	}
-	lexerName := flag.Arg(0)
-	args := flag.Args()[1:]
 
-	lexerInfo, ok := lexerMakerTable[lexerName]
-	if !ok {
-		usage()
+	var lexerMaker lexerMaker
+	var args []string
+	if bnfPath != "" {
+		args = flag.Args()
+		var err error
+		lexerMaker, err = grammarLexerMaker(context.Background(), bnfPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	} else {
+		if flag.NArg() < 1 {
+			usage()
+		}
+		args = flag.Args()[1:]
+		lexerInfo, ok := lexerMakerTable[flag.Arg(0)]
+		if !ok {
+			usage()
+		}
+		lexerMaker = lexerInfo.maker
 	}
-	lexerMaker := lexerInfo.maker
 
 	if exprMode {
 		if len(args) == 0 {
@@ -102,6 +147,43 @@ func main() {
 	}
 }
 
+// grammarLexerMaker builds lexer tables from the BNF grammar at bnfPath and runs them with the dfa
+// package, as the generated code would.
+func grammarLexerMaker(ctx context.Context, bnfPath string) (lexerMaker, error) {
+	tables, err := genrun.LexgenRuntimeTables(ctx, bnfPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	return func(r io.Reader) liblexers.AbstractLexer { return dfa.NewLexer(tables, r) }, nil
+}
+
+// watchGrammar rebuilds the lexer from the grammar at bnfPath whenever the file changes, and lexes
+// each of the inputs with it, until interrupted. Errors in the grammar or the inputs are printed,
+// and watching continues.
+func watchGrammar(bnfPath string, inputs []string, exprMode bool) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	return genrun.WatchFile(ctx, bnfPath, watchInterval, func() {
+		fmt.Printf("==== %s (%s)\n", bnfPath, time.Now().Format(time.TimeOnly))
+		lexerMaker, err := grammarLexerMaker(ctx, bnfPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		for _, input := range inputs {
+			fmt.Printf("---- %s\n", input)
+			if exprMode {
+				err = runLexerOnce(lexerMaker, strings.NewReader(input))
+			} else {
+				err = runLexerOnFiles(lexerMaker, []string{input})
+			}
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
+		}
+	})
+}
+
 func runLexerOnce(lexerMaker lexerMaker, r io.Reader) error {
 	lexer := lexerMaker(r)
 	return lexers.Run(lexer)
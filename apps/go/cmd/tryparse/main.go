@@ -2,16 +2,23 @@ package main
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/johnkerl/pgpg/apps/go/manual/parsers"
+	genrun "github.com/johnkerl/pgpg/go/generators/pkg/run"
 	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
 	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
 	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
 
 	generatedlexers "github.com/johnkerl/pgpg/apps/go/generated/pkg/lexers"
@@ -30,12 +37,23 @@ type multiObjectParser interface {
 	ParseOne(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, bool, error)
 }
 
+// glrParser is implemented by generated parsers; ParseAll follows conflicting actions only in tables
+// built with parsegen-tables -glr.
+type glrParser interface {
+	generatedParser
+	ParseAll(lexer liblexers.AbstractLexer, astMode string) ([]*asts.AST, error)
+}
+
 type parserInfoT struct {
 	run      func(io.Reader, traceOptions) (*asts.AST, error)
-	runMulti func(io.Reader, traceOptions) error // nil if parser does not support -multi
+	runMulti func(io.Reader, traceOptions) error                // nil if parser does not support -multi
+	runAll   func(io.Reader, traceOptions) ([]*asts.AST, error) // nil if parser does not support -all
 	help     string
 }
 
+// watchInterval is how often -watch checks the grammar file for changes.
+const watchInterval = 500 * time.Millisecond
+
 type traceOptions struct {
 	tokens  bool
 	states  bool
@@ -79,6 +97,11 @@ var parserMakerTable = map[string]parserInfoT{
 		runMulti: runGeneratedMulti(generatedlexers.NewPEMDASPlainLexer, func() generatedParser { return generatedparsers.NewPEMDASPlainParser() }),
 		help:     "Generated arithmetic parser from apps/bnfs/pemdas-plain.bnf.",
 	},
+	"g:pemdas-flat": {
+		run:      runGeneratedParser(generatedlexers.NewPEMDASFlatLexer, func() generatedParser { return generatedparsers.NewPEMDASFlatParser() }),
+		runMulti: runGeneratedMulti(generatedlexers.NewPEMDASFlatLexer, func() generatedParser { return generatedparsers.NewPEMDASFlatParser() }),
+		help:     "Generated arithmetic parser from apps/bnfs/pemdas_flat.bnf, using precedence declarations.",
+	},
 	"g:pemdas": {
 		run:      runGeneratedParser(generatedlexers.NewPEMDASLexer, func() generatedParser { return generatedparsers.NewPEMDASParser() }),
 		runMulti: runGeneratedMulti(generatedlexers.NewPEMDASLexer, func() generatedParser { return generatedparsers.NewPEMDASParser() }),
@@ -94,6 +117,11 @@ var parserMakerTable = map[string]parserInfoT{
 		runMulti: runGeneratedMulti(generatedlexers.NewSENGLexer, func() generatedParser { return generatedparsers.NewSENGParser() }),
 		help:     "Generated SENG parser from apps/bnfs/seng.bnf.",
 	},
+	"g:seng-glr": {
+		run:    runGeneratedParser(generatedlexers.NewSENGGLRLexer, func() generatedParser { return generatedparsers.NewSENGGLRParser() }),
+		runAll: runGeneratedAll(generatedlexers.NewSENGGLRLexer, func() generatedParser { return generatedparsers.NewSENGGLRParser() }),
+		help:   "Generated ambiguous SENG parser from apps/bnfs/seng_glr.bnf; use -all for all parses.",
+	},
 	"g:lisp": {
 		run:      runGeneratedParser(generatedlexers.NewLISPLexer, func() generatedParser { return generatedparsers.NewLISPParser() }),
 		runMulti: runGeneratedMulti(generatedlexers.NewLISPLexer, func() generatedParser { return generatedparsers.NewLISPParser() }),
@@ -113,9 +141,14 @@ var parserMakerTable = map[string]parserInfoT{
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage: %s [options] {parser name} [file ...]\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "       %s [options] -bnf {grammar file} [file ...]\n", os.Args[0])
 	fmt.Fprintf(os.Stderr, "  With -e (before parser name): one or more positional args are expressions (error if none).\n")
 	fmt.Fprintf(os.Stderr, "  Without -e: zero args = read from stdin; one or more = read from those files.\n")
-	fmt.Fprintf(os.Stderr, "  With -multi: parse multiple top-level objects from a single input stream (generated parsers only).\n")
+	fmt.Fprintf(os.Stderr, "  With -multi: parse multiple top-level objects from a single input stream (generated parsers\n    whose grammar declares %%records, e.g. g:json).\n")
+	fmt.Fprintf(os.Stderr, "  With -all: print every parse of an ambiguous input (generated GLR parsers only).\n")
+	fmt.Fprintf(os.Stderr, "  With -bnf: build lexer and parser tables from the grammar in process, in place of a parser name.\n")
+	fmt.Fprintf(os.Stderr, "  With -messages (and -bnf): apply the messages file's syntax error messages, as parsegen-tables\n    -messages does.\n")
+	fmt.Fprintf(os.Stderr, "  With -watch (and -bnf): rebuild and reparse the -e expressions or files whenever the grammar\n    file changes, until interrupted.\n")
 	flag.PrintDefaults()
 	fmt.Fprintf(os.Stderr, "Parser names:\n")
 	names := make([]string, 0, len(parserMakerTable))
@@ -138,6 +171,10 @@ func main() {
 	var fullast bool
 	var exprMode bool
 	var multi bool
+	var all bool
+	var bnfPath string
+	var messagesPath string
+	var watch bool
 	flag.BoolVar(&traceTokens, "tokens", false, "Print tokens as they're read")
 	flag.BoolVar(&traceStates, "states", false, "Show parser state transitions")
 	flag.BoolVar(&traceStack, "stack", false, "Show parser stack after each action")
@@ -145,6 +182,10 @@ func main() {
 	flag.BoolVar(&fullast, "fullast", false, "Ignore AST hints and build full parse tree (generated parsers only)")
 	flag.BoolVar(&exprMode, "e", false, "Arguments are expressions to parse (at least one required)")
 	flag.BoolVar(&multi, "multi", false, "Parse multiple top-level objects from one stream (generated parsers only)")
+	flag.BoolVar(&all, "all", false, "Print all parses, using the GLR driver (generated GLR parsers only)")
+	flag.StringVar(&bnfPath, "bnf", "", "Build the parser from this grammar file in place of a parser name")
+	flag.StringVar(&messagesPath, "messages", "", "With -bnf, apply this messages file's syntax error messages")
+	flag.BoolVar(&watch, "watch", false, "Reparse the inputs whenever the -bnf grammar file changes")
 	flag.Usage = usage
 	flag.Parse()
 
@@ -159,16 +200,6 @@ func main() {
 		astMode = "fullast"
 	}
 
-	if flag.NArg() < 1 {
-		usage()
-	}
-	parserName := flag.Arg(0)
-	args := flag.Args()[1:]
-
-	parserInfo, ok := parserMakerTable[parserName]
-	if !ok {
-		usage()
-	}
 	opts := traceOptions{
 		tokens:  traceTokens,
 		states:  traceStates,
@@ -176,6 +207,57 @@ func main() {
 		astMode: astMode,
 	}
 
+	if messagesPath != "" && bnfPath == "" {
+		fmt.Fprintln(os.Stderr, "tryparse: -messages requires -bnf")
+		os.Exit(1)
+	}
+
+	if watch {
+		if bnfPath == "" {
+			fmt.Fprintln(os.Stderr, "tryparse: -watch requires -bnf")
+			os.Exit(1)
+		}
+		if multi {
+			fmt.Fprintln(os.Stderr, "tryparse: cannot use -watch and -multi together")
+			os.Exit(1)
+		}
+		if flag.NArg() == 0 {
+			fmt.Fprintln(os.Stderr, "tryparse: -watch requires -e expressions or files to parse")
+			os.Exit(1)
+		}
+		err := watchGrammar(bnfPath, messagesPath, flag.Args(), exprMode, all, opts)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	var parserName string
+	var args []string
+	var parserInfo parserInfoT
+	if bnfPath != "" {
+		parserName = bnfPath
+		args = flag.Args()
+		var err error
+		parserInfo, err = grammarParserInfo(context.Background(), bnfPath, messagesPath, all)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	} else {
+		if flag.NArg() < 1 {
+			usage()
+		}
+		parserName = flag.Arg(0)
+		args = flag.Args()[1:]
+		var ok bool
+		parserInfo, ok = parserMakerTable[parserName]
+		if !ok {
+			usage()
+		}
+	}
+
 	if multi {
 		if parserInfo.runMulti == nil {
 			fmt.Fprintf(os.Stderr, "tryparse: parser %q does not support -multi (use a generated parser, e.g. g:json-plain)\n", parserName)
@@ -211,6 +293,13 @@ func main() {
 	}
 
 	run := parserInfo.run
+	if all {
+		if parserInfo.runAll == nil {
+			fmt.Fprintf(os.Stderr, "tryparse: parser %q does not support -all (use a GLR parser, e.g. g:seng-glr)\n", parserName)
+			os.Exit(1)
+		}
+		run = printAllParses(parserInfo.runAll)
+	}
 	if exprMode {
 		if len(args) == 0 {
 			fmt.Fprintln(os.Stderr, "tryparse: -e requires at least one argument")
@@ -235,6 +324,60 @@ func main() {
 	}
 }
 
+// grammarParserInfo builds lexer and parser tables from the BNF grammar at bnfPath and runs them
+// with the lr and dfa packages, as the generated code would. A non-empty messagesPath gives the
+// parser tables syntax error messages, as parsegen-tables -messages does. With glr the parser
+// tables keep conflicting actions, for -all.
+func grammarParserInfo(ctx context.Context, bnfPath, messagesPath string, glr bool) (parserInfoT, error) {
+	lexTables, err := genrun.LexgenRuntimeTables(ctx, bnfPath, nil)
+	if err != nil {
+		return parserInfoT{}, err
+	}
+	parseTables, err := genrun.ParsegenRuntimeTables(ctx, bnfPath, &genrun.ParsegenTablesOptions{GLR: glr, Messages: messagesPath})
+	if err != nil {
+		return parserInfoT{}, err
+	}
+	newLexer := func(r io.Reader) liblexers.AbstractLexer { return dfa.NewLexer(lexTables, r) }
+	newParser := func() generatedParser { return lr.NewParser(parseTables) }
+	return parserInfoT{
+		run:      runGeneratedParser(newLexer, newParser),
+		runMulti: runGeneratedMulti(newLexer, newParser),
+		runAll:   runGeneratedAll(newLexer, newParser),
+		help:     "Parser built from " + bnfPath + ".",
+	}, nil
+}
+
+// watchGrammar rebuilds the parser from the grammar at bnfPath whenever the file changes, and
+// parses each of the inputs with it, until interrupted. Errors in the grammar or the inputs are
+// printed, and watching continues.
+func watchGrammar(bnfPath, messagesPath string, inputs []string, exprMode, all bool, opts traceOptions) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	return genrun.WatchFile(ctx, bnfPath, watchInterval, func() {
+		fmt.Printf("==== %s (%s)\n", bnfPath, time.Now().Format(time.TimeOnly))
+		parserInfo, err := grammarParserInfo(ctx, bnfPath, messagesPath, all)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		run := parserInfo.run
+		if all {
+			run = printAllParses(parserInfo.runAll)
+		}
+		for _, input := range inputs {
+			fmt.Printf("---- %s\n", input)
+			if exprMode {
+				err = runParserOnce(run, strings.NewReader(input), opts)
+			} else {
+				err = runParserOnFiles(run, []string{input}, opts)
+			}
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
+		}
+	})
+}
+
 func runManualParser(maker func() libparsers.AbstractParser) func(io.Reader, traceOptions) (*asts.AST, error) {
 	return func(r io.Reader, _ traceOptions) (*asts.AST, error) {
 		parser := maker()
@@ -268,12 +411,12 @@ func runGeneratedMulti(
 		}
 		for {
 			ast, done, err := multi.ParseOne(lexer, opts.astMode)
-			if err != nil {
-				return err
-			}
 			if ast != nil && opts.astMode != "noast" {
 				ast.Print()
 			}
+			if err != nil {
+				return err
+			}
 			if done {
 				break
 			}
@@ -282,15 +425,45 @@ func runGeneratedMulti(
 	}
 }
 
+func runGeneratedAll(
+	newLexer func(io.Reader) liblexers.AbstractLexer,
+	newParser func() generatedParser,
+) func(io.Reader, traceOptions) ([]*asts.AST, error) {
+	return func(r io.Reader, opts traceOptions) ([]*asts.AST, error) {
+		lexer := newLexer(r)
+		parser, ok := newParser().(glrParser)
+		if !ok {
+			return nil, fmt.Errorf("parser does not support ParseAll")
+		}
+		return parser.ParseAll(lexer, opts.astMode)
+	}
+}
+
+// printAllParses adapts a ParseAll runner to the single-AST runner used by runParserOnce,
+// printing each parse with a header and returning no AST of its own.
+func printAllParses(runAll func(io.Reader, traceOptions) ([]*asts.AST, error)) func(io.Reader, traceOptions) (*asts.AST, error) {
+	return func(r io.Reader, opts traceOptions) (*asts.AST, error) {
+		parses, err := runAll(r, opts)
+		if err != nil {
+			return nil, err
+		}
+		for i, ast := range parses {
+			fmt.Printf("Parse %d of %d:\n", i+1, len(parses))
+			if opts.astMode != "noast" {
+				ast.Print()
+			}
+		}
+		return nil, nil
+	}
+}
+
 func runParserOnce(run func(io.Reader, traceOptions) (*asts.AST, error), r io.Reader, opts traceOptions) error {
+	// A parser which recovers from syntax errors returns its AST along with the errors.
 	ast, err := run(r, opts)
-	if err != nil {
-		return err
-	}
 	if ast != nil && opts.astMode != "noast" {
 		ast.Print()
 	}
-	return nil
+	return err
 }
 
 func runParserOnFiles(run func(io.Reader, traceOptions) (*asts.AST, error), filenames []string, opts traceOptions) error {
@@ -0,0 +1,95 @@
+package lexers
+
+import (
+	"io"
+	"strings"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
+	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+func NewInterpLexer(r io.Reader) liblexers.AbstractLexer {
+	return dfa.NewLexer(InterpLexerTables, r)
+}
+
+// NewInterpLexerFromString returns a lexer over s (convenience for tests and -e mode).
+func NewInterpLexerFromString(s string) liblexers.AbstractLexer {
+	return NewInterpLexer(strings.NewReader(s))
+}
+
+// InterpLexerTables are the lexer's DFA tables, run by the dfa package. ASCII runes are grouped
+// into classes, and ASCIINext has a row per state and a column per class, as dfa.Tables documents.
+var InterpLexerTables = &dfa.Tables{
+	StartState: 0,
+	ASCIIClasses: []uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 0, 0, 1, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		2, 3, 4, 3, 5, 3, 3, 3, 6, 7, 3, 8, 3, 3, 3, 3,
+		9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 3, 3, 3, 3, 3, 3,
+		3, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10,
+		10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 3, 11, 3, 3, 9,
+		3, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 12, 10,
+		10, 10, 10, 10, 12, 10, 10, 10, 10, 10, 10, 13, 3, 14, 3, 3,
+	},
+	ASCIIClassCount: 15,
+	ASCIINext: []int32{
+		-1, 2, 2, -1, 3, -1, 4, 5, 6, -1, 7, -1, 7, 8, 9,
+		-1, -1, 10, 10, 11, 12, 10, 10, 10, 10, 10, 13, 10, 10, 10,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, 7, 7, -1, 7, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, 10, 10, -1, -1, 10, 10, 10, 10, 10, -1, 10, 10, 10,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 14, -1,
+		-1, -1, -1, -1, 15, 15, -1, -1, -1, -1, -1, 15, 15, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	},
+	NonASCII: [][]dfa.Transition{
+		1: {
+			{From: '\u0080', To: '\uffff', Next: 10},
+		},
+		10: {
+			{From: '\u0080', To: '\uffff', Next: 10},
+		},
+	},
+	Actions: []tokens.TokenType{
+		2:  "!whitespace",
+		3:  "quote",
+		4:  "lparen",
+		5:  "rparen",
+		6:  "plus",
+		7:  "identifier",
+		8:  "lcurly",
+		9:  "rcurly",
+		10: "text",
+		11: "end_quote",
+		12: "text",
+		14: "interp",
+		15: "text",
+	},
+	Ignored: []bool{
+		2: true,
+	},
+	Modes: []string{
+		"default",
+		"string",
+	},
+	ModeStarts: []int{
+		0,
+		1,
+	},
+	ModeActions: []dfa.ModeAction{
+		3:  {Kind: dfa.ModePush, Mode: 1},
+		8:  {Kind: dfa.ModePush, Mode: 0},
+		9:  {Kind: dfa.ModePop, Mode: 0},
+		11: {Kind: dfa.ModePop, Mode: 0},
+		14: {Kind: dfa.ModePush, Mode: 0},
+	},
+}
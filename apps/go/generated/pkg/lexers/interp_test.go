@@ -0,0 +1,48 @@
+package lexers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func scanInterp(t *testing.T, input string) string {
+	t.Helper()
+	lexer := NewInterpLexerFromString(input)
+	var scanned []string
+	for {
+		token := lexer.Scan()
+		if token.IsError() {
+			t.Fatalf("%q: lexer error: %s", input, string(token.Lexeme))
+		}
+		if token.IsEOF() {
+			return strings.Join(scanned, " ")
+		}
+		scanned = append(scanned, fmt.Sprintf("%s:%q", token.Type, string(token.Lexeme)))
+	}
+}
+
+// TestInterpLexer verifies that the lexer switches modes in and out of strings and
+// interpolations, including nested ones.
+func TestInterpLexer(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{
+			`"total ${a + "x${b}y"} items"`,
+			`quote:"\"" text:"total " interp:"${" identifier:"a" plus:"+" quote:"\"" text:"x" interp:"${" ` +
+				`identifier:"b" rcurly:"}" text:"y" end_quote:"\"" rcurly:"}" text:" items" end_quote:"\""`,
+		},
+		{
+			`"$5 \" ${ {a} }" + b`,
+			`quote:"\"" text:"$" text:"5 " text:"\\\"" text:" " interp:"${" lcurly:"{" identifier:"a" ` +
+				`rcurly:"}" rcurly:"}" end_quote:"\"" plus:"+" identifier:"b"`,
+		},
+	}
+	for _, tt := range tests {
+		if got := scanInterp(t, tt.input); got != tt.want {
+			t.Errorf("%q:\ngot  %s\nwant %s", tt.input, got, tt.want)
+		}
+	}
+}
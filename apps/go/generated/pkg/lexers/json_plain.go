@@ -1,38 +1,16 @@
 package lexers
 
 import (
-	"bufio"
-	"fmt"
 	"io"
 	"strings"
-	"unicode/utf8"
 
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
 	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
 	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
 )
 
-const JSONPlainLexerBufSize = 4096
-
-type JSONPlainLexer struct {
-	reader        *bufio.Reader
-	buf           []byte
-	tokenStart    int
-	tokenLocation *tokens.TokenLocation
-	atEOF         bool
-}
-
-var _ liblexers.AbstractLexer = (*JSONPlainLexer)(nil)
-
 func NewJSONPlainLexer(r io.Reader) liblexers.AbstractLexer {
-	reader, ok := r.(*bufio.Reader)
-	if !ok {
-		reader = bufio.NewReader(r)
-	}
-	return &JSONPlainLexer{
-		reader:        reader,
-		buf:           make([]byte, 0, JSONPlainLexerBufSize),
-		tokenLocation: tokens.NewTokenLocation(),
-	}
+	return dfa.NewLexer(JSONPlainLexerTables, r)
 }
 
 // NewJSONPlainLexerFromString returns a lexer over s (convenience for tests and -e mode).
@@ -40,427 +18,82 @@ func NewJSONPlainLexerFromString(s string) liblexers.AbstractLexer {
 	return NewJSONPlainLexer(strings.NewReader(s))
 }
 
-func (lexer *JSONPlainLexer) ensureFill(needBytes int) {
-	for needBytes > len(lexer.buf) && !lexer.atEOF {
-		chunk := make([]byte, JSONPlainLexerBufSize)
-		n, err := lexer.reader.Read(chunk)
-		if n > 0 {
-			lexer.buf = append(lexer.buf, chunk[:n]...)
-		}
-		if err == io.EOF {
-			lexer.atEOF = true
-			return
-		}
-		if err != nil {
-			lexer.atEOF = true
-			return
-		}
-	}
-}
-
-func (lexer *JSONPlainLexer) peekRuneAt(byteOffset int) (rune, int) {
-	lexer.ensureFill(byteOffset + utf8.UTFMax)
-	if byteOffset >= len(lexer.buf) {
-		return 0, 0
-	}
-	r, width := utf8.DecodeRune(lexer.buf[byteOffset:])
-	if width == 0 {
-		return 0, 0
-	}
-	return r, width
-}
-
-func (lexer *JSONPlainLexer) Scan() *tokens.Token {
-	lexer.ensureFill(lexer.tokenStart + 1)
-	if lexer.tokenStart >= len(lexer.buf) && lexer.atEOF {
-		return tokens.NewEOFToken(lexer.tokenLocation)
-	}
-
-	for {
-		if lexer.tokenStart >= len(lexer.buf) {
-			if lexer.atEOF {
-				return tokens.NewEOFToken(lexer.tokenLocation)
-			}
-			lexer.ensureFill(lexer.tokenStart + 1)
-			if lexer.tokenStart >= len(lexer.buf) {
-				return tokens.NewEOFToken(lexer.tokenLocation)
-			}
-		}
-
-		startLocation := *lexer.tokenLocation
-		scanOffset := lexer.tokenStart
-		state := JSONPlainLexerStartState
-		lastAcceptState := -1
-		lastAcceptOffset := scanOffset
-
-		for {
-			if scanOffset >= len(lexer.buf) {
-				if !lexer.atEOF {
-					lexer.ensureFill(scanOffset + utf8.UTFMax)
-				}
-				if scanOffset >= len(lexer.buf) {
-					break
-				}
-			}
-			r, width := lexer.peekRuneAt(scanOffset)
-			if width == 0 {
-				break
-			}
-			nextState, ok := JSONPlainLexerLookupTransition(state, r)
-			if !ok {
-				break
-			}
-			scanOffset += width
-			state = nextState
-			if _, ok := JSONPlainLexerActions[state]; ok {
-				lastAcceptState = state
-				lastAcceptOffset = scanOffset
-			}
-		}
-
-		if lastAcceptState < 0 {
-			r, _ := lexer.peekRuneAt(lexer.tokenStart)
-			return tokens.NewErrorToken(fmt.Sprintf("lexer: unrecognized input %q", r), lexer.tokenLocation)
-		}
-
-		lexemeText := string(lexer.buf[lexer.tokenStart:lastAcceptOffset])
-		lexeme := []rune(lexemeText)
-		for len(lexemeText) > 0 {
-			r, w := utf8.DecodeRuneInString(lexemeText)
-			lexer.tokenLocation.LocateRune(r, w)
-			lexemeText = lexemeText[w:]
-		}
-		lexer.buf = lexer.buf[lastAcceptOffset:]
-		lexer.tokenStart = 0
-		tokenType := JSONPlainLexerActions[lastAcceptState]
-		if JSONPlainLexerIsIgnoredToken(tokenType) {
-			continue
-		}
-		return tokens.NewToken(lexeme, tokenType, &startLocation)
-	}
-}
-
-func JSONPlainLexerLookupTransition(state int, r rune) (int, bool) {
-	transitionsForState, ok := JSONPlainLexerTransitions[state]
-	if !ok {
-		return 0, false
-	}
-	for _, tr := range transitionsForState {
-		if r < tr.from {
-			return 0, false
-		}
-		if r >= tr.from && r <= tr.to {
-			return tr.next, true
-		}
-	}
-	return 0, false
-}
-func JSONPlainLexerIsIgnoredToken(tokenType tokens.TokenType) bool {
-	return strings.HasPrefix(string(tokenType), "!")
-}
-
-const JSONPlainLexerStartState = 0
-
-type JSONPlainLexerRangeTransition struct {
-	from rune
-	to   rune
-	next int
-}
-
-var JSONPlainLexerTransitions = map[int][]JSONPlainLexerRangeTransition{
-	0: {
-		{from: '\t', to: '\t', next: 1},
-		{from: '\n', to: '\n', next: 2},
-		{from: '\r', to: '\r', next: 3},
-		{from: ' ', to: ' ', next: 4},
-		{from: '"', to: '"', next: 5},
-		{from: ',', to: ',', next: 6},
-		{from: '-', to: '-', next: 7},
-		{from: '0', to: '0', next: 8},
-		{from: '1', to: '9', next: 9},
-		{from: ':', to: ':', next: 10},
-		{from: '[', to: '[', next: 11},
-		{from: ']', to: ']', next: 12},
-		{from: 'f', to: 'f', next: 13},
-		{from: 'n', to: 'n', next: 14},
-		{from: 't', to: 't', next: 15},
-		{from: '{', to: '{', next: 16},
-		{from: '}', to: '}', next: 17},
-	},
-	5: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-	7: {
-		{from: '0', to: '0', next: 8},
-		{from: '1', to: '9', next: 9},
-	},
-	8: {
-		{from: '.', to: '.', next: 23},
-		{from: 'E', to: 'E', next: 24},
-		{from: 'e', to: 'e', next: 25},
-	},
-	9: {
-		{from: '.', to: '.', next: 23},
-		{from: '0', to: '9', next: 26},
-		{from: 'E', to: 'E', next: 24},
-		{from: 'e', to: 'e', next: 25},
-	},
-	13: {
-		{from: 'a', to: 'a', next: 27},
-	},
-	14: {
-		{from: 'u', to: 'u', next: 28},
-	},
-	15: {
-		{from: 'r', to: 'r', next: 29},
-	},
-	18: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-	20: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-	21: {
-		{from: '"', to: '"', next: 30},
-		{from: '/', to: '/', next: 31},
-		{from: '\\', to: '\\', next: 32},
-		{from: 'b', to: 'b', next: 33},
-		{from: 'f', to: 'f', next: 34},
-		{from: 'n', to: 'n', next: 35},
-		{from: 'r', to: 'r', next: 36},
-		{from: 't', to: 't', next: 37},
-		{from: 'u', to: 'u', next: 38},
-	},
-	22: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-	23: {
-		{from: '0', to: '9', next: 39},
-	},
-	24: {
-		{from: '+', to: '+', next: 40},
-		{from: '-', to: '-', next: 41},
-		{from: '0', to: '9', next: 42},
-	},
-	25: {
-		{from: '+', to: '+', next: 40},
-		{from: '-', to: '-', next: 41},
-		{from: '0', to: '9', next: 42},
-	},
-	26: {
-		{from: '.', to: '.', next: 23},
-		{from: '0', to: '9', next: 26},
-		{from: 'E', to: 'E', next: 24},
-		{from: 'e', to: 'e', next: 25},
+// JSONPlainLexerTables are the lexer's DFA tables, run by the dfa package. ASCII runes are grouped
+// into classes, and ASCIINext has a row per state and a column per class, as dfa.Tables documents.
+var JSONPlainLexerTables = &dfa.Tables{
+	StartState: 0,
+	ASCIIClasses: []uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 0, 0, 1, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		2, 3, 4, 3, 3, 3, 3, 3, 3, 3, 3, 5, 6, 7, 8, 9,
+		10, 11, 11, 11, 11, 11, 11, 11, 11, 11, 12, 3, 3, 3, 3, 3,
+		3, 13, 13, 13, 13, 14, 13, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 15, 16, 17, 3, 3,
+		3, 18, 19, 13, 13, 20, 21, 3, 3, 3, 3, 3, 22, 3, 23, 3,
+		3, 3, 24, 25, 26, 27, 3, 3, 3, 3, 3, 28, 3, 29, 3, 3,
+	},
+	ASCIIClassCount: 30,
+	ASCIINext: []int32{
+		-1, 1, 1, -1, 2, -1, 3, 4, -1, -1, 5, 6, 7, -1, -1, 8, -1, 9, -1, -1, -1, 10, -1, 11, -1, -1, 12, -1, 13, 14,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, 2, 2, 15, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 16, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 5, 6, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, 17, -1, -1, -1, -1, -1, 18, -1, -1, -1, -1, -1, 18, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, 17, -1, 6, 6, -1, -1, 18, -1, -1, -1, -1, -1, 18, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 19, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 20, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 21, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, 2, -1, -1, -1, -1, 2, -1, -1, -1, -1, -1, -1, 2, -1, -1, 2, -1, 2, -1, 2, 2, -1, 2, 22, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 23, 23, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, 24, -1, 24, -1, -1, 25, 25, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 26, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 27, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 28, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 29, 29, -1, 29, 29, -1, -1, -1, 29, 29, 29, 29, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 23, 23, -1, -1, 18, -1, -1, -1, -1, -1, 18, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 25, 25, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 25, 25, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 30, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 31, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 32, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 33, 33, -1, 33, 33, -1, -1, -1, 33, 33, 33, 33, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 34, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 35, 35, -1, 35, 35, -1, -1, -1, 35, 35, 35, 35, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 2, 2, -1, 2, 2, -1, -1, -1, 2, 2, 2, 2, -1, -1, -1, -1, -1, -1, -1, -1,
+	},
+	NonASCII: [][]dfa.Transition{
+		2: {
+			{From: '\u0080', To: '\U0010ffff', Next: 2},
+		},
+	},
+	Actions: []tokens.TokenType{
+		1:  "!whitespace",
+		3:  "comma",
+		5:  "number",
+		6:  "number",
+		7:  "colon",
+		8:  "lbracket",
+		9:  "rbracket",
+		13: "lcurly",
+		14: "rcurly",
+		15: "string",
+		23: "number",
+		25: "number",
+		31: "null",
+		32: "true",
+		34: "false",
+	},
+	Ignored: []bool{
+		1: true,
 	},
-	27: {
-		{from: 'l', to: 'l', next: 43},
-	},
-	28: {
-		{from: 'l', to: 'l', next: 44},
-	},
-	29: {
-		{from: 'u', to: 'u', next: 45},
-	},
-	30: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-	31: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-	32: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-	33: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-	34: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-	35: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-	36: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-	37: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-	38: {
-		{from: '0', to: '9', next: 46},
-		{from: 'A', to: 'F', next: 47},
-		{from: 'a', to: 'f', next: 48},
-	},
-	39: {
-		{from: '0', to: '9', next: 49},
-		{from: 'E', to: 'E', next: 24},
-		{from: 'e', to: 'e', next: 25},
-	},
-	40: {
-		{from: '0', to: '9', next: 42},
-	},
-	41: {
-		{from: '0', to: '9', next: 42},
-	},
-	42: {
-		{from: '0', to: '9', next: 50},
-	},
-	43: {
-		{from: 's', to: 's', next: 51},
-	},
-	44: {
-		{from: 'l', to: 'l', next: 52},
-	},
-	45: {
-		{from: 'e', to: 'e', next: 53},
-	},
-	46: {
-		{from: '0', to: '9', next: 54},
-		{from: 'A', to: 'F', next: 55},
-		{from: 'a', to: 'f', next: 56},
-	},
-	47: {
-		{from: '0', to: '9', next: 54},
-		{from: 'A', to: 'F', next: 55},
-		{from: 'a', to: 'f', next: 56},
-	},
-	48: {
-		{from: '0', to: '9', next: 54},
-		{from: 'A', to: 'F', next: 55},
-		{from: 'a', to: 'f', next: 56},
-	},
-	49: {
-		{from: '0', to: '9', next: 49},
-		{from: 'E', to: 'E', next: 24},
-		{from: 'e', to: 'e', next: 25},
-	},
-	50: {
-		{from: '0', to: '9', next: 50},
-	},
-	51: {
-		{from: 'e', to: 'e', next: 57},
-	},
-	54: {
-		{from: '0', to: '9', next: 58},
-		{from: 'A', to: 'F', next: 59},
-		{from: 'a', to: 'f', next: 60},
-	},
-	55: {
-		{from: '0', to: '9', next: 58},
-		{from: 'A', to: 'F', next: 59},
-		{from: 'a', to: 'f', next: 60},
-	},
-	56: {
-		{from: '0', to: '9', next: 58},
-		{from: 'A', to: 'F', next: 59},
-		{from: 'a', to: 'f', next: 60},
-	},
-	58: {
-		{from: '0', to: '9', next: 61},
-		{from: 'A', to: 'F', next: 62},
-		{from: 'a', to: 'f', next: 63},
-	},
-	59: {
-		{from: '0', to: '9', next: 61},
-		{from: 'A', to: 'F', next: 62},
-		{from: 'a', to: 'f', next: 63},
-	},
-	60: {
-		{from: '0', to: '9', next: 61},
-		{from: 'A', to: 'F', next: 62},
-		{from: 'a', to: 'f', next: 63},
-	},
-	61: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-	62: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-	63: {
-		{from: ' ', to: '!', next: 18},
-		{from: '"', to: '"', next: 19},
-		{from: '#', to: '[', next: 20},
-		{from: '\\', to: '\\', next: 21},
-		{from: ']', to: '\uffff', next: 22},
-	},
-}
-
-var JSONPlainLexerActions = map[int]tokens.TokenType{
-	1:  "!whitespace",
-	2:  "!whitespace",
-	3:  "!whitespace",
-	4:  "!whitespace",
-	6:  "comma",
-	8:  "number",
-	9:  "number",
-	10: "colon",
-	11: "lbracket",
-	12: "rbracket",
-	16: "lcurly",
-	17: "rcurly",
-	19: "string",
-	26: "number",
-	39: "number",
-	42: "number",
-	49: "number",
-	50: "number",
-	52: "null",
-	53: "true",
-	57: "false",
 }
@@ -0,0 +1,26 @@
+package lexers
+
+import (
+	"testing"
+
+	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+)
+
+// TestJSONLexerStrings verifies that strings may hold any character but quotes, backslashes, and
+// control characters, including those outside the Basic Multilingual Plane.
+func TestJSONLexerStrings(t *testing.T) {
+	for name, newLexer := range map[string]func(string) liblexers.AbstractLexer{
+		"json":       NewJSONLexerFromString,
+		"json_plain": NewJSONPlainLexerFromString,
+	} {
+		for _, input := range []string{`"héllo"`, `"😀 é 𝄞"`, `"￿"`} {
+			token := newLexer(input).Scan()
+			if token.IsError() || string(token.Lexeme) != input {
+				t.Errorf("%s %q: got %s %q, want one string token", name, input, token.Type, string(token.Lexeme))
+			}
+		}
+		if token := newLexer("\"a\tb\"").Scan(); !token.IsError() {
+			t.Errorf("%s: got %s for a string with a tab, want an error", name, token.Type)
+		}
+	}
+}
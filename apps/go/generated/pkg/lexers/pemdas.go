@@ -1,38 +1,16 @@
 package lexers
 
 import (
-	"bufio"
-	"fmt"
 	"io"
 	"strings"
-	"unicode/utf8"
 
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
 	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
 	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
 )
 
-const PEMDASLexerBufSize = 4096
-
-type PEMDASLexer struct {
-	reader        *bufio.Reader
-	buf           []byte
-	tokenStart    int
-	tokenLocation *tokens.TokenLocation
-	atEOF         bool
-}
-
-var _ liblexers.AbstractLexer = (*PEMDASLexer)(nil)
-
 func NewPEMDASLexer(r io.Reader) liblexers.AbstractLexer {
-	reader, ok := r.(*bufio.Reader)
-	if !ok {
-		reader = bufio.NewReader(r)
-	}
-	return &PEMDASLexer{
-		reader:        reader,
-		buf:           make([]byte, 0, PEMDASLexerBufSize),
-		tokenLocation: tokens.NewTokenLocation(),
-	}
+	return dfa.NewLexer(PEMDASLexerTables, r)
 }
 
 // NewPEMDASLexerFromString returns a lexer over s (convenience for tests and -e mode).
@@ -40,4937 +18,59 @@ func NewPEMDASLexerFromString(s string) liblexers.AbstractLexer {
 	return NewPEMDASLexer(strings.NewReader(s))
 }
 
-func (lexer *PEMDASLexer) ensureFill(needBytes int) {
-	for needBytes > len(lexer.buf) && !lexer.atEOF {
-		chunk := make([]byte, PEMDASLexerBufSize)
-		n, err := lexer.reader.Read(chunk)
-		if n > 0 {
-			lexer.buf = append(lexer.buf, chunk[:n]...)
-		}
-		if err == io.EOF {
-			lexer.atEOF = true
-			return
-		}
-		if err != nil {
-			lexer.atEOF = true
-			return
-		}
-	}
-}
-
-func (lexer *PEMDASLexer) peekRuneAt(byteOffset int) (rune, int) {
-	lexer.ensureFill(byteOffset + utf8.UTFMax)
-	if byteOffset >= len(lexer.buf) {
-		return 0, 0
-	}
-	r, width := utf8.DecodeRune(lexer.buf[byteOffset:])
-	if width == 0 {
-		return 0, 0
-	}
-	return r, width
-}
-
-func (lexer *PEMDASLexer) Scan() *tokens.Token {
-	lexer.ensureFill(lexer.tokenStart + 1)
-	if lexer.tokenStart >= len(lexer.buf) && lexer.atEOF {
-		return tokens.NewEOFToken(lexer.tokenLocation)
-	}
-
-	for {
-		if lexer.tokenStart >= len(lexer.buf) {
-			if lexer.atEOF {
-				return tokens.NewEOFToken(lexer.tokenLocation)
-			}
-			lexer.ensureFill(lexer.tokenStart + 1)
-			if lexer.tokenStart >= len(lexer.buf) {
-				return tokens.NewEOFToken(lexer.tokenLocation)
-			}
-		}
-
-		startLocation := *lexer.tokenLocation
-		scanOffset := lexer.tokenStart
-		state := PEMDASLexerStartState
-		lastAcceptState := -1
-		lastAcceptOffset := scanOffset
-
-		for {
-			if scanOffset >= len(lexer.buf) {
-				if !lexer.atEOF {
-					lexer.ensureFill(scanOffset + utf8.UTFMax)
-				}
-				if scanOffset >= len(lexer.buf) {
-					break
-				}
-			}
-			r, width := lexer.peekRuneAt(scanOffset)
-			if width == 0 {
-				break
-			}
-			nextState, ok := PEMDASLexerLookupTransition(state, r)
-			if !ok {
-				break
-			}
-			scanOffset += width
-			state = nextState
-			if _, ok := PEMDASLexerActions[state]; ok {
-				lastAcceptState = state
-				lastAcceptOffset = scanOffset
-			}
-		}
-
-		if lastAcceptState < 0 {
-			r, _ := lexer.peekRuneAt(lexer.tokenStart)
-			return tokens.NewErrorToken(fmt.Sprintf("lexer: unrecognized input %q", r), lexer.tokenLocation)
-		}
-
-		lexemeText := string(lexer.buf[lexer.tokenStart:lastAcceptOffset])
-		lexeme := []rune(lexemeText)
-		for len(lexemeText) > 0 {
-			r, w := utf8.DecodeRuneInString(lexemeText)
-			lexer.tokenLocation.LocateRune(r, w)
-			lexemeText = lexemeText[w:]
-		}
-		lexer.buf = lexer.buf[lastAcceptOffset:]
-		lexer.tokenStart = 0
-		tokenType := PEMDASLexerActions[lastAcceptState]
-		if PEMDASLexerIsIgnoredToken(tokenType) {
-			continue
-		}
-		return tokens.NewToken(lexeme, tokenType, &startLocation)
-	}
-}
-
-func PEMDASLexerLookupTransition(state int, r rune) (int, bool) {
-	transitionsForState, ok := PEMDASLexerTransitions[state]
-	if !ok {
-		return 0, false
-	}
-	for _, tr := range transitionsForState {
-		if r < tr.from {
-			return 0, false
-		}
-		if r >= tr.from && r <= tr.to {
-			return tr.next, true
-		}
-	}
-	return 0, false
-}
-func PEMDASLexerIsIgnoredToken(tokenType tokens.TokenType) bool {
-	return strings.HasPrefix(string(tokenType), "!")
-}
-
-const PEMDASLexerStartState = 0
-
-type PEMDASLexerRangeTransition struct {
-	from rune
-	to   rune
-	next int
-}
-
-var PEMDASLexerTransitions = map[int][]PEMDASLexerRangeTransition{
-	0: {
-		{from: '\t', to: '\t', next: 1},
-		{from: '\n', to: '\n', next: 2},
-		{from: '\r', to: '\r', next: 3},
-		{from: ' ', to: ' ', next: 4},
-		{from: '%', to: '%', next: 5},
-		{from: '(', to: '(', next: 6},
-		{from: ')', to: ')', next: 7},
-		{from: '*', to: '*', next: 8},
-		{from: '+', to: '+', next: 9},
-		{from: '-', to: '-', next: 10},
-		{from: '.', to: '.', next: 11},
-		{from: '/', to: '/', next: 12},
-		{from: '0', to: '0', next: 13},
-		{from: '1', to: '1', next: 14},
-		{from: '2', to: '2', next: 15},
-		{from: '3', to: '3', next: 16},
-		{from: '4', to: '4', next: 17},
-		{from: '5', to: '5', next: 18},
-		{from: '6', to: '6', next: 19},
-		{from: '7', to: '7', next: 20},
-		{from: '8', to: '8', next: 21},
-		{from: '9', to: '9', next: 22},
-	},
-	8: {
-		{from: '*', to: '*', next: 23},
-	},
-	11: {
-		{from: '0', to: '0', next: 24},
-		{from: '1', to: '1', next: 25},
-		{from: '2', to: '2', next: 26},
-		{from: '3', to: '3', next: 27},
-		{from: '4', to: '4', next: 28},
-		{from: '5', to: '5', next: 29},
-		{from: '6', to: '6', next: 30},
-		{from: '7', to: '7', next: 31},
-		{from: '8', to: '8', next: 32},
-		{from: '9', to: '9', next: 33},
-	},
-	13: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'X', to: 'X', next: 46},
-		{from: 'e', to: 'e', next: 47},
-		{from: 'x', to: 'x', next: 48},
-	},
-	14: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	15: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	16: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	17: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	18: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	19: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	20: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	21: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	22: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	24: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	25: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	26: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	27: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	28: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	29: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	30: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	31: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	32: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	33: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	34: {
-		{from: '0', to: '0', next: 61},
-		{from: '1', to: '1', next: 62},
-		{from: '2', to: '2', next: 63},
-		{from: '3', to: '3', next: 64},
-		{from: '4', to: '4', next: 65},
-		{from: '5', to: '5', next: 66},
-		{from: '6', to: '6', next: 67},
-		{from: '7', to: '7', next: 68},
-		{from: '8', to: '8', next: 69},
-		{from: '9', to: '9', next: 70},
-		{from: 'E', to: 'E', next: 71},
-		{from: 'e', to: 'e', next: 72},
-	},
-	35: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	36: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	37: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	38: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	39: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	40: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	41: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	42: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	43: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	44: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 47},
-	},
-	45: {
-		{from: '+', to: '+', next: 73},
-		{from: '-', to: '-', next: 74},
-		{from: '0', to: '0', next: 75},
-		{from: '1', to: '1', next: 76},
-		{from: '2', to: '2', next: 77},
-		{from: '3', to: '3', next: 78},
-		{from: '4', to: '4', next: 79},
-		{from: '5', to: '5', next: 80},
-		{from: '6', to: '6', next: 81},
-		{from: '7', to: '7', next: 82},
-		{from: '8', to: '8', next: 83},
-		{from: '9', to: '9', next: 84},
-	},
-	46: {
-		{from: '0', to: '0', next: 85},
-		{from: '1', to: '1', next: 86},
-		{from: '2', to: '2', next: 87},
-		{from: '3', to: '3', next: 88},
-		{from: '4', to: '4', next: 89},
-		{from: '5', to: '5', next: 90},
-		{from: '6', to: '6', next: 91},
-		{from: '7', to: '7', next: 92},
-		{from: '8', to: '8', next: 93},
-		{from: '9', to: '9', next: 94},
-		{from: 'A', to: 'A', next: 95},
-		{from: 'B', to: 'B', next: 96},
-		{from: 'C', to: 'C', next: 97},
-		{from: 'D', to: 'D', next: 98},
-		{from: 'E', to: 'E', next: 99},
-		{from: 'F', to: 'F', next: 100},
-		{from: 'a', to: 'a', next: 101},
-		{from: 'b', to: 'b', next: 102},
-		{from: 'c', to: 'c', next: 103},
-		{from: 'd', to: 'd', next: 104},
-		{from: 'e', to: 'e', next: 105},
-		{from: 'f', to: 'f', next: 106},
-	},
-	47: {
-		{from: '+', to: '+', next: 73},
-		{from: '-', to: '-', next: 74},
-		{from: '0', to: '0', next: 75},
-		{from: '1', to: '1', next: 76},
-		{from: '2', to: '2', next: 77},
-		{from: '3', to: '3', next: 78},
-		{from: '4', to: '4', next: 79},
-		{from: '5', to: '5', next: 80},
-		{from: '6', to: '6', next: 81},
-		{from: '7', to: '7', next: 82},
-		{from: '8', to: '8', next: 83},
-		{from: '9', to: '9', next: 84},
-	},
-	48: {
-		{from: '0', to: '0', next: 107},
-		{from: '1', to: '1', next: 108},
-		{from: '2', to: '2', next: 109},
-		{from: '3', to: '3', next: 110},
-		{from: '4', to: '4', next: 111},
-		{from: '5', to: '5', next: 112},
-		{from: '6', to: '6', next: 113},
-		{from: '7', to: '7', next: 114},
-		{from: '8', to: '8', next: 115},
-		{from: '9', to: '9', next: 116},
-		{from: 'A', to: 'A', next: 117},
-		{from: 'B', to: 'B', next: 118},
-		{from: 'C', to: 'C', next: 119},
-		{from: 'D', to: 'D', next: 120},
-		{from: 'E', to: 'E', next: 121},
-		{from: 'F', to: 'F', next: 122},
-		{from: 'a', to: 'a', next: 123},
-		{from: 'b', to: 'b', next: 124},
-		{from: 'c', to: 'c', next: 125},
-		{from: 'd', to: 'd', next: 126},
-		{from: 'e', to: 'e', next: 127},
-		{from: 'f', to: 'f', next: 128},
-	},
-	49: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	50: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	51: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	52: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	53: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	54: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	55: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	56: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	57: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	58: {
-		{from: '0', to: '0', next: 49},
-		{from: '1', to: '1', next: 50},
-		{from: '2', to: '2', next: 51},
-		{from: '3', to: '3', next: 52},
-		{from: '4', to: '4', next: 53},
-		{from: '5', to: '5', next: 54},
-		{from: '6', to: '6', next: 55},
-		{from: '7', to: '7', next: 56},
-		{from: '8', to: '8', next: 57},
-		{from: '9', to: '9', next: 58},
-		{from: 'E', to: 'E', next: 59},
-		{from: 'e', to: 'e', next: 60},
-	},
-	59: {
-		{from: '+', to: '+', next: 129},
-		{from: '-', to: '-', next: 130},
-		{from: '0', to: '0', next: 131},
-		{from: '1', to: '1', next: 132},
-		{from: '2', to: '2', next: 133},
-		{from: '3', to: '3', next: 134},
-		{from: '4', to: '4', next: 135},
-		{from: '5', to: '5', next: 136},
-		{from: '6', to: '6', next: 137},
-		{from: '7', to: '7', next: 138},
-		{from: '8', to: '8', next: 139},
-		{from: '9', to: '9', next: 140},
-	},
-	60: {
-		{from: '+', to: '+', next: 129},
-		{from: '-', to: '-', next: 130},
-		{from: '0', to: '0', next: 131},
-		{from: '1', to: '1', next: 132},
-		{from: '2', to: '2', next: 133},
-		{from: '3', to: '3', next: 134},
-		{from: '4', to: '4', next: 135},
-		{from: '5', to: '5', next: 136},
-		{from: '6', to: '6', next: 137},
-		{from: '7', to: '7', next: 138},
-		{from: '8', to: '8', next: 139},
-		{from: '9', to: '9', next: 140},
-	},
-	61: {
-		{from: '0', to: '0', next: 61},
-		{from: '1', to: '1', next: 62},
-		{from: '2', to: '2', next: 63},
-		{from: '3', to: '3', next: 64},
-		{from: '4', to: '4', next: 65},
-		{from: '5', to: '5', next: 66},
-		{from: '6', to: '6', next: 67},
-		{from: '7', to: '7', next: 68},
-		{from: '8', to: '8', next: 69},
-		{from: '9', to: '9', next: 70},
-		{from: 'E', to: 'E', next: 71},
-		{from: 'e', to: 'e', next: 72},
-	},
-	62: {
-		{from: '0', to: '0', next: 61},
-		{from: '1', to: '1', next: 62},
-		{from: '2', to: '2', next: 63},
-		{from: '3', to: '3', next: 64},
-		{from: '4', to: '4', next: 65},
-		{from: '5', to: '5', next: 66},
-		{from: '6', to: '6', next: 67},
-		{from: '7', to: '7', next: 68},
-		{from: '8', to: '8', next: 69},
-		{from: '9', to: '9', next: 70},
-		{from: 'E', to: 'E', next: 71},
-		{from: 'e', to: 'e', next: 72},
-	},
-	63: {
-		{from: '0', to: '0', next: 61},
-		{from: '1', to: '1', next: 62},
-		{from: '2', to: '2', next: 63},
-		{from: '3', to: '3', next: 64},
-		{from: '4', to: '4', next: 65},
-		{from: '5', to: '5', next: 66},
-		{from: '6', to: '6', next: 67},
-		{from: '7', to: '7', next: 68},
-		{from: '8', to: '8', next: 69},
-		{from: '9', to: '9', next: 70},
-		{from: 'E', to: 'E', next: 71},
-		{from: 'e', to: 'e', next: 72},
-	},
-	64: {
-		{from: '0', to: '0', next: 61},
-		{from: '1', to: '1', next: 62},
-		{from: '2', to: '2', next: 63},
-		{from: '3', to: '3', next: 64},
-		{from: '4', to: '4', next: 65},
-		{from: '5', to: '5', next: 66},
-		{from: '6', to: '6', next: 67},
-		{from: '7', to: '7', next: 68},
-		{from: '8', to: '8', next: 69},
-		{from: '9', to: '9', next: 70},
-		{from: 'E', to: 'E', next: 71},
-		{from: 'e', to: 'e', next: 72},
-	},
-	65: {
-		{from: '0', to: '0', next: 61},
-		{from: '1', to: '1', next: 62},
-		{from: '2', to: '2', next: 63},
-		{from: '3', to: '3', next: 64},
-		{from: '4', to: '4', next: 65},
-		{from: '5', to: '5', next: 66},
-		{from: '6', to: '6', next: 67},
-		{from: '7', to: '7', next: 68},
-		{from: '8', to: '8', next: 69},
-		{from: '9', to: '9', next: 70},
-		{from: 'E', to: 'E', next: 71},
-		{from: 'e', to: 'e', next: 72},
-	},
-	66: {
-		{from: '0', to: '0', next: 61},
-		{from: '1', to: '1', next: 62},
-		{from: '2', to: '2', next: 63},
-		{from: '3', to: '3', next: 64},
-		{from: '4', to: '4', next: 65},
-		{from: '5', to: '5', next: 66},
-		{from: '6', to: '6', next: 67},
-		{from: '7', to: '7', next: 68},
-		{from: '8', to: '8', next: 69},
-		{from: '9', to: '9', next: 70},
-		{from: 'E', to: 'E', next: 71},
-		{from: 'e', to: 'e', next: 72},
-	},
-	67: {
-		{from: '0', to: '0', next: 61},
-		{from: '1', to: '1', next: 62},
-		{from: '2', to: '2', next: 63},
-		{from: '3', to: '3', next: 64},
-		{from: '4', to: '4', next: 65},
-		{from: '5', to: '5', next: 66},
-		{from: '6', to: '6', next: 67},
-		{from: '7', to: '7', next: 68},
-		{from: '8', to: '8', next: 69},
-		{from: '9', to: '9', next: 70},
-		{from: 'E', to: 'E', next: 71},
-		{from: 'e', to: 'e', next: 72},
-	},
-	68: {
-		{from: '0', to: '0', next: 61},
-		{from: '1', to: '1', next: 62},
-		{from: '2', to: '2', next: 63},
-		{from: '3', to: '3', next: 64},
-		{from: '4', to: '4', next: 65},
-		{from: '5', to: '5', next: 66},
-		{from: '6', to: '6', next: 67},
-		{from: '7', to: '7', next: 68},
-		{from: '8', to: '8', next: 69},
-		{from: '9', to: '9', next: 70},
-		{from: 'E', to: 'E', next: 71},
-		{from: 'e', to: 'e', next: 72},
-	},
-	69: {
-		{from: '0', to: '0', next: 61},
-		{from: '1', to: '1', next: 62},
-		{from: '2', to: '2', next: 63},
-		{from: '3', to: '3', next: 64},
-		{from: '4', to: '4', next: 65},
-		{from: '5', to: '5', next: 66},
-		{from: '6', to: '6', next: 67},
-		{from: '7', to: '7', next: 68},
-		{from: '8', to: '8', next: 69},
-		{from: '9', to: '9', next: 70},
-		{from: 'E', to: 'E', next: 71},
-		{from: 'e', to: 'e', next: 72},
-	},
-	70: {
-		{from: '0', to: '0', next: 61},
-		{from: '1', to: '1', next: 62},
-		{from: '2', to: '2', next: 63},
-		{from: '3', to: '3', next: 64},
-		{from: '4', to: '4', next: 65},
-		{from: '5', to: '5', next: 66},
-		{from: '6', to: '6', next: 67},
-		{from: '7', to: '7', next: 68},
-		{from: '8', to: '8', next: 69},
-		{from: '9', to: '9', next: 70},
-		{from: 'E', to: 'E', next: 71},
-		{from: 'e', to: 'e', next: 72},
-	},
-	71: {
-		{from: '+', to: '+', next: 141},
-		{from: '-', to: '-', next: 142},
-		{from: '0', to: '0', next: 143},
-		{from: '1', to: '1', next: 144},
-		{from: '2', to: '2', next: 145},
-		{from: '3', to: '3', next: 146},
-		{from: '4', to: '4', next: 147},
-		{from: '5', to: '5', next: 148},
-		{from: '6', to: '6', next: 149},
-		{from: '7', to: '7', next: 150},
-		{from: '8', to: '8', next: 151},
-		{from: '9', to: '9', next: 152},
-	},
-	72: {
-		{from: '+', to: '+', next: 141},
-		{from: '-', to: '-', next: 142},
-		{from: '0', to: '0', next: 143},
-		{from: '1', to: '1', next: 144},
-		{from: '2', to: '2', next: 145},
-		{from: '3', to: '3', next: 146},
-		{from: '4', to: '4', next: 147},
-		{from: '5', to: '5', next: 148},
-		{from: '6', to: '6', next: 149},
-		{from: '7', to: '7', next: 150},
-		{from: '8', to: '8', next: 151},
-		{from: '9', to: '9', next: 152},
-	},
-	73: {
-		{from: '0', to: '0', next: 153},
-		{from: '1', to: '1', next: 154},
-		{from: '2', to: '2', next: 155},
-		{from: '3', to: '3', next: 156},
-		{from: '4', to: '4', next: 157},
-		{from: '5', to: '5', next: 158},
-		{from: '6', to: '6', next: 159},
-		{from: '7', to: '7', next: 160},
-		{from: '8', to: '8', next: 161},
-		{from: '9', to: '9', next: 162},
-	},
-	74: {
-		{from: '0', to: '0', next: 153},
-		{from: '1', to: '1', next: 154},
-		{from: '2', to: '2', next: 155},
-		{from: '3', to: '3', next: 156},
-		{from: '4', to: '4', next: 157},
-		{from: '5', to: '5', next: 158},
-		{from: '6', to: '6', next: 159},
-		{from: '7', to: '7', next: 160},
-		{from: '8', to: '8', next: 161},
-		{from: '9', to: '9', next: 162},
-	},
-	75: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	76: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	77: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	78: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	79: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	80: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	81: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	82: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	83: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	84: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	85: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	86: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	87: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	88: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	89: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	90: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	91: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	92: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	93: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	94: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	95: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	96: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	97: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	98: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	99: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	100: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	101: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	102: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	103: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	104: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	105: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	106: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	107: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	108: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	109: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	110: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	111: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	112: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	113: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	114: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	115: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	116: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	117: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	118: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	119: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	120: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	121: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	122: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	123: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	124: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	125: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	126: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	127: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	128: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	129: {
-		{from: '0', to: '0', next: 217},
-		{from: '1', to: '1', next: 218},
-		{from: '2', to: '2', next: 219},
-		{from: '3', to: '3', next: 220},
-		{from: '4', to: '4', next: 221},
-		{from: '5', to: '5', next: 222},
-		{from: '6', to: '6', next: 223},
-		{from: '7', to: '7', next: 224},
-		{from: '8', to: '8', next: 225},
-		{from: '9', to: '9', next: 226},
-	},
-	130: {
-		{from: '0', to: '0', next: 217},
-		{from: '1', to: '1', next: 218},
-		{from: '2', to: '2', next: 219},
-		{from: '3', to: '3', next: 220},
-		{from: '4', to: '4', next: 221},
-		{from: '5', to: '5', next: 222},
-		{from: '6', to: '6', next: 223},
-		{from: '7', to: '7', next: 224},
-		{from: '8', to: '8', next: 225},
-		{from: '9', to: '9', next: 226},
-	},
-	131: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	132: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	133: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	134: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	135: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	136: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	137: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	138: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	139: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
+// PEMDASLexerTables are the lexer's DFA tables, run by the dfa package. ASCII runes are grouped
+// into classes, and ASCIINext has a row per state and a column per class, as dfa.Tables documents.
+var PEMDASLexerTables = &dfa.Tables{
+	StartState: 0,
+	ASCIIClasses: []uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 0, 0, 1, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 0, 0, 0, 0, 2, 0, 0, 3, 4, 5, 6, 0, 7, 8, 9,
+		10, 11, 11, 11, 11, 11, 11, 11, 11, 11, 0, 0, 0, 0, 0, 0,
+		0, 12, 12, 12, 12, 13, 12, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 14, 0, 0, 0, 0, 0, 0, 0,
+		0, 12, 12, 12, 12, 13, 12, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 14, 0, 0, 0, 0, 0, 0, 0,
+	},
+	ASCIIClassCount: 15,
+	ASCIINext: []int32{
+		-1, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, 12, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 13, 13, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, 13, -1, 11, 11, -1, 14, 15,
+		-1, -1, -1, -1, -1, -1, -1, -1, 13, -1, 11, 11, -1, 14, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 13, 13, -1, 14, -1,
+		-1, -1, -1, -1, -1, -1, 16, 16, -1, -1, 17, 17, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 18, 18, 18, 18, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 17, 17, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 17, 17, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 18, 18, 18, 18, -1,
+	},
+	Actions: []tokens.TokenType{
+		1:  "!whitespace",
+		2:  "modulo",
+		3:  "lparen",
+		4:  "rparen",
+		5:  "times",
+		6:  "plus",
+		7:  "minus",
+		9:  "divide",
+		10: "int_literal",
+		11: "int_literal",
+		12: "exponentiation",
+		13: "float_literal",
+		17: "float_literal",
+		18: "hex_literal",
+	},
+	Ignored: []bool{
+		1: true,
 	},
-	140: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	141: {
-		{from: '0', to: '0', next: 237},
-		{from: '1', to: '1', next: 238},
-		{from: '2', to: '2', next: 239},
-		{from: '3', to: '3', next: 240},
-		{from: '4', to: '4', next: 241},
-		{from: '5', to: '5', next: 242},
-		{from: '6', to: '6', next: 243},
-		{from: '7', to: '7', next: 244},
-		{from: '8', to: '8', next: 245},
-		{from: '9', to: '9', next: 246},
-	},
-	142: {
-		{from: '0', to: '0', next: 237},
-		{from: '1', to: '1', next: 238},
-		{from: '2', to: '2', next: 239},
-		{from: '3', to: '3', next: 240},
-		{from: '4', to: '4', next: 241},
-		{from: '5', to: '5', next: 242},
-		{from: '6', to: '6', next: 243},
-		{from: '7', to: '7', next: 244},
-		{from: '8', to: '8', next: 245},
-		{from: '9', to: '9', next: 246},
-	},
-	143: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	144: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	145: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	146: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	147: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	148: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	149: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	150: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	151: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	152: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	153: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	154: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	155: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	156: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	157: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	158: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	159: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	160: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	161: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	162: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	163: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	164: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	165: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	166: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	167: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	168: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	169: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	170: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	171: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	172: {
-		{from: '0', to: '0', next: 163},
-		{from: '1', to: '1', next: 164},
-		{from: '2', to: '2', next: 165},
-		{from: '3', to: '3', next: 166},
-		{from: '4', to: '4', next: 167},
-		{from: '5', to: '5', next: 168},
-		{from: '6', to: '6', next: 169},
-		{from: '7', to: '7', next: 170},
-		{from: '8', to: '8', next: 171},
-		{from: '9', to: '9', next: 172},
-	},
-	173: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	174: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	175: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	176: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	177: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	178: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	179: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	180: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	181: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	182: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	183: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	184: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	185: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	186: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	187: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	188: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	189: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	190: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	191: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	192: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	193: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	194: {
-		{from: '0', to: '0', next: 173},
-		{from: '1', to: '1', next: 174},
-		{from: '2', to: '2', next: 175},
-		{from: '3', to: '3', next: 176},
-		{from: '4', to: '4', next: 177},
-		{from: '5', to: '5', next: 178},
-		{from: '6', to: '6', next: 179},
-		{from: '7', to: '7', next: 180},
-		{from: '8', to: '8', next: 181},
-		{from: '9', to: '9', next: 182},
-		{from: 'A', to: 'A', next: 183},
-		{from: 'B', to: 'B', next: 184},
-		{from: 'C', to: 'C', next: 185},
-		{from: 'D', to: 'D', next: 186},
-		{from: 'E', to: 'E', next: 187},
-		{from: 'F', to: 'F', next: 188},
-		{from: 'a', to: 'a', next: 189},
-		{from: 'b', to: 'b', next: 190},
-		{from: 'c', to: 'c', next: 191},
-		{from: 'd', to: 'd', next: 192},
-		{from: 'e', to: 'e', next: 193},
-		{from: 'f', to: 'f', next: 194},
-	},
-	195: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	196: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	197: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	198: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	199: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	200: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	201: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	202: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	203: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	204: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	205: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	206: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	207: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	208: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	209: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	210: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	211: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	212: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	213: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	214: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	215: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	216: {
-		{from: '0', to: '0', next: 195},
-		{from: '1', to: '1', next: 196},
-		{from: '2', to: '2', next: 197},
-		{from: '3', to: '3', next: 198},
-		{from: '4', to: '4', next: 199},
-		{from: '5', to: '5', next: 200},
-		{from: '6', to: '6', next: 201},
-		{from: '7', to: '7', next: 202},
-		{from: '8', to: '8', next: 203},
-		{from: '9', to: '9', next: 204},
-		{from: 'A', to: 'A', next: 205},
-		{from: 'B', to: 'B', next: 206},
-		{from: 'C', to: 'C', next: 207},
-		{from: 'D', to: 'D', next: 208},
-		{from: 'E', to: 'E', next: 209},
-		{from: 'F', to: 'F', next: 210},
-		{from: 'a', to: 'a', next: 211},
-		{from: 'b', to: 'b', next: 212},
-		{from: 'c', to: 'c', next: 213},
-		{from: 'd', to: 'd', next: 214},
-		{from: 'e', to: 'e', next: 215},
-		{from: 'f', to: 'f', next: 216},
-	},
-	217: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	218: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	219: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	220: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	221: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	222: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	223: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	224: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	225: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	226: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	227: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	228: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	229: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	230: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	231: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	232: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	233: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	234: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	235: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	236: {
-		{from: '0', to: '0', next: 227},
-		{from: '1', to: '1', next: 228},
-		{from: '2', to: '2', next: 229},
-		{from: '3', to: '3', next: 230},
-		{from: '4', to: '4', next: 231},
-		{from: '5', to: '5', next: 232},
-		{from: '6', to: '6', next: 233},
-		{from: '7', to: '7', next: 234},
-		{from: '8', to: '8', next: 235},
-		{from: '9', to: '9', next: 236},
-	},
-	237: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	238: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	239: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	240: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	241: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	242: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	243: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	244: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	245: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	246: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	247: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	248: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	249: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	250: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	251: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	252: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	253: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	254: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	255: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	256: {
-		{from: '0', to: '0', next: 247},
-		{from: '1', to: '1', next: 248},
-		{from: '2', to: '2', next: 249},
-		{from: '3', to: '3', next: 250},
-		{from: '4', to: '4', next: 251},
-		{from: '5', to: '5', next: 252},
-		{from: '6', to: '6', next: 253},
-		{from: '7', to: '7', next: 254},
-		{from: '8', to: '8', next: 255},
-		{from: '9', to: '9', next: 256},
-	},
-	257: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	258: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	259: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	260: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	261: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	262: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	263: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	264: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	265: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	266: {
-		{from: '0', to: '0', next: 257},
-		{from: '1', to: '1', next: 258},
-		{from: '2', to: '2', next: 259},
-		{from: '3', to: '3', next: 260},
-		{from: '4', to: '4', next: 261},
-		{from: '5', to: '5', next: 262},
-		{from: '6', to: '6', next: 263},
-		{from: '7', to: '7', next: 264},
-		{from: '8', to: '8', next: 265},
-		{from: '9', to: '9', next: 266},
-	},
-	267: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	268: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	269: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	270: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	271: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	272: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	273: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	274: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	275: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	276: {
-		{from: '0', to: '0', next: 267},
-		{from: '1', to: '1', next: 268},
-		{from: '2', to: '2', next: 269},
-		{from: '3', to: '3', next: 270},
-		{from: '4', to: '4', next: 271},
-		{from: '5', to: '5', next: 272},
-		{from: '6', to: '6', next: 273},
-		{from: '7', to: '7', next: 274},
-		{from: '8', to: '8', next: 275},
-		{from: '9', to: '9', next: 276},
-	},
-	277: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	278: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	279: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	280: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	281: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	282: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	283: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	284: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	285: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-	286: {
-		{from: '0', to: '0', next: 277},
-		{from: '1', to: '1', next: 278},
-		{from: '2', to: '2', next: 279},
-		{from: '3', to: '3', next: 280},
-		{from: '4', to: '4', next: 281},
-		{from: '5', to: '5', next: 282},
-		{from: '6', to: '6', next: 283},
-		{from: '7', to: '7', next: 284},
-		{from: '8', to: '8', next: 285},
-		{from: '9', to: '9', next: 286},
-	},
-}
-
-var PEMDASLexerActions = map[int]tokens.TokenType{
-	1:   "!whitespace",
-	2:   "!whitespace",
-	3:   "!whitespace",
-	4:   "!whitespace",
-	5:   "modulo",
-	6:   "lparen",
-	7:   "rparen",
-	8:   "times",
-	9:   "plus",
-	10:  "minus",
-	12:  "divide",
-	13:  "int_literal",
-	14:  "int_literal",
-	15:  "int_literal",
-	16:  "int_literal",
-	17:  "int_literal",
-	18:  "int_literal",
-	19:  "int_literal",
-	20:  "int_literal",
-	21:  "int_literal",
-	22:  "int_literal",
-	23:  "exponentiation",
-	24:  "float_literal",
-	25:  "float_literal",
-	26:  "float_literal",
-	27:  "float_literal",
-	28:  "float_literal",
-	29:  "float_literal",
-	30:  "float_literal",
-	31:  "float_literal",
-	32:  "float_literal",
-	33:  "float_literal",
-	34:  "float_literal",
-	35:  "int_literal",
-	36:  "int_literal",
-	37:  "int_literal",
-	38:  "int_literal",
-	39:  "int_literal",
-	40:  "int_literal",
-	41:  "int_literal",
-	42:  "int_literal",
-	43:  "int_literal",
-	44:  "int_literal",
-	49:  "float_literal",
-	50:  "float_literal",
-	51:  "float_literal",
-	52:  "float_literal",
-	53:  "float_literal",
-	54:  "float_literal",
-	55:  "float_literal",
-	56:  "float_literal",
-	57:  "float_literal",
-	58:  "float_literal",
-	61:  "float_literal",
-	62:  "float_literal",
-	63:  "float_literal",
-	64:  "float_literal",
-	65:  "float_literal",
-	66:  "float_literal",
-	67:  "float_literal",
-	68:  "float_literal",
-	69:  "float_literal",
-	70:  "float_literal",
-	75:  "float_literal",
-	76:  "float_literal",
-	77:  "float_literal",
-	78:  "float_literal",
-	79:  "float_literal",
-	80:  "float_literal",
-	81:  "float_literal",
-	82:  "float_literal",
-	83:  "float_literal",
-	84:  "float_literal",
-	85:  "hex_literal",
-	86:  "hex_literal",
-	87:  "hex_literal",
-	88:  "hex_literal",
-	89:  "hex_literal",
-	90:  "hex_literal",
-	91:  "hex_literal",
-	92:  "hex_literal",
-	93:  "hex_literal",
-	94:  "hex_literal",
-	95:  "hex_literal",
-	96:  "hex_literal",
-	97:  "hex_literal",
-	98:  "hex_literal",
-	99:  "hex_literal",
-	100: "hex_literal",
-	101: "hex_literal",
-	102: "hex_literal",
-	103: "hex_literal",
-	104: "hex_literal",
-	105: "hex_literal",
-	106: "hex_literal",
-	107: "hex_literal",
-	108: "hex_literal",
-	109: "hex_literal",
-	110: "hex_literal",
-	111: "hex_literal",
-	112: "hex_literal",
-	113: "hex_literal",
-	114: "hex_literal",
-	115: "hex_literal",
-	116: "hex_literal",
-	117: "hex_literal",
-	118: "hex_literal",
-	119: "hex_literal",
-	120: "hex_literal",
-	121: "hex_literal",
-	122: "hex_literal",
-	123: "hex_literal",
-	124: "hex_literal",
-	125: "hex_literal",
-	126: "hex_literal",
-	127: "hex_literal",
-	128: "hex_literal",
-	131: "float_literal",
-	132: "float_literal",
-	133: "float_literal",
-	134: "float_literal",
-	135: "float_literal",
-	136: "float_literal",
-	137: "float_literal",
-	138: "float_literal",
-	139: "float_literal",
-	140: "float_literal",
-	143: "float_literal",
-	144: "float_literal",
-	145: "float_literal",
-	146: "float_literal",
-	147: "float_literal",
-	148: "float_literal",
-	149: "float_literal",
-	150: "float_literal",
-	151: "float_literal",
-	152: "float_literal",
-	153: "float_literal",
-	154: "float_literal",
-	155: "float_literal",
-	156: "float_literal",
-	157: "float_literal",
-	158: "float_literal",
-	159: "float_literal",
-	160: "float_literal",
-	161: "float_literal",
-	162: "float_literal",
-	163: "float_literal",
-	164: "float_literal",
-	165: "float_literal",
-	166: "float_literal",
-	167: "float_literal",
-	168: "float_literal",
-	169: "float_literal",
-	170: "float_literal",
-	171: "float_literal",
-	172: "float_literal",
-	173: "hex_literal",
-	174: "hex_literal",
-	175: "hex_literal",
-	176: "hex_literal",
-	177: "hex_literal",
-	178: "hex_literal",
-	179: "hex_literal",
-	180: "hex_literal",
-	181: "hex_literal",
-	182: "hex_literal",
-	183: "hex_literal",
-	184: "hex_literal",
-	185: "hex_literal",
-	186: "hex_literal",
-	187: "hex_literal",
-	188: "hex_literal",
-	189: "hex_literal",
-	190: "hex_literal",
-	191: "hex_literal",
-	192: "hex_literal",
-	193: "hex_literal",
-	194: "hex_literal",
-	195: "hex_literal",
-	196: "hex_literal",
-	197: "hex_literal",
-	198: "hex_literal",
-	199: "hex_literal",
-	200: "hex_literal",
-	201: "hex_literal",
-	202: "hex_literal",
-	203: "hex_literal",
-	204: "hex_literal",
-	205: "hex_literal",
-	206: "hex_literal",
-	207: "hex_literal",
-	208: "hex_literal",
-	209: "hex_literal",
-	210: "hex_literal",
-	211: "hex_literal",
-	212: "hex_literal",
-	213: "hex_literal",
-	214: "hex_literal",
-	215: "hex_literal",
-	216: "hex_literal",
-	217: "float_literal",
-	218: "float_literal",
-	219: "float_literal",
-	220: "float_literal",
-	221: "float_literal",
-	222: "float_literal",
-	223: "float_literal",
-	224: "float_literal",
-	225: "float_literal",
-	226: "float_literal",
-	227: "float_literal",
-	228: "float_literal",
-	229: "float_literal",
-	230: "float_literal",
-	231: "float_literal",
-	232: "float_literal",
-	233: "float_literal",
-	234: "float_literal",
-	235: "float_literal",
-	236: "float_literal",
-	237: "float_literal",
-	238: "float_literal",
-	239: "float_literal",
-	240: "float_literal",
-	241: "float_literal",
-	242: "float_literal",
-	243: "float_literal",
-	244: "float_literal",
-	245: "float_literal",
-	246: "float_literal",
-	247: "float_literal",
-	248: "float_literal",
-	249: "float_literal",
-	250: "float_literal",
-	251: "float_literal",
-	252: "float_literal",
-	253: "float_literal",
-	254: "float_literal",
-	255: "float_literal",
-	256: "float_literal",
-	257: "float_literal",
-	258: "float_literal",
-	259: "float_literal",
-	260: "float_literal",
-	261: "float_literal",
-	262: "float_literal",
-	263: "float_literal",
-	264: "float_literal",
-	265: "float_literal",
-	266: "float_literal",
-	267: "float_literal",
-	268: "float_literal",
-	269: "float_literal",
-	270: "float_literal",
-	271: "float_literal",
-	272: "float_literal",
-	273: "float_literal",
-	274: "float_literal",
-	275: "float_literal",
-	276: "float_literal",
-	277: "float_literal",
-	278: "float_literal",
-	279: "float_literal",
-	280: "float_literal",
-	281: "float_literal",
-	282: "float_literal",
-	283: "float_literal",
-	284: "float_literal",
-	285: "float_literal",
-	286: "float_literal",
 }
@@ -0,0 +1,62 @@
+package lexers
+
+import (
+	"io"
+	"strings"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
+	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+func NewPEMDASActionsLexer(r io.Reader) liblexers.AbstractLexer {
+	return dfa.NewLexer(PEMDASActionsLexerTables, r)
+}
+
+// NewPEMDASActionsLexerFromString returns a lexer over s (convenience for tests and -e mode).
+func NewPEMDASActionsLexerFromString(s string) liblexers.AbstractLexer {
+	return NewPEMDASActionsLexer(strings.NewReader(s))
+}
+
+// PEMDASActionsLexerTables are the lexer's DFA tables, run by the dfa package. ASCII runes are grouped
+// into classes, and ASCIINext has a row per state and a column per class, as dfa.Tables documents.
+var PEMDASActionsLexerTables = &dfa.Tables{
+	StartState: 0,
+	ASCIIClasses: []uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 0, 0, 1, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 0, 0, 0, 0, 0, 0, 0, 2, 3, 4, 5, 0, 6, 0, 7,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	},
+	ASCIIClassCount: 9,
+	ASCIINext: []int32{
+		-1, 1, 2, 3, 4, 5, 6, 7, 8,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, 9, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, 8,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1,
+	},
+	Actions: []tokens.TokenType{
+		1: "!whitespace",
+		2: "lparen",
+		3: "rparen",
+		4: "times",
+		5: "plus",
+		6: "minus",
+		7: "divide",
+		8: "int_literal",
+		9: "exponentiation",
+	},
+	Ignored: []bool{
+		1: true,
+	},
+}
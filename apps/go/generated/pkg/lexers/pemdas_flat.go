@@ -0,0 +1,76 @@
+package lexers
+
+import (
+	"io"
+	"strings"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
+	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+func NewPEMDASFlatLexer(r io.Reader) liblexers.AbstractLexer {
+	return dfa.NewLexer(PEMDASFlatLexerTables, r)
+}
+
+// NewPEMDASFlatLexerFromString returns a lexer over s (convenience for tests and -e mode).
+func NewPEMDASFlatLexerFromString(s string) liblexers.AbstractLexer {
+	return NewPEMDASFlatLexer(strings.NewReader(s))
+}
+
+// PEMDASFlatLexerTables are the lexer's DFA tables, run by the dfa package. ASCII runes are grouped
+// into classes, and ASCIINext has a row per state and a column per class, as dfa.Tables documents.
+var PEMDASFlatLexerTables = &dfa.Tables{
+	StartState: 0,
+	ASCIIClasses: []uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 0, 0, 1, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 0, 0, 0, 0, 2, 0, 0, 3, 4, 5, 6, 0, 7, 8, 9,
+		10, 11, 11, 11, 11, 11, 11, 11, 11, 11, 0, 0, 0, 0, 0, 0,
+		0, 12, 12, 12, 12, 13, 12, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 14, 0, 0, 0, 0, 0, 0, 0,
+		0, 12, 12, 12, 12, 13, 12, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 14, 0, 0, 0, 0, 0, 0, 0,
+	},
+	ASCIIClassCount: 15,
+	ASCIINext: []int32{
+		-1, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, 12, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 13, 13, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, 13, -1, 11, 11, -1, 14, 15,
+		-1, -1, -1, -1, -1, -1, -1, -1, 13, -1, 11, 11, -1, 14, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 13, 13, -1, 14, -1,
+		-1, -1, -1, -1, -1, -1, 16, 16, -1, -1, 17, 17, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 18, 18, 18, 18, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 17, 17, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 17, 17, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 18, 18, 18, 18, -1,
+	},
+	Actions: []tokens.TokenType{
+		1:  "!whitespace",
+		2:  "modulo",
+		3:  "lparen",
+		4:  "rparen",
+		5:  "times",
+		6:  "plus",
+		7:  "minus",
+		9:  "divide",
+		10: "int_literal",
+		11: "int_literal",
+		12: "exponentiation",
+		13: "float_literal",
+		17: "float_literal",
+		18: "hex_literal",
+	},
+	Ignored: []bool{
+		1: true,
+	},
+}
@@ -1,38 +1,16 @@
 package lexers
 
 import (
-	"bufio"
-	"fmt"
 	"io"
 	"strings"
-	"unicode/utf8"
 
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
 	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
 	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
 )
 
-const PEMDASFloatLexerBufSize = 4096
-
-type PEMDASFloatLexer struct {
-	reader        *bufio.Reader
-	buf           []byte
-	tokenStart    int
-	tokenLocation *tokens.TokenLocation
-	atEOF         bool
-}
-
-var _ liblexers.AbstractLexer = (*PEMDASFloatLexer)(nil)
-
 func NewPEMDASFloatLexer(r io.Reader) liblexers.AbstractLexer {
-	reader, ok := r.(*bufio.Reader)
-	if !ok {
-		reader = bufio.NewReader(r)
-	}
-	return &PEMDASFloatLexer{
-		reader:        reader,
-		buf:           make([]byte, 0, PEMDASFloatLexerBufSize),
-		tokenLocation: tokens.NewTokenLocation(),
-	}
+	return dfa.NewLexer(PEMDASFloatLexerTables, r)
 }
 
 // NewPEMDASFloatLexerFromString returns a lexer over s (convenience for tests and -e mode).
@@ -40,2687 +18,54 @@ func NewPEMDASFloatLexerFromString(s string) liblexers.AbstractLexer {
 	return NewPEMDASFloatLexer(strings.NewReader(s))
 }
 
-func (lexer *PEMDASFloatLexer) ensureFill(needBytes int) {
-	for needBytes > len(lexer.buf) && !lexer.atEOF {
-		chunk := make([]byte, PEMDASFloatLexerBufSize)
-		n, err := lexer.reader.Read(chunk)
-		if n > 0 {
-			lexer.buf = append(lexer.buf, chunk[:n]...)
-		}
-		if err == io.EOF {
-			lexer.atEOF = true
-			return
-		}
-		if err != nil {
-			lexer.atEOF = true
-			return
-		}
-	}
-}
-
-func (lexer *PEMDASFloatLexer) peekRuneAt(byteOffset int) (rune, int) {
-	lexer.ensureFill(byteOffset + utf8.UTFMax)
-	if byteOffset >= len(lexer.buf) {
-		return 0, 0
-	}
-	r, width := utf8.DecodeRune(lexer.buf[byteOffset:])
-	if width == 0 {
-		return 0, 0
-	}
-	return r, width
-}
-
-func (lexer *PEMDASFloatLexer) Scan() *tokens.Token {
-	lexer.ensureFill(lexer.tokenStart + 1)
-	if lexer.tokenStart >= len(lexer.buf) && lexer.atEOF {
-		return tokens.NewEOFToken(lexer.tokenLocation)
-	}
-
-	for {
-		if lexer.tokenStart >= len(lexer.buf) {
-			if lexer.atEOF {
-				return tokens.NewEOFToken(lexer.tokenLocation)
-			}
-			lexer.ensureFill(lexer.tokenStart + 1)
-			if lexer.tokenStart >= len(lexer.buf) {
-				return tokens.NewEOFToken(lexer.tokenLocation)
-			}
-		}
-
-		startLocation := *lexer.tokenLocation
-		scanOffset := lexer.tokenStart
-		state := PEMDASFloatLexerStartState
-		lastAcceptState := -1
-		lastAcceptOffset := scanOffset
-
-		for {
-			if scanOffset >= len(lexer.buf) {
-				if !lexer.atEOF {
-					lexer.ensureFill(scanOffset + utf8.UTFMax)
-				}
-				if scanOffset >= len(lexer.buf) {
-					break
-				}
-			}
-			r, width := lexer.peekRuneAt(scanOffset)
-			if width == 0 {
-				break
-			}
-			nextState, ok := PEMDASFloatLexerLookupTransition(state, r)
-			if !ok {
-				break
-			}
-			scanOffset += width
-			state = nextState
-			if _, ok := PEMDASFloatLexerActions[state]; ok {
-				lastAcceptState = state
-				lastAcceptOffset = scanOffset
-			}
-		}
-
-		if lastAcceptState < 0 {
-			r, _ := lexer.peekRuneAt(lexer.tokenStart)
-			return tokens.NewErrorToken(fmt.Sprintf("lexer: unrecognized input %q", r), lexer.tokenLocation)
-		}
-
-		lexemeText := string(lexer.buf[lexer.tokenStart:lastAcceptOffset])
-		lexeme := []rune(lexemeText)
-		for len(lexemeText) > 0 {
-			r, w := utf8.DecodeRuneInString(lexemeText)
-			lexer.tokenLocation.LocateRune(r, w)
-			lexemeText = lexemeText[w:]
-		}
-		lexer.buf = lexer.buf[lastAcceptOffset:]
-		lexer.tokenStart = 0
-		tokenType := PEMDASFloatLexerActions[lastAcceptState]
-		if PEMDASFloatLexerIsIgnoredToken(tokenType) {
-			continue
-		}
-		return tokens.NewToken(lexeme, tokenType, &startLocation)
-	}
-}
-
-func PEMDASFloatLexerLookupTransition(state int, r rune) (int, bool) {
-	transitionsForState, ok := PEMDASFloatLexerTransitions[state]
-	if !ok {
-		return 0, false
-	}
-	for _, tr := range transitionsForState {
-		if r < tr.from {
-			return 0, false
-		}
-		if r >= tr.from && r <= tr.to {
-			return tr.next, true
-		}
-	}
-	return 0, false
-}
-func PEMDASFloatLexerIsIgnoredToken(tokenType tokens.TokenType) bool {
-	return strings.HasPrefix(string(tokenType), "!")
-}
-
-const PEMDASFloatLexerStartState = 0
-
-type PEMDASFloatLexerRangeTransition struct {
-	from rune
-	to   rune
-	next int
-}
-
-var PEMDASFloatLexerTransitions = map[int][]PEMDASFloatLexerRangeTransition{
-	0: {
-		{from: '\t', to: '\t', next: 1},
-		{from: '\n', to: '\n', next: 2},
-		{from: '\r', to: '\r', next: 3},
-		{from: ' ', to: ' ', next: 4},
-		{from: '%', to: '%', next: 5},
-		{from: '(', to: '(', next: 6},
-		{from: ')', to: ')', next: 7},
-		{from: '*', to: '*', next: 8},
-		{from: '+', to: '+', next: 9},
-		{from: '-', to: '-', next: 10},
-		{from: '.', to: '.', next: 11},
-		{from: '/', to: '/', next: 12},
-		{from: '0', to: '0', next: 13},
-		{from: '1', to: '1', next: 14},
-		{from: '2', to: '2', next: 15},
-		{from: '3', to: '3', next: 16},
-		{from: '4', to: '4', next: 17},
-		{from: '5', to: '5', next: 18},
-		{from: '6', to: '6', next: 19},
-		{from: '7', to: '7', next: 20},
-		{from: '8', to: '8', next: 21},
-		{from: '9', to: '9', next: 22},
-	},
-	8: {
-		{from: '*', to: '*', next: 23},
-	},
-	11: {
-		{from: '0', to: '0', next: 24},
-		{from: '1', to: '1', next: 25},
-		{from: '2', to: '2', next: 26},
-		{from: '3', to: '3', next: 27},
-		{from: '4', to: '4', next: 28},
-		{from: '5', to: '5', next: 29},
-		{from: '6', to: '6', next: 30},
-		{from: '7', to: '7', next: 31},
-		{from: '8', to: '8', next: 32},
-		{from: '9', to: '9', next: 33},
-	},
-	13: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	14: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	15: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	16: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	17: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	18: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	19: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	20: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	21: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	22: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	24: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	25: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	26: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	27: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	28: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	29: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	30: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	31: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	32: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	33: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	34: {
-		{from: '0', to: '0', next: 59},
-		{from: '1', to: '1', next: 60},
-		{from: '2', to: '2', next: 61},
-		{from: '3', to: '3', next: 62},
-		{from: '4', to: '4', next: 63},
-		{from: '5', to: '5', next: 64},
-		{from: '6', to: '6', next: 65},
-		{from: '7', to: '7', next: 66},
-		{from: '8', to: '8', next: 67},
-		{from: '9', to: '9', next: 68},
-		{from: 'E', to: 'E', next: 69},
-		{from: 'e', to: 'e', next: 70},
-	},
-	35: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	36: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	37: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	38: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	39: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	40: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	41: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	42: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	43: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	44: {
-		{from: '.', to: '.', next: 34},
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'E', to: 'E', next: 45},
-		{from: 'e', to: 'e', next: 46},
-	},
-	45: {
-		{from: '+', to: '+', next: 71},
-		{from: '-', to: '-', next: 72},
-		{from: '0', to: '0', next: 73},
-		{from: '1', to: '1', next: 74},
-		{from: '2', to: '2', next: 75},
-		{from: '3', to: '3', next: 76},
-		{from: '4', to: '4', next: 77},
-		{from: '5', to: '5', next: 78},
-		{from: '6', to: '6', next: 79},
-		{from: '7', to: '7', next: 80},
-		{from: '8', to: '8', next: 81},
-		{from: '9', to: '9', next: 82},
-	},
-	46: {
-		{from: '+', to: '+', next: 71},
-		{from: '-', to: '-', next: 72},
-		{from: '0', to: '0', next: 73},
-		{from: '1', to: '1', next: 74},
-		{from: '2', to: '2', next: 75},
-		{from: '3', to: '3', next: 76},
-		{from: '4', to: '4', next: 77},
-		{from: '5', to: '5', next: 78},
-		{from: '6', to: '6', next: 79},
-		{from: '7', to: '7', next: 80},
-		{from: '8', to: '8', next: 81},
-		{from: '9', to: '9', next: 82},
-	},
-	47: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	48: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	49: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	50: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	51: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	52: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	53: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	54: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	55: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	56: {
-		{from: '0', to: '0', next: 47},
-		{from: '1', to: '1', next: 48},
-		{from: '2', to: '2', next: 49},
-		{from: '3', to: '3', next: 50},
-		{from: '4', to: '4', next: 51},
-		{from: '5', to: '5', next: 52},
-		{from: '6', to: '6', next: 53},
-		{from: '7', to: '7', next: 54},
-		{from: '8', to: '8', next: 55},
-		{from: '9', to: '9', next: 56},
-		{from: 'E', to: 'E', next: 57},
-		{from: 'e', to: 'e', next: 58},
-	},
-	57: {
-		{from: '+', to: '+', next: 83},
-		{from: '-', to: '-', next: 84},
-		{from: '0', to: '0', next: 85},
-		{from: '1', to: '1', next: 86},
-		{from: '2', to: '2', next: 87},
-		{from: '3', to: '3', next: 88},
-		{from: '4', to: '4', next: 89},
-		{from: '5', to: '5', next: 90},
-		{from: '6', to: '6', next: 91},
-		{from: '7', to: '7', next: 92},
-		{from: '8', to: '8', next: 93},
-		{from: '9', to: '9', next: 94},
-	},
-	58: {
-		{from: '+', to: '+', next: 83},
-		{from: '-', to: '-', next: 84},
-		{from: '0', to: '0', next: 85},
-		{from: '1', to: '1', next: 86},
-		{from: '2', to: '2', next: 87},
-		{from: '3', to: '3', next: 88},
-		{from: '4', to: '4', next: 89},
-		{from: '5', to: '5', next: 90},
-		{from: '6', to: '6', next: 91},
-		{from: '7', to: '7', next: 92},
-		{from: '8', to: '8', next: 93},
-		{from: '9', to: '9', next: 94},
-	},
-	59: {
-		{from: '0', to: '0', next: 59},
-		{from: '1', to: '1', next: 60},
-		{from: '2', to: '2', next: 61},
-		{from: '3', to: '3', next: 62},
-		{from: '4', to: '4', next: 63},
-		{from: '5', to: '5', next: 64},
-		{from: '6', to: '6', next: 65},
-		{from: '7', to: '7', next: 66},
-		{from: '8', to: '8', next: 67},
-		{from: '9', to: '9', next: 68},
-		{from: 'E', to: 'E', next: 69},
-		{from: 'e', to: 'e', next: 70},
-	},
-	60: {
-		{from: '0', to: '0', next: 59},
-		{from: '1', to: '1', next: 60},
-		{from: '2', to: '2', next: 61},
-		{from: '3', to: '3', next: 62},
-		{from: '4', to: '4', next: 63},
-		{from: '5', to: '5', next: 64},
-		{from: '6', to: '6', next: 65},
-		{from: '7', to: '7', next: 66},
-		{from: '8', to: '8', next: 67},
-		{from: '9', to: '9', next: 68},
-		{from: 'E', to: 'E', next: 69},
-		{from: 'e', to: 'e', next: 70},
-	},
-	61: {
-		{from: '0', to: '0', next: 59},
-		{from: '1', to: '1', next: 60},
-		{from: '2', to: '2', next: 61},
-		{from: '3', to: '3', next: 62},
-		{from: '4', to: '4', next: 63},
-		{from: '5', to: '5', next: 64},
-		{from: '6', to: '6', next: 65},
-		{from: '7', to: '7', next: 66},
-		{from: '8', to: '8', next: 67},
-		{from: '9', to: '9', next: 68},
-		{from: 'E', to: 'E', next: 69},
-		{from: 'e', to: 'e', next: 70},
-	},
-	62: {
-		{from: '0', to: '0', next: 59},
-		{from: '1', to: '1', next: 60},
-		{from: '2', to: '2', next: 61},
-		{from: '3', to: '3', next: 62},
-		{from: '4', to: '4', next: 63},
-		{from: '5', to: '5', next: 64},
-		{from: '6', to: '6', next: 65},
-		{from: '7', to: '7', next: 66},
-		{from: '8', to: '8', next: 67},
-		{from: '9', to: '9', next: 68},
-		{from: 'E', to: 'E', next: 69},
-		{from: 'e', to: 'e', next: 70},
-	},
-	63: {
-		{from: '0', to: '0', next: 59},
-		{from: '1', to: '1', next: 60},
-		{from: '2', to: '2', next: 61},
-		{from: '3', to: '3', next: 62},
-		{from: '4', to: '4', next: 63},
-		{from: '5', to: '5', next: 64},
-		{from: '6', to: '6', next: 65},
-		{from: '7', to: '7', next: 66},
-		{from: '8', to: '8', next: 67},
-		{from: '9', to: '9', next: 68},
-		{from: 'E', to: 'E', next: 69},
-		{from: 'e', to: 'e', next: 70},
-	},
-	64: {
-		{from: '0', to: '0', next: 59},
-		{from: '1', to: '1', next: 60},
-		{from: '2', to: '2', next: 61},
-		{from: '3', to: '3', next: 62},
-		{from: '4', to: '4', next: 63},
-		{from: '5', to: '5', next: 64},
-		{from: '6', to: '6', next: 65},
-		{from: '7', to: '7', next: 66},
-		{from: '8', to: '8', next: 67},
-		{from: '9', to: '9', next: 68},
-		{from: 'E', to: 'E', next: 69},
-		{from: 'e', to: 'e', next: 70},
-	},
-	65: {
-		{from: '0', to: '0', next: 59},
-		{from: '1', to: '1', next: 60},
-		{from: '2', to: '2', next: 61},
-		{from: '3', to: '3', next: 62},
-		{from: '4', to: '4', next: 63},
-		{from: '5', to: '5', next: 64},
-		{from: '6', to: '6', next: 65},
-		{from: '7', to: '7', next: 66},
-		{from: '8', to: '8', next: 67},
-		{from: '9', to: '9', next: 68},
-		{from: 'E', to: 'E', next: 69},
-		{from: 'e', to: 'e', next: 70},
-	},
-	66: {
-		{from: '0', to: '0', next: 59},
-		{from: '1', to: '1', next: 60},
-		{from: '2', to: '2', next: 61},
-		{from: '3', to: '3', next: 62},
-		{from: '4', to: '4', next: 63},
-		{from: '5', to: '5', next: 64},
-		{from: '6', to: '6', next: 65},
-		{from: '7', to: '7', next: 66},
-		{from: '8', to: '8', next: 67},
-		{from: '9', to: '9', next: 68},
-		{from: 'E', to: 'E', next: 69},
-		{from: 'e', to: 'e', next: 70},
-	},
-	67: {
-		{from: '0', to: '0', next: 59},
-		{from: '1', to: '1', next: 60},
-		{from: '2', to: '2', next: 61},
-		{from: '3', to: '3', next: 62},
-		{from: '4', to: '4', next: 63},
-		{from: '5', to: '5', next: 64},
-		{from: '6', to: '6', next: 65},
-		{from: '7', to: '7', next: 66},
-		{from: '8', to: '8', next: 67},
-		{from: '9', to: '9', next: 68},
-		{from: 'E', to: 'E', next: 69},
-		{from: 'e', to: 'e', next: 70},
-	},
-	68: {
-		{from: '0', to: '0', next: 59},
-		{from: '1', to: '1', next: 60},
-		{from: '2', to: '2', next: 61},
-		{from: '3', to: '3', next: 62},
-		{from: '4', to: '4', next: 63},
-		{from: '5', to: '5', next: 64},
-		{from: '6', to: '6', next: 65},
-		{from: '7', to: '7', next: 66},
-		{from: '8', to: '8', next: 67},
-		{from: '9', to: '9', next: 68},
-		{from: 'E', to: 'E', next: 69},
-		{from: 'e', to: 'e', next: 70},
-	},
-	69: {
-		{from: '+', to: '+', next: 95},
-		{from: '-', to: '-', next: 96},
-		{from: '0', to: '0', next: 97},
-		{from: '1', to: '1', next: 98},
-		{from: '2', to: '2', next: 99},
-		{from: '3', to: '3', next: 100},
-		{from: '4', to: '4', next: 101},
-		{from: '5', to: '5', next: 102},
-		{from: '6', to: '6', next: 103},
-		{from: '7', to: '7', next: 104},
-		{from: '8', to: '8', next: 105},
-		{from: '9', to: '9', next: 106},
-	},
-	70: {
-		{from: '+', to: '+', next: 95},
-		{from: '-', to: '-', next: 96},
-		{from: '0', to: '0', next: 97},
-		{from: '1', to: '1', next: 98},
-		{from: '2', to: '2', next: 99},
-		{from: '3', to: '3', next: 100},
-		{from: '4', to: '4', next: 101},
-		{from: '5', to: '5', next: 102},
-		{from: '6', to: '6', next: 103},
-		{from: '7', to: '7', next: 104},
-		{from: '8', to: '8', next: 105},
-		{from: '9', to: '9', next: 106},
-	},
-	71: {
-		{from: '0', to: '0', next: 107},
-		{from: '1', to: '1', next: 108},
-		{from: '2', to: '2', next: 109},
-		{from: '3', to: '3', next: 110},
-		{from: '4', to: '4', next: 111},
-		{from: '5', to: '5', next: 112},
-		{from: '6', to: '6', next: 113},
-		{from: '7', to: '7', next: 114},
-		{from: '8', to: '8', next: 115},
-		{from: '9', to: '9', next: 116},
-	},
-	72: {
-		{from: '0', to: '0', next: 107},
-		{from: '1', to: '1', next: 108},
-		{from: '2', to: '2', next: 109},
-		{from: '3', to: '3', next: 110},
-		{from: '4', to: '4', next: 111},
-		{from: '5', to: '5', next: 112},
-		{from: '6', to: '6', next: 113},
-		{from: '7', to: '7', next: 114},
-		{from: '8', to: '8', next: 115},
-		{from: '9', to: '9', next: 116},
-	},
-	73: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	74: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	75: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	76: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	77: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	78: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	79: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	80: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	81: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	82: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	83: {
-		{from: '0', to: '0', next: 127},
-		{from: '1', to: '1', next: 128},
-		{from: '2', to: '2', next: 129},
-		{from: '3', to: '3', next: 130},
-		{from: '4', to: '4', next: 131},
-		{from: '5', to: '5', next: 132},
-		{from: '6', to: '6', next: 133},
-		{from: '7', to: '7', next: 134},
-		{from: '8', to: '8', next: 135},
-		{from: '9', to: '9', next: 136},
-	},
-	84: {
-		{from: '0', to: '0', next: 127},
-		{from: '1', to: '1', next: 128},
-		{from: '2', to: '2', next: 129},
-		{from: '3', to: '3', next: 130},
-		{from: '4', to: '4', next: 131},
-		{from: '5', to: '5', next: 132},
-		{from: '6', to: '6', next: 133},
-		{from: '7', to: '7', next: 134},
-		{from: '8', to: '8', next: 135},
-		{from: '9', to: '9', next: 136},
-	},
-	85: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	86: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	87: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	88: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	89: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	90: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	91: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	92: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	93: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	94: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
+// PEMDASFloatLexerTables are the lexer's DFA tables, run by the dfa package. ASCII runes are grouped
+// into classes, and ASCIINext has a row per state and a column per class, as dfa.Tables documents.
+var PEMDASFloatLexerTables = &dfa.Tables{
+	StartState: 0,
+	ASCIIClasses: []uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 0, 0, 1, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 0, 0, 0, 0, 2, 0, 0, 3, 4, 5, 6, 0, 7, 8, 9,
+		10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 11, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 11, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	},
+	ASCIIClassCount: 12,
+	ASCIINext: []int32{
+		-1, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, 11, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 12, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, 12, -1, 10, 13,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 12, 13,
+		-1, -1, -1, -1, -1, -1, 14, 14, -1, -1, 15, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 15, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 15, -1,
+	},
+	Actions: []tokens.TokenType{
+		1:  "!whitespace",
+		2:  "modulo",
+		3:  "lparen",
+		4:  "rparen",
+		5:  "times",
+		6:  "plus",
+		7:  "minus",
+		9:  "divide",
+		10: "int_literal",
+		11: "exponentiation",
+		12: "float_literal",
+		15: "float_literal",
+	},
+	Ignored: []bool{
+		1: true,
 	},
-	95: {
-		{from: '0', to: '0', next: 147},
-		{from: '1', to: '1', next: 148},
-		{from: '2', to: '2', next: 149},
-		{from: '3', to: '3', next: 150},
-		{from: '4', to: '4', next: 151},
-		{from: '5', to: '5', next: 152},
-		{from: '6', to: '6', next: 153},
-		{from: '7', to: '7', next: 154},
-		{from: '8', to: '8', next: 155},
-		{from: '9', to: '9', next: 156},
-	},
-	96: {
-		{from: '0', to: '0', next: 147},
-		{from: '1', to: '1', next: 148},
-		{from: '2', to: '2', next: 149},
-		{from: '3', to: '3', next: 150},
-		{from: '4', to: '4', next: 151},
-		{from: '5', to: '5', next: 152},
-		{from: '6', to: '6', next: 153},
-		{from: '7', to: '7', next: 154},
-		{from: '8', to: '8', next: 155},
-		{from: '9', to: '9', next: 156},
-	},
-	97: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	98: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	99: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	100: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	101: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	102: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	103: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	104: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	105: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	106: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	107: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	108: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	109: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	110: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	111: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	112: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	113: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	114: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	115: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	116: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	117: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	118: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	119: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	120: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	121: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	122: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	123: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	124: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	125: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	126: {
-		{from: '0', to: '0', next: 117},
-		{from: '1', to: '1', next: 118},
-		{from: '2', to: '2', next: 119},
-		{from: '3', to: '3', next: 120},
-		{from: '4', to: '4', next: 121},
-		{from: '5', to: '5', next: 122},
-		{from: '6', to: '6', next: 123},
-		{from: '7', to: '7', next: 124},
-		{from: '8', to: '8', next: 125},
-		{from: '9', to: '9', next: 126},
-	},
-	127: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	128: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	129: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	130: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	131: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	132: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	133: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	134: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	135: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	136: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	137: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	138: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	139: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	140: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	141: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	142: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	143: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	144: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	145: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	146: {
-		{from: '0', to: '0', next: 137},
-		{from: '1', to: '1', next: 138},
-		{from: '2', to: '2', next: 139},
-		{from: '3', to: '3', next: 140},
-		{from: '4', to: '4', next: 141},
-		{from: '5', to: '5', next: 142},
-		{from: '6', to: '6', next: 143},
-		{from: '7', to: '7', next: 144},
-		{from: '8', to: '8', next: 145},
-		{from: '9', to: '9', next: 146},
-	},
-	147: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	148: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	149: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	150: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	151: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	152: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	153: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	154: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	155: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	156: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	157: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	158: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	159: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	160: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	161: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	162: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	163: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	164: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	165: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	166: {
-		{from: '0', to: '0', next: 157},
-		{from: '1', to: '1', next: 158},
-		{from: '2', to: '2', next: 159},
-		{from: '3', to: '3', next: 160},
-		{from: '4', to: '4', next: 161},
-		{from: '5', to: '5', next: 162},
-		{from: '6', to: '6', next: 163},
-		{from: '7', to: '7', next: 164},
-		{from: '8', to: '8', next: 165},
-		{from: '9', to: '9', next: 166},
-	},
-	167: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	168: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	169: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	170: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	171: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	172: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	173: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	174: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	175: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	176: {
-		{from: '0', to: '0', next: 167},
-		{from: '1', to: '1', next: 168},
-		{from: '2', to: '2', next: 169},
-		{from: '3', to: '3', next: 170},
-		{from: '4', to: '4', next: 171},
-		{from: '5', to: '5', next: 172},
-		{from: '6', to: '6', next: 173},
-		{from: '7', to: '7', next: 174},
-		{from: '8', to: '8', next: 175},
-		{from: '9', to: '9', next: 176},
-	},
-	177: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	178: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	179: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	180: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	181: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	182: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	183: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	184: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	185: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	186: {
-		{from: '0', to: '0', next: 177},
-		{from: '1', to: '1', next: 178},
-		{from: '2', to: '2', next: 179},
-		{from: '3', to: '3', next: 180},
-		{from: '4', to: '4', next: 181},
-		{from: '5', to: '5', next: 182},
-		{from: '6', to: '6', next: 183},
-		{from: '7', to: '7', next: 184},
-		{from: '8', to: '8', next: 185},
-		{from: '9', to: '9', next: 186},
-	},
-	187: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	188: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	189: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	190: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	191: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	192: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	193: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	194: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	195: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-	196: {
-		{from: '0', to: '0', next: 187},
-		{from: '1', to: '1', next: 188},
-		{from: '2', to: '2', next: 189},
-		{from: '3', to: '3', next: 190},
-		{from: '4', to: '4', next: 191},
-		{from: '5', to: '5', next: 192},
-		{from: '6', to: '6', next: 193},
-		{from: '7', to: '7', next: 194},
-		{from: '8', to: '8', next: 195},
-		{from: '9', to: '9', next: 196},
-	},
-}
-
-var PEMDASFloatLexerActions = map[int]tokens.TokenType{
-	1:   "!whitespace",
-	2:   "!whitespace",
-	3:   "!whitespace",
-	4:   "!whitespace",
-	5:   "modulo",
-	6:   "lparen",
-	7:   "rparen",
-	8:   "times",
-	9:   "plus",
-	10:  "minus",
-	12:  "divide",
-	13:  "int_literal",
-	14:  "int_literal",
-	15:  "int_literal",
-	16:  "int_literal",
-	17:  "int_literal",
-	18:  "int_literal",
-	19:  "int_literal",
-	20:  "int_literal",
-	21:  "int_literal",
-	22:  "int_literal",
-	23:  "exponentiation",
-	24:  "float_literal",
-	25:  "float_literal",
-	26:  "float_literal",
-	27:  "float_literal",
-	28:  "float_literal",
-	29:  "float_literal",
-	30:  "float_literal",
-	31:  "float_literal",
-	32:  "float_literal",
-	33:  "float_literal",
-	34:  "float_literal",
-	35:  "int_literal",
-	36:  "int_literal",
-	37:  "int_literal",
-	38:  "int_literal",
-	39:  "int_literal",
-	40:  "int_literal",
-	41:  "int_literal",
-	42:  "int_literal",
-	43:  "int_literal",
-	44:  "int_literal",
-	47:  "float_literal",
-	48:  "float_literal",
-	49:  "float_literal",
-	50:  "float_literal",
-	51:  "float_literal",
-	52:  "float_literal",
-	53:  "float_literal",
-	54:  "float_literal",
-	55:  "float_literal",
-	56:  "float_literal",
-	59:  "float_literal",
-	60:  "float_literal",
-	61:  "float_literal",
-	62:  "float_literal",
-	63:  "float_literal",
-	64:  "float_literal",
-	65:  "float_literal",
-	66:  "float_literal",
-	67:  "float_literal",
-	68:  "float_literal",
-	73:  "float_literal",
-	74:  "float_literal",
-	75:  "float_literal",
-	76:  "float_literal",
-	77:  "float_literal",
-	78:  "float_literal",
-	79:  "float_literal",
-	80:  "float_literal",
-	81:  "float_literal",
-	82:  "float_literal",
-	85:  "float_literal",
-	86:  "float_literal",
-	87:  "float_literal",
-	88:  "float_literal",
-	89:  "float_literal",
-	90:  "float_literal",
-	91:  "float_literal",
-	92:  "float_literal",
-	93:  "float_literal",
-	94:  "float_literal",
-	97:  "float_literal",
-	98:  "float_literal",
-	99:  "float_literal",
-	100: "float_literal",
-	101: "float_literal",
-	102: "float_literal",
-	103: "float_literal",
-	104: "float_literal",
-	105: "float_literal",
-	106: "float_literal",
-	107: "float_literal",
-	108: "float_literal",
-	109: "float_literal",
-	110: "float_literal",
-	111: "float_literal",
-	112: "float_literal",
-	113: "float_literal",
-	114: "float_literal",
-	115: "float_literal",
-	116: "float_literal",
-	117: "float_literal",
-	118: "float_literal",
-	119: "float_literal",
-	120: "float_literal",
-	121: "float_literal",
-	122: "float_literal",
-	123: "float_literal",
-	124: "float_literal",
-	125: "float_literal",
-	126: "float_literal",
-	127: "float_literal",
-	128: "float_literal",
-	129: "float_literal",
-	130: "float_literal",
-	131: "float_literal",
-	132: "float_literal",
-	133: "float_literal",
-	134: "float_literal",
-	135: "float_literal",
-	136: "float_literal",
-	137: "float_literal",
-	138: "float_literal",
-	139: "float_literal",
-	140: "float_literal",
-	141: "float_literal",
-	142: "float_literal",
-	143: "float_literal",
-	144: "float_literal",
-	145: "float_literal",
-	146: "float_literal",
-	147: "float_literal",
-	148: "float_literal",
-	149: "float_literal",
-	150: "float_literal",
-	151: "float_literal",
-	152: "float_literal",
-	153: "float_literal",
-	154: "float_literal",
-	155: "float_literal",
-	156: "float_literal",
-	157: "float_literal",
-	158: "float_literal",
-	159: "float_literal",
-	160: "float_literal",
-	161: "float_literal",
-	162: "float_literal",
-	163: "float_literal",
-	164: "float_literal",
-	165: "float_literal",
-	166: "float_literal",
-	167: "float_literal",
-	168: "float_literal",
-	169: "float_literal",
-	170: "float_literal",
-	171: "float_literal",
-	172: "float_literal",
-	173: "float_literal",
-	174: "float_literal",
-	175: "float_literal",
-	176: "float_literal",
-	177: "float_literal",
-	178: "float_literal",
-	179: "float_literal",
-	180: "float_literal",
-	181: "float_literal",
-	182: "float_literal",
-	183: "float_literal",
-	184: "float_literal",
-	185: "float_literal",
-	186: "float_literal",
-	187: "float_literal",
-	188: "float_literal",
-	189: "float_literal",
-	190: "float_literal",
-	191: "float_literal",
-	192: "float_literal",
-	193: "float_literal",
-	194: "float_literal",
-	195: "float_literal",
-	196: "float_literal",
 }
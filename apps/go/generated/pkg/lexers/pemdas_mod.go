@@ -1,38 +1,16 @@
 package lexers
 
 import (
-	"bufio"
-	"fmt"
 	"io"
 	"strings"
-	"unicode/utf8"
 
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
 	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
 	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
 )
 
-const PEMDASModLexerBufSize = 4096
-
-type PEMDASModLexer struct {
-	reader        *bufio.Reader
-	buf           []byte
-	tokenStart    int
-	tokenLocation *tokens.TokenLocation
-	atEOF         bool
-}
-
-var _ liblexers.AbstractLexer = (*PEMDASModLexer)(nil)
-
 func NewPEMDASModLexer(r io.Reader) liblexers.AbstractLexer {
-	reader, ok := r.(*bufio.Reader)
-	if !ok {
-		reader = bufio.NewReader(r)
-	}
-	return &PEMDASModLexer{
-		reader:        reader,
-		buf:           make([]byte, 0, PEMDASModLexerBufSize),
-		tokenLocation: tokens.NewTokenLocation(),
-	}
+	return dfa.NewLexer(PEMDASModLexerTables, r)
 }
 
 // NewPEMDASModLexerFromString returns a lexer over s (convenience for tests and -e mode).
@@ -40,2683 +18,52 @@ func NewPEMDASModLexerFromString(s string) liblexers.AbstractLexer {
 	return NewPEMDASModLexer(strings.NewReader(s))
 }
 
-func (lexer *PEMDASModLexer) ensureFill(needBytes int) {
-	for needBytes > len(lexer.buf) && !lexer.atEOF {
-		chunk := make([]byte, PEMDASModLexerBufSize)
-		n, err := lexer.reader.Read(chunk)
-		if n > 0 {
-			lexer.buf = append(lexer.buf, chunk[:n]...)
-		}
-		if err == io.EOF {
-			lexer.atEOF = true
-			return
-		}
-		if err != nil {
-			lexer.atEOF = true
-			return
-		}
-	}
-}
-
-func (lexer *PEMDASModLexer) peekRuneAt(byteOffset int) (rune, int) {
-	lexer.ensureFill(byteOffset + utf8.UTFMax)
-	if byteOffset >= len(lexer.buf) {
-		return 0, 0
-	}
-	r, width := utf8.DecodeRune(lexer.buf[byteOffset:])
-	if width == 0 {
-		return 0, 0
-	}
-	return r, width
-}
-
-func (lexer *PEMDASModLexer) Scan() *tokens.Token {
-	lexer.ensureFill(lexer.tokenStart + 1)
-	if lexer.tokenStart >= len(lexer.buf) && lexer.atEOF {
-		return tokens.NewEOFToken(lexer.tokenLocation)
-	}
-
-	for {
-		if lexer.tokenStart >= len(lexer.buf) {
-			if lexer.atEOF {
-				return tokens.NewEOFToken(lexer.tokenLocation)
-			}
-			lexer.ensureFill(lexer.tokenStart + 1)
-			if lexer.tokenStart >= len(lexer.buf) {
-				return tokens.NewEOFToken(lexer.tokenLocation)
-			}
-		}
-
-		startLocation := *lexer.tokenLocation
-		scanOffset := lexer.tokenStart
-		state := PEMDASModLexerStartState
-		lastAcceptState := -1
-		lastAcceptOffset := scanOffset
-
-		for {
-			if scanOffset >= len(lexer.buf) {
-				if !lexer.atEOF {
-					lexer.ensureFill(scanOffset + utf8.UTFMax)
-				}
-				if scanOffset >= len(lexer.buf) {
-					break
-				}
-			}
-			r, width := lexer.peekRuneAt(scanOffset)
-			if width == 0 {
-				break
-			}
-			nextState, ok := PEMDASModLexerLookupTransition(state, r)
-			if !ok {
-				break
-			}
-			scanOffset += width
-			state = nextState
-			if _, ok := PEMDASModLexerActions[state]; ok {
-				lastAcceptState = state
-				lastAcceptOffset = scanOffset
-			}
-		}
-
-		if lastAcceptState < 0 {
-			r, _ := lexer.peekRuneAt(lexer.tokenStart)
-			return tokens.NewErrorToken(fmt.Sprintf("lexer: unrecognized input %q", r), lexer.tokenLocation)
-		}
-
-		lexemeText := string(lexer.buf[lexer.tokenStart:lastAcceptOffset])
-		lexeme := []rune(lexemeText)
-		for len(lexemeText) > 0 {
-			r, w := utf8.DecodeRuneInString(lexemeText)
-			lexer.tokenLocation.LocateRune(r, w)
-			lexemeText = lexemeText[w:]
-		}
-		lexer.buf = lexer.buf[lastAcceptOffset:]
-		lexer.tokenStart = 0
-		tokenType := PEMDASModLexerActions[lastAcceptState]
-		if PEMDASModLexerIsIgnoredToken(tokenType) {
-			continue
-		}
-		return tokens.NewToken(lexeme, tokenType, &startLocation)
-	}
-}
-
-func PEMDASModLexerLookupTransition(state int, r rune) (int, bool) {
-	transitionsForState, ok := PEMDASModLexerTransitions[state]
-	if !ok {
-		return 0, false
-	}
-	for _, tr := range transitionsForState {
-		if r < tr.from {
-			return 0, false
-		}
-		if r >= tr.from && r <= tr.to {
-			return tr.next, true
-		}
-	}
-	return 0, false
-}
-func PEMDASModLexerIsIgnoredToken(tokenType tokens.TokenType) bool {
-	return strings.HasPrefix(string(tokenType), "!")
-}
-
-const PEMDASModLexerStartState = 0
-
-type PEMDASModLexerRangeTransition struct {
-	from rune
-	to   rune
-	next int
-}
-
-var PEMDASModLexerTransitions = map[int][]PEMDASModLexerRangeTransition{
-	0: {
-		{from: '\t', to: '\t', next: 1},
-		{from: '\n', to: '\n', next: 2},
-		{from: '\r', to: '\r', next: 3},
-		{from: ' ', to: ' ', next: 4},
-		{from: '%', to: '%', next: 5},
-		{from: '(', to: '(', next: 6},
-		{from: ')', to: ')', next: 7},
-		{from: '*', to: '*', next: 8},
-		{from: '+', to: '+', next: 9},
-		{from: '-', to: '-', next: 10},
-		{from: '/', to: '/', next: 11},
-		{from: '0', to: '0', next: 12},
-		{from: '1', to: '1', next: 13},
-		{from: '2', to: '2', next: 14},
-		{from: '3', to: '3', next: 15},
-		{from: '4', to: '4', next: 16},
-		{from: '5', to: '5', next: 17},
-		{from: '6', to: '6', next: 18},
-		{from: '7', to: '7', next: 19},
-		{from: '8', to: '8', next: 20},
-		{from: '9', to: '9', next: 21},
-	},
-	8: {
-		{from: '*', to: '*', next: 22},
-	},
-	12: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-		{from: 'X', to: 'X', next: 33},
-		{from: 'x', to: 'x', next: 34},
-	},
-	13: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	14: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	15: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	16: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	17: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	18: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	19: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	20: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	21: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	23: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	24: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	25: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	26: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	27: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	28: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	29: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	30: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	31: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	32: {
-		{from: '0', to: '0', next: 23},
-		{from: '1', to: '1', next: 24},
-		{from: '2', to: '2', next: 25},
-		{from: '3', to: '3', next: 26},
-		{from: '4', to: '4', next: 27},
-		{from: '5', to: '5', next: 28},
-		{from: '6', to: '6', next: 29},
-		{from: '7', to: '7', next: 30},
-		{from: '8', to: '8', next: 31},
-		{from: '9', to: '9', next: 32},
-	},
-	33: {
-		{from: '0', to: '0', next: 35},
-		{from: '1', to: '1', next: 36},
-		{from: '2', to: '2', next: 37},
-		{from: '3', to: '3', next: 38},
-		{from: '4', to: '4', next: 39},
-		{from: '5', to: '5', next: 40},
-		{from: '6', to: '6', next: 41},
-		{from: '7', to: '7', next: 42},
-		{from: '8', to: '8', next: 43},
-		{from: '9', to: '9', next: 44},
-		{from: 'A', to: 'A', next: 45},
-		{from: 'B', to: 'B', next: 46},
-		{from: 'C', to: 'C', next: 47},
-		{from: 'D', to: 'D', next: 48},
-		{from: 'E', to: 'E', next: 49},
-		{from: 'F', to: 'F', next: 50},
-		{from: 'a', to: 'a', next: 51},
-		{from: 'b', to: 'b', next: 52},
-		{from: 'c', to: 'c', next: 53},
-		{from: 'd', to: 'd', next: 54},
-		{from: 'e', to: 'e', next: 55},
-		{from: 'f', to: 'f', next: 56},
-	},
-	34: {
-		{from: '0', to: '0', next: 57},
-		{from: '1', to: '1', next: 58},
-		{from: '2', to: '2', next: 59},
-		{from: '3', to: '3', next: 60},
-		{from: '4', to: '4', next: 61},
-		{from: '5', to: '5', next: 62},
-		{from: '6', to: '6', next: 63},
-		{from: '7', to: '7', next: 64},
-		{from: '8', to: '8', next: 65},
-		{from: '9', to: '9', next: 66},
-		{from: 'A', to: 'A', next: 67},
-		{from: 'B', to: 'B', next: 68},
-		{from: 'C', to: 'C', next: 69},
-		{from: 'D', to: 'D', next: 70},
-		{from: 'E', to: 'E', next: 71},
-		{from: 'F', to: 'F', next: 72},
-		{from: 'a', to: 'a', next: 73},
-		{from: 'b', to: 'b', next: 74},
-		{from: 'c', to: 'c', next: 75},
-		{from: 'd', to: 'd', next: 76},
-		{from: 'e', to: 'e', next: 77},
-		{from: 'f', to: 'f', next: 78},
-	},
-	35: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	36: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	37: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	38: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	39: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	40: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	41: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	42: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	43: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	44: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	45: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	46: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	47: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	48: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	49: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	50: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	51: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	52: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	53: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	54: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	55: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	56: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	57: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
+// PEMDASModLexerTables are the lexer's DFA tables, run by the dfa package. ASCII runes are grouped
+// into classes, and ASCIINext has a row per state and a column per class, as dfa.Tables documents.
+var PEMDASModLexerTables = &dfa.Tables{
+	StartState: 0,
+	ASCIIClasses: []uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 0, 0, 1, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 0, 0, 0, 0, 2, 0, 0, 3, 4, 5, 6, 0, 7, 0, 8,
+		9, 10, 10, 10, 10, 10, 10, 10, 10, 10, 0, 0, 0, 0, 0, 0,
+		0, 11, 11, 11, 11, 11, 11, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 12, 0, 0, 0, 0, 0, 0, 0,
+		0, 11, 11, 11, 11, 11, 11, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 12, 0, 0, 0, 0, 0, 0, 0,
+	},
+	ASCIIClassCount: 13,
+	ASCIINext: []int32{
+		-1, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, 11, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, 10, 10, -1, 12,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, 10, 10, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, 13, 13, 13, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1, -1, 13, 13, 13, -1,
+	},
+	Actions: []tokens.TokenType{
+		1:  "!whitespace",
+		2:  "modulo",
+		3:  "lparen",
+		4:  "rparen",
+		5:  "times",
+		6:  "plus",
+		7:  "minus",
+		8:  "divide",
+		9:  "int_literal",
+		10: "int_literal",
+		11: "exponentiation",
+		13: "hex_literal",
+	},
+	Ignored: []bool{
+		1: true,
 	},
-	58: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	59: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	60: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	61: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	62: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	63: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	64: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	65: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	66: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	67: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	68: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	69: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	70: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	71: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	72: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	73: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	74: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	75: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	76: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	77: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	78: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	79: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	80: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	81: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	82: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	83: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	84: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	85: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	86: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	87: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	88: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	89: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	90: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	91: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	92: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	93: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	94: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	95: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	96: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	97: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	98: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	99: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	100: {
-		{from: '0', to: '0', next: 79},
-		{from: '1', to: '1', next: 80},
-		{from: '2', to: '2', next: 81},
-		{from: '3', to: '3', next: 82},
-		{from: '4', to: '4', next: 83},
-		{from: '5', to: '5', next: 84},
-		{from: '6', to: '6', next: 85},
-		{from: '7', to: '7', next: 86},
-		{from: '8', to: '8', next: 87},
-		{from: '9', to: '9', next: 88},
-		{from: 'A', to: 'A', next: 89},
-		{from: 'B', to: 'B', next: 90},
-		{from: 'C', to: 'C', next: 91},
-		{from: 'D', to: 'D', next: 92},
-		{from: 'E', to: 'E', next: 93},
-		{from: 'F', to: 'F', next: 94},
-		{from: 'a', to: 'a', next: 95},
-		{from: 'b', to: 'b', next: 96},
-		{from: 'c', to: 'c', next: 97},
-		{from: 'd', to: 'd', next: 98},
-		{from: 'e', to: 'e', next: 99},
-		{from: 'f', to: 'f', next: 100},
-	},
-	101: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	102: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	103: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	104: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	105: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	106: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	107: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	108: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	109: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	110: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	111: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	112: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	113: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	114: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	115: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	116: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	117: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	118: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	119: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	120: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	121: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-	122: {
-		{from: '0', to: '0', next: 101},
-		{from: '1', to: '1', next: 102},
-		{from: '2', to: '2', next: 103},
-		{from: '3', to: '3', next: 104},
-		{from: '4', to: '4', next: 105},
-		{from: '5', to: '5', next: 106},
-		{from: '6', to: '6', next: 107},
-		{from: '7', to: '7', next: 108},
-		{from: '8', to: '8', next: 109},
-		{from: '9', to: '9', next: 110},
-		{from: 'A', to: 'A', next: 111},
-		{from: 'B', to: 'B', next: 112},
-		{from: 'C', to: 'C', next: 113},
-		{from: 'D', to: 'D', next: 114},
-		{from: 'E', to: 'E', next: 115},
-		{from: 'F', to: 'F', next: 116},
-		{from: 'a', to: 'a', next: 117},
-		{from: 'b', to: 'b', next: 118},
-		{from: 'c', to: 'c', next: 119},
-		{from: 'd', to: 'd', next: 120},
-		{from: 'e', to: 'e', next: 121},
-		{from: 'f', to: 'f', next: 122},
-	},
-}
-
-var PEMDASModLexerActions = map[int]tokens.TokenType{
-	1:   "!whitespace",
-	2:   "!whitespace",
-	3:   "!whitespace",
-	4:   "!whitespace",
-	5:   "modulo",
-	6:   "lparen",
-	7:   "rparen",
-	8:   "times",
-	9:   "plus",
-	10:  "minus",
-	11:  "divide",
-	12:  "int_literal",
-	13:  "int_literal",
-	14:  "int_literal",
-	15:  "int_literal",
-	16:  "int_literal",
-	17:  "int_literal",
-	18:  "int_literal",
-	19:  "int_literal",
-	20:  "int_literal",
-	21:  "int_literal",
-	22:  "exponentiation",
-	23:  "int_literal",
-	24:  "int_literal",
-	25:  "int_literal",
-	26:  "int_literal",
-	27:  "int_literal",
-	28:  "int_literal",
-	29:  "int_literal",
-	30:  "int_literal",
-	31:  "int_literal",
-	32:  "int_literal",
-	35:  "hex_literal",
-	36:  "hex_literal",
-	37:  "hex_literal",
-	38:  "hex_literal",
-	39:  "hex_literal",
-	40:  "hex_literal",
-	41:  "hex_literal",
-	42:  "hex_literal",
-	43:  "hex_literal",
-	44:  "hex_literal",
-	45:  "hex_literal",
-	46:  "hex_literal",
-	47:  "hex_literal",
-	48:  "hex_literal",
-	49:  "hex_literal",
-	50:  "hex_literal",
-	51:  "hex_literal",
-	52:  "hex_literal",
-	53:  "hex_literal",
-	54:  "hex_literal",
-	55:  "hex_literal",
-	56:  "hex_literal",
-	57:  "hex_literal",
-	58:  "hex_literal",
-	59:  "hex_literal",
-	60:  "hex_literal",
-	61:  "hex_literal",
-	62:  "hex_literal",
-	63:  "hex_literal",
-	64:  "hex_literal",
-	65:  "hex_literal",
-	66:  "hex_literal",
-	67:  "hex_literal",
-	68:  "hex_literal",
-	69:  "hex_literal",
-	70:  "hex_literal",
-	71:  "hex_literal",
-	72:  "hex_literal",
-	73:  "hex_literal",
-	74:  "hex_literal",
-	75:  "hex_literal",
-	76:  "hex_literal",
-	77:  "hex_literal",
-	78:  "hex_literal",
-	79:  "hex_literal",
-	80:  "hex_literal",
-	81:  "hex_literal",
-	82:  "hex_literal",
-	83:  "hex_literal",
-	84:  "hex_literal",
-	85:  "hex_literal",
-	86:  "hex_literal",
-	87:  "hex_literal",
-	88:  "hex_literal",
-	89:  "hex_literal",
-	90:  "hex_literal",
-	91:  "hex_literal",
-	92:  "hex_literal",
-	93:  "hex_literal",
-	94:  "hex_literal",
-	95:  "hex_literal",
-	96:  "hex_literal",
-	97:  "hex_literal",
-	98:  "hex_literal",
-	99:  "hex_literal",
-	100: "hex_literal",
-	101: "hex_literal",
-	102: "hex_literal",
-	103: "hex_literal",
-	104: "hex_literal",
-	105: "hex_literal",
-	106: "hex_literal",
-	107: "hex_literal",
-	108: "hex_literal",
-	109: "hex_literal",
-	110: "hex_literal",
-	111: "hex_literal",
-	112: "hex_literal",
-	113: "hex_literal",
-	114: "hex_literal",
-	115: "hex_literal",
-	116: "hex_literal",
-	117: "hex_literal",
-	118: "hex_literal",
-	119: "hex_literal",
-	120: "hex_literal",
-	121: "hex_literal",
-	122: "hex_literal",
 }
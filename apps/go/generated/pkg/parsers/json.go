@@ -1,1284 +1,133 @@
 package parsers
 
 import (
-	"fmt"
-	"os"
-	"strings"
-
 	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
 	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
 	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
 )
 
+// JSONParser parses with JSONParserTables. Parsing, error handling, and the parser's
+// options are those of lr.Parser.
 type JSONParser struct {
-	Trace            *JSONParserTraceHooks
-	stashedLookahead *tokens.Token
-}
-
-type JSONParserTraceHooks struct {
-	OnToken  func(tok *tokens.Token)
-	OnAction func(state int, action JSONParserAction, lookahead *tokens.Token)
-	OnStack  func(stateStack []int, nodeStack []*asts.ASTNode)
-}
-
-func NewJSONParser() *JSONParser { return &JSONParser{} }
-
-// noASTSentinel is used as a placeholder on the node stack when astMode == "noast".
-var JSONParserNoASTSentinel = &asts.ASTNode{}
-
-func (parser *JSONParser) Parse(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {
-	if lexer == nil {
-		return nil, fmt.Errorf("parser: nil lexer")
-	}
-	stateStack := []int{0}
-	nodeStack := []*asts.ASTNode{}
-	lookahead := lexer.Scan()
-	if parser.Trace != nil && parser.Trace.OnToken != nil {
-		parser.Trace.OnToken(lookahead)
-	}
-	for {
-		if lookahead == nil {
-			return nil, fmt.Errorf("parser: lexer returned nil token")
-		}
-		if lookahead.Type == tokens.TokenTypeError {
-			return nil, fmt.Errorf("lexer error: %s", string(lookahead.Lexeme))
-		}
-		state := stateStack[len(stateStack)-1]
-		action, ok := JSONParserActions[state][lookahead.Type]
-		if !ok {
-			return nil, fmt.Errorf("parse error: unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme))
-		}
-		if parser.Trace != nil && parser.Trace.OnAction != nil {
-			parser.Trace.OnAction(state, action, lookahead)
-		}
-		switch action.Kind {
-		case JSONParserActionShift:
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, JSONParserNoASTSentinel)
-			} else {
-				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
-			}
-			stateStack = append(stateStack, action.Target)
-			lookahead = lexer.Scan()
-			if parser.Trace != nil && parser.Trace.OnToken != nil {
-				parser.Trace.OnToken(lookahead)
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case JSONParserActionReduce:
-			prod := JSONParserProductions[action.Target]
-			rhsNodes := make([]*asts.ASTNode, prod.rhsCount)
-			for i := prod.rhsCount - 1; i >= 0; i-- {
-				stateStack = stateStack[:len(stateStack)-1]
-				rhsNodes[i] = nodeStack[len(nodeStack)-1]
-				nodeStack = nodeStack[:len(nodeStack)-1]
-			}
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, JSONParserNoASTSentinel)
-			} else {
-				var node *asts.ASTNode
-				useFullTree := (astMode == "fullast")
-				if !useFullTree && prod.hasPassthrough {
-					node = rhsNodes[prod.passthroughIndex]
-				} else if !useFullTree && prod.hasWithAppendedChildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					if parent != nil && parent.Children != nil {
-						newChildren = append(newChildren, parent.Children...)
-					}
-					for _, ci := range prod.withAppendedChildren {
-						newChildren = append(newChildren, rhsNodes[ci])
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasWithPrependedChildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					for _, ci := range prod.withPrependedChildren {
-						newChildren = append(newChildren, rhsNodes[ci])
-					}
-					if parent != nil && parent.Children != nil {
-						newChildren = append(newChildren, parent.Children...)
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasWithAdoptedGrandchildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					for _, ci := range prod.withAdoptedGrandchildren {
-						childNode := rhsNodes[ci]
-						if childNode != nil && childNode.Children != nil {
-							newChildren = append(newChildren, childNode.Children...)
-						}
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasHint {
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = prod.lhs
-					}
-					var parentToken *tokens.Token
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-					} else if prod.parentIndex >= 0 && prod.parentIndex < len(rhsNodes) {
-						parentToken = rhsNodes[prod.parentIndex].Token
-					}
-					hintChildren := make([]*asts.ASTNode, len(prod.childIndices))
-					for i, ci := range prod.childIndices {
-						hintChildren[i] = rhsNodes[ci]
-					}
-					node = asts.NewASTNode(parentToken, nodeType, hintChildren)
-				} else if prod.rhsCount == 1 {
-					node = rhsNodes[0]
-				} else if prod.rhsCount == 0 {
-					node = asts.NewASTNode(nil, prod.lhs, []*asts.ASTNode{})
-				} else {
-					node = asts.NewASTNode(nil, prod.lhs, rhsNodes)
-				}
-				nodeStack = append(nodeStack, node)
-			}
-			state = stateStack[len(stateStack)-1]
-			nextState, ok := JSONParserGotos[state][prod.lhs]
-			if !ok {
-				return nil, fmt.Errorf("parse error: missing goto for %s", prod.lhs)
-			}
-			stateStack = append(stateStack, nextState)
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case JSONParserActionAccept:
-			if len(nodeStack) != 1 {
-				return nil, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			if astMode == "noast" {
-				return nil, nil
-			}
-			return asts.NewAST(nodeStack[0]), nil
-		case JSONParserActionAcceptAndYield:
-			return nil, fmt.Errorf("parse error: multiple objects; use ParseOne for multi-object input")
-		default:
-			return nil, fmt.Errorf("parse error: no action")
-		}
-	}
-}
-
-// ParseOne parses one record from the lexer. It is for multi-object input: call in a loop until done.
-// Returns (ast, true, nil) on EOF after a record, (ast, false, nil) when more input follows, or (nil, false, err) on error.
-func (parser *JSONParser) ParseOne(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, bool, error) {
-	if lexer == nil {
-		return nil, false, fmt.Errorf("parser: nil lexer")
-	}
-	stateStack := []int{0}
-	nodeStack := []*asts.ASTNode{}
-	var lookahead *tokens.Token
-	if parser.stashedLookahead != nil {
-		lookahead = parser.stashedLookahead
-		parser.stashedLookahead = nil
-	} else {
-		lookahead = lexer.Scan()
-	}
-	if parser.Trace != nil && parser.Trace.OnToken != nil {
-		parser.Trace.OnToken(lookahead)
-	}
-	for {
-		if lookahead == nil {
-			return nil, false, fmt.Errorf("parser: lexer returned nil token")
-		}
-		if lookahead.Type == tokens.TokenTypeError {
-			return nil, false, fmt.Errorf("lexer error: %s", string(lookahead.Lexeme))
-		}
-		state := stateStack[len(stateStack)-1]
-		action, ok := JSONParserActions[state][lookahead.Type]
-		if !ok {
-			return nil, false, fmt.Errorf("parse error: unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme))
-		}
-		if parser.Trace != nil && parser.Trace.OnAction != nil {
-			parser.Trace.OnAction(state, action, lookahead)
-		}
-		switch action.Kind {
-		case JSONParserActionShift:
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, JSONParserNoASTSentinel)
-			} else {
-				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
-			}
-			stateStack = append(stateStack, action.Target)
-			lookahead = lexer.Scan()
-			if parser.Trace != nil && parser.Trace.OnToken != nil {
-				parser.Trace.OnToken(lookahead)
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case JSONParserActionReduce:
-			prod := JSONParserProductions[action.Target]
-			rhsNodes := make([]*asts.ASTNode, prod.rhsCount)
-			for i := prod.rhsCount - 1; i >= 0; i-- {
-				stateStack = stateStack[:len(stateStack)-1]
-				rhsNodes[i] = nodeStack[len(nodeStack)-1]
-				nodeStack = nodeStack[:len(nodeStack)-1]
-			}
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, JSONParserNoASTSentinel)
-			} else {
-				var node *asts.ASTNode
-				useFullTree := (astMode == "fullast")
-				if !useFullTree && prod.hasPassthrough {
-					node = rhsNodes[prod.passthroughIndex]
-				} else if !useFullTree && prod.hasWithAppendedChildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					if parent != nil && parent.Children != nil {
-						newChildren = append(newChildren, parent.Children...)
-					}
-					for _, ci := range prod.withAppendedChildren {
-						newChildren = append(newChildren, rhsNodes[ci])
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasWithPrependedChildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					for _, ci := range prod.withPrependedChildren {
-						newChildren = append(newChildren, rhsNodes[ci])
-					}
-					if parent != nil && parent.Children != nil {
-						newChildren = append(newChildren, parent.Children...)
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasWithAdoptedGrandchildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					for _, ci := range prod.withAdoptedGrandchildren {
-						childNode := rhsNodes[ci]
-						if childNode != nil && childNode.Children != nil {
-							newChildren = append(newChildren, childNode.Children...)
-						}
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasHint {
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = prod.lhs
-					}
-					var parentToken *tokens.Token
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-					} else if prod.parentIndex >= 0 && prod.parentIndex < len(rhsNodes) {
-						parentToken = rhsNodes[prod.parentIndex].Token
-					}
-					hintChildren := make([]*asts.ASTNode, len(prod.childIndices))
-					for i, ci := range prod.childIndices {
-						hintChildren[i] = rhsNodes[ci]
-					}
-					node = asts.NewASTNode(parentToken, nodeType, hintChildren)
-				} else if prod.rhsCount == 1 {
-					node = rhsNodes[0]
-				} else if prod.rhsCount == 0 {
-					node = asts.NewASTNode(nil, prod.lhs, []*asts.ASTNode{})
-				} else {
-					node = asts.NewASTNode(nil, prod.lhs, rhsNodes)
-				}
-				nodeStack = append(nodeStack, node)
-			}
-			state = stateStack[len(stateStack)-1]
-			nextState, ok := JSONParserGotos[state][prod.lhs]
-			if !ok {
-				return nil, false, fmt.Errorf("parse error: missing goto for %s", prod.lhs)
-			}
-			stateStack = append(stateStack, nextState)
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case JSONParserActionAccept:
-			if len(nodeStack) != 1 {
-				return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			if astMode == "noast" {
-				return nil, true, nil
-			}
-			return asts.NewAST(nodeStack[0]), true, nil
-		case JSONParserActionAcceptAndYield:
-			if len(nodeStack) != 1 {
-				return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			parser.stashedLookahead = lookahead
-			if astMode == "noast" {
-				return nil, false, nil
-			}
-			return asts.NewAST(nodeStack[0]), false, nil
-		default:
-			return nil, false, fmt.Errorf("parse error: no action")
-		}
-	}
-}
-
-// AttachCLITrace installs tracing hooks for CLI debugging.
-func (parser *JSONParser) AttachCLITrace(traceTokens bool, traceStates bool, traceStack bool) {
-	if !traceTokens && !traceStates && !traceStack {
-		return
-	}
-	parser.Trace = &JSONParserTraceHooks{
-		OnToken: func(tok *tokens.Token) {
-			if !traceTokens {
-				return
-			}
-			fmt.Fprintln(os.Stderr, formatJSONParserToken(tok))
-		},
-		OnAction: func(state int, action JSONParserAction, lookahead *tokens.Token) {
-			if !traceStates {
-				return
-			}
-			fmt.Fprintf(os.Stderr, "STATE %d %s on %s(%q)\n",
-				state, formatJSONParserAction(action), tokenTypeNameJSONParser(lookahead), tokenLexemeJSONParser(lookahead))
-		},
-		OnStack: func(stateStack []int, nodeStack []*asts.ASTNode) {
-			if !traceStack {
-				return
-			}
-			fmt.Fprintf(os.Stderr, "STACK states=%s nodes=%s\n",
-				formatJSONParserIntStack(stateStack), formatJSONParserNodeStack(nodeStack))
-		},
-	}
-}
-
-type JSONParserActionKind int
-
-const (
-	JSONParserActionShift JSONParserActionKind = iota
-	JSONParserActionReduce
-	JSONParserActionAccept
-	JSONParserActionAcceptAndYield
-)
-
-type JSONParserAction struct {
-	Kind   JSONParserActionKind
-	Target int
-}
-
-func formatJSONParserToken(tok *tokens.Token) string {
-	if tok == nil {
-		return "TOK <nil>"
-	}
-	return fmt.Sprintf("TOK type=%s lexeme=%q line=%d col=%d",
-		tok.Type, string(tok.Lexeme), tok.Location.LineNumber, tok.Location.ColumnNumber)
-}
-
-func tokenTypeNameJSONParser(tok *tokens.Token) string {
-	if tok == nil {
-		return "<nil>"
-	}
-	return string(tok.Type)
-}
-
-func tokenLexemeJSONParser(tok *tokens.Token) string {
-	if tok == nil {
-		return ""
-	}
-	return string(tok.Lexeme)
-}
-
-func formatJSONParserIntStack(stack []int) string {
-	parts := make([]string, len(stack))
-	for i, v := range stack {
-		parts[i] = fmt.Sprintf("%d", v)
-	}
-	return "[" + strings.Join(parts, " ") + "]"
-}
-
-func formatJSONParserNodeStack(stack []*asts.ASTNode) string {
-	parts := make([]string, len(stack))
-	for i, node := range stack {
-		if node == nil {
-			parts[i] = "<nil>"
-			continue
-		}
-		parts[i] = string(node.Type)
-	}
-	return "[" + strings.Join(parts, " ") + "]"
-}
-
-func formatJSONParserAction(action JSONParserAction) string {
-	switch action.Kind {
-	case JSONParserActionShift:
-		return fmt.Sprintf("shift(%d)", action.Target)
-	case JSONParserActionReduce:
-		return fmt.Sprintf("reduce(%d)", action.Target)
-	case JSONParserActionAccept:
-		return "accept"
-	case JSONParserActionAcceptAndYield:
-		return "accept_and_yield"
-	default:
-		return "unknown"
-	}
-}
-
-type JSONParserProduction struct {
-	lhs                         asts.NodeType
-	rhsCount                    int
-	hasHint                     bool
-	hasPassthrough              bool
-	hasParentLiteral            bool
-	hasWithAppendedChildren     bool
-	hasWithPrependedChildren    bool
-	hasWithAdoptedGrandchildren bool
-	parentIndex                 int
-	passthroughIndex            int
-	parentLiteral               string
-	childIndices                []int
-	withAppendedChildren        []int
-	withPrependedChildren       []int
-	withAdoptedGrandchildren    []int
-	nodeType                    asts.NodeType
-}
-
-var JSONParserActions = map[int]map[tokens.TokenType]JSONParserAction{
-	0: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionShift, Target: 5},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionShift, Target: 6},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionShift, Target: 7},
-		tokens.TokenType("null"):     {Kind: JSONParserActionShift, Target: 8},
-		tokens.TokenType("number"):   {Kind: JSONParserActionShift, Target: 9},
-		tokens.TokenType("string"):   {Kind: JSONParserActionShift, Target: 10},
-		tokens.TokenType("true"):     {Kind: JSONParserActionShift, Target: 11},
-	},
-	1: {
-		tokens.TokenTypeEOF:          {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 3},
-	},
-	2: {
-		tokens.TokenTypeEOF:          {Kind: JSONParserActionAccept},
-		tokens.TokenType("colon"):    {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("comma"):    {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("false"):    {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("null"):     {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("number"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("string"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("true"):     {Kind: JSONParserActionAcceptAndYield},
-	},
-	3: {
-		tokens.TokenTypeEOF:          {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 2},
-	},
-	4: {
-		tokens.TokenTypeEOF:          {Kind: JSONParserActionReduce, Target: 1},
-		tokens.TokenType("colon"):    {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("comma"):    {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("false"):    {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("null"):     {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("number"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("string"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("true"):     {Kind: JSONParserActionAcceptAndYield},
-	},
-	5: {
-		tokens.TokenTypeEOF:          {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 7},
-	},
-	6: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionShift, Target: 16},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionShift, Target: 17},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionShift, Target: 18},
-		tokens.TokenType("null"):     {Kind: JSONParserActionShift, Target: 19},
-		tokens.TokenType("number"):   {Kind: JSONParserActionShift, Target: 20},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionShift, Target: 21},
-		tokens.TokenType("string"):   {Kind: JSONParserActionShift, Target: 22},
-		tokens.TokenType("true"):     {Kind: JSONParserActionShift, Target: 23},
-	},
-	7: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionShift, Target: 30},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionShift, Target: 31},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionShift, Target: 32},
-		tokens.TokenType("null"):     {Kind: JSONParserActionShift, Target: 33},
-		tokens.TokenType("number"):   {Kind: JSONParserActionShift, Target: 34},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionShift, Target: 35},
-		tokens.TokenType("string"):   {Kind: JSONParserActionShift, Target: 36},
-		tokens.TokenType("true"):     {Kind: JSONParserActionShift, Target: 37},
-	},
-	8: {
-		tokens.TokenTypeEOF:          {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 8},
-	},
-	9: {
-		tokens.TokenTypeEOF:          {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 5},
-	},
-	10: {
-		tokens.TokenTypeEOF:          {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 4},
-	},
-	11: {
-		tokens.TokenTypeEOF:          {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 6},
-	},
-	12: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 3},
-	},
-	13: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionShift, Target: 38},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionShift, Target: 39},
-	},
-	14: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 2},
-	},
-	15: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 16},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionReduce, Target: 16},
-	},
-	16: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 7},
-	},
-	17: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionShift, Target: 16},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionShift, Target: 17},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionShift, Target: 18},
-		tokens.TokenType("null"):     {Kind: JSONParserActionShift, Target: 19},
-		tokens.TokenType("number"):   {Kind: JSONParserActionShift, Target: 20},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionShift, Target: 41},
-		tokens.TokenType("string"):   {Kind: JSONParserActionShift, Target: 22},
-		tokens.TokenType("true"):     {Kind: JSONParserActionShift, Target: 23},
-	},
-	18: {
-		tokens.TokenType("rcurly"): {Kind: JSONParserActionShift, Target: 43},
-		tokens.TokenType("string"): {Kind: JSONParserActionShift, Target: 44},
-	},
-	19: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 8},
-	},
-	20: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 5},
-	},
-	21: {
-		tokens.TokenTypeEOF:          {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 14},
-	},
-	22: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 4},
-	},
-	23: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 6},
-	},
-	24: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 3},
-	},
-	25: {
-		tokens.TokenType("colon"):    {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("comma"):    {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("false"):    {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("null"):     {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("number"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 0},
-		tokens.TokenType("string"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("true"):     {Kind: JSONParserActionAcceptAndYield},
-	},
-	26: {
-		tokens.TokenType("comma"):  {Kind: JSONParserActionReduce, Target: 11},
-		tokens.TokenType("rcurly"): {Kind: JSONParserActionReduce, Target: 11},
-	},
-	27: {
-		tokens.TokenType("comma"):  {Kind: JSONParserActionShift, Target: 45},
-		tokens.TokenType("rcurly"): {Kind: JSONParserActionShift, Target: 46},
-	},
-	28: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 2},
-	},
-	29: {
-		tokens.TokenType("colon"):    {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("comma"):    {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("false"):    {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("null"):     {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("number"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("string"):   {Kind: JSONParserActionAcceptAndYield},
-		tokens.TokenType("true"):     {Kind: JSONParserActionAcceptAndYield},
-	},
-	30: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 7},
-	},
-	31: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionShift, Target: 16},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionShift, Target: 17},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionShift, Target: 18},
-		tokens.TokenType("null"):     {Kind: JSONParserActionShift, Target: 19},
-		tokens.TokenType("number"):   {Kind: JSONParserActionShift, Target: 20},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionShift, Target: 48},
-		tokens.TokenType("string"):   {Kind: JSONParserActionShift, Target: 22},
-		tokens.TokenType("true"):     {Kind: JSONParserActionShift, Target: 23},
-	},
-	32: {
-		tokens.TokenType("rcurly"): {Kind: JSONParserActionShift, Target: 50},
-		tokens.TokenType("string"): {Kind: JSONParserActionShift, Target: 44},
-	},
-	33: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 8},
-	},
-	34: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 5},
-	},
-	35: {
-		tokens.TokenTypeEOF:          {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 9},
-	},
-	36: {
-		tokens.TokenType("colon"):    {Kind: JSONParserActionShift, Target: 51},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 4},
-	},
-	37: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 6},
-	},
-	38: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionShift, Target: 16},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionShift, Target: 17},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionShift, Target: 18},
-		tokens.TokenType("null"):     {Kind: JSONParserActionShift, Target: 19},
-		tokens.TokenType("number"):   {Kind: JSONParserActionShift, Target: 20},
-		tokens.TokenType("string"):   {Kind: JSONParserActionShift, Target: 22},
-		tokens.TokenType("true"):     {Kind: JSONParserActionShift, Target: 23},
-	},
-	39: {
-		tokens.TokenTypeEOF:          {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 15},
-	},
-	40: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionShift, Target: 38},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionShift, Target: 53},
-	},
-	41: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 14},
-	},
-	42: {
-		tokens.TokenType("comma"):  {Kind: JSONParserActionShift, Target: 45},
-		tokens.TokenType("rcurly"): {Kind: JSONParserActionShift, Target: 54},
-	},
-	43: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 9},
-	},
-	44: {
-		tokens.TokenType("colon"): {Kind: JSONParserActionShift, Target: 51},
-	},
-	45: {
-		tokens.TokenType("string"): {Kind: JSONParserActionShift, Target: 44},
-	},
-	46: {
-		tokens.TokenTypeEOF:          {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 10},
-	},
-	47: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionShift, Target: 38},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionShift, Target: 56},
-	},
-	48: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 14},
-	},
-	49: {
-		tokens.TokenType("comma"):  {Kind: JSONParserActionShift, Target: 45},
-		tokens.TokenType("rcurly"): {Kind: JSONParserActionShift, Target: 57},
-	},
-	50: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 9},
-	},
-	51: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionShift, Target: 61},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionShift, Target: 62},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionShift, Target: 63},
-		tokens.TokenType("null"):     {Kind: JSONParserActionShift, Target: 64},
-		tokens.TokenType("number"):   {Kind: JSONParserActionShift, Target: 65},
-		tokens.TokenType("string"):   {Kind: JSONParserActionShift, Target: 66},
-		tokens.TokenType("true"):     {Kind: JSONParserActionShift, Target: 67},
-	},
-	52: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 17},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionReduce, Target: 17},
-	},
-	53: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 15},
-	},
-	54: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 10},
-	},
-	55: {
-		tokens.TokenType("comma"):  {Kind: JSONParserActionReduce, Target: 12},
-		tokens.TokenType("rcurly"): {Kind: JSONParserActionReduce, Target: 12},
-	},
-	56: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 15},
-	},
-	57: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 10},
-	},
-	58: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 3},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 3},
-	},
-	59: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 2},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 2},
-	},
-	60: {
-		tokens.TokenType("comma"):  {Kind: JSONParserActionReduce, Target: 13},
-		tokens.TokenType("rcurly"): {Kind: JSONParserActionReduce, Target: 13},
-	},
-	61: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 7},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 7},
-	},
-	62: {
-		tokens.TokenType("false"):    {Kind: JSONParserActionShift, Target: 16},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionShift, Target: 17},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionShift, Target: 18},
-		tokens.TokenType("null"):     {Kind: JSONParserActionShift, Target: 19},
-		tokens.TokenType("number"):   {Kind: JSONParserActionShift, Target: 20},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionShift, Target: 69},
-		tokens.TokenType("string"):   {Kind: JSONParserActionShift, Target: 22},
-		tokens.TokenType("true"):     {Kind: JSONParserActionShift, Target: 23},
-	},
-	63: {
-		tokens.TokenType("rcurly"): {Kind: JSONParserActionShift, Target: 71},
-		tokens.TokenType("string"): {Kind: JSONParserActionShift, Target: 44},
-	},
-	64: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 8},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 8},
-	},
-	65: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 5},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 5},
-	},
-	66: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 4},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 4},
-	},
-	67: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 6},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 6},
-	},
-	68: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionShift, Target: 38},
-		tokens.TokenType("rbracket"): {Kind: JSONParserActionShift, Target: 72},
-	},
-	69: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 14},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 14},
-	},
-	70: {
-		tokens.TokenType("comma"):  {Kind: JSONParserActionShift, Target: 45},
-		tokens.TokenType("rcurly"): {Kind: JSONParserActionShift, Target: 73},
-	},
-	71: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 9},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 9},
-	},
-	72: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 15},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 15},
-	},
-	73: {
-		tokens.TokenType("comma"):    {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("false"):    {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("lbracket"): {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("lcurly"):   {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("null"):     {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("number"):   {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("rcurly"):   {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("string"):   {Kind: JSONParserActionReduce, Target: 10},
-		tokens.TokenType("true"):     {Kind: JSONParserActionReduce, Target: 10},
-	},
-}
-
-var JSONParserGotos = map[int]map[asts.NodeType]int{
-	0: {
-		asts.NodeType("Array"):  1,
-		asts.NodeType("Json"):   2,
-		asts.NodeType("Object"): 3,
-		asts.NodeType("Value"):  4,
-	},
-	6: {
-		asts.NodeType("Array"):    12,
-		asts.NodeType("Elements"): 13,
-		asts.NodeType("Object"):   14,
-		asts.NodeType("Value"):    15,
-	},
-	7: {
-		asts.NodeType("Array"):   24,
-		asts.NodeType("Json"):    25,
-		asts.NodeType("Member"):  26,
-		asts.NodeType("Members"): 27,
-		asts.NodeType("Object"):  28,
-		asts.NodeType("Value"):   29,
-	},
-	17: {
-		asts.NodeType("Array"):    12,
-		asts.NodeType("Elements"): 40,
-		asts.NodeType("Object"):   14,
-		asts.NodeType("Value"):    15,
-	},
-	18: {
-		asts.NodeType("Member"):  26,
-		asts.NodeType("Members"): 42,
-	},
-	31: {
-		asts.NodeType("Array"):    12,
-		asts.NodeType("Elements"): 47,
-		asts.NodeType("Object"):   14,
-		asts.NodeType("Value"):    15,
-	},
-	32: {
-		asts.NodeType("Member"):  26,
-		asts.NodeType("Members"): 49,
-	},
-	38: {
-		asts.NodeType("Array"):  12,
-		asts.NodeType("Object"): 14,
-		asts.NodeType("Value"):  52,
-	},
-	45: {
-		asts.NodeType("Member"): 55,
-	},
-	51: {
-		asts.NodeType("Array"):  58,
-		asts.NodeType("Object"): 59,
-		asts.NodeType("Value"):  60,
-	},
-	62: {
-		asts.NodeType("Array"):    12,
-		asts.NodeType("Elements"): 68,
-		asts.NodeType("Object"):   14,
-		asts.NodeType("Value"):    15,
-	},
-	63: {
-		asts.NodeType("Member"):  26,
-		asts.NodeType("Members"): 70,
-	},
-}
-
-var JSONParserProductions = []JSONParserProduction{
-	{lhs: asts.NodeType("__pgpg_start_1"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Json"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Value"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Value"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Value"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Value"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Value"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Value"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Value"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Object"), rhsCount: 2, hasHint: true, hasPassthrough: false, hasParentLiteral: true, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "{}", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("object")},
-	{lhs: asts.NodeType("Object"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: true, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: true, parentIndex: 0, passthroughIndex: 0, parentLiteral: "{}", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{1}, nodeType: asts.NodeType("object")},
-	{lhs: asts.NodeType("Members"), rhsCount: 1, hasHint: true, hasPassthrough: false, hasParentLiteral: true, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "{temp}", childIndices: []int{0}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Members"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: true, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{2}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Member"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 2}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Array"), rhsCount: 2, hasHint: true, hasPassthrough: false, hasParentLiteral: true, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "[]", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("array")},
-	{lhs: asts.NodeType("Array"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: true, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: true, parentIndex: 0, passthroughIndex: 0, parentLiteral: "[]", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{1}, nodeType: asts.NodeType("array")},
-	{lhs: asts.NodeType("Elements"), rhsCount: 1, hasHint: true, hasPassthrough: false, hasParentLiteral: true, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "[temp]", childIndices: []int{0}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Elements"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: true, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{2}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
+	lr.Parser
+}
+
+func NewJSONParser() *JSONParser {
+	return &JSONParser{Parser: lr.Parser{Tables: JSONParserTables}}
+}
+
+// ParseWithJSONParser parses the input, computing values of type T with the handler in place of
+// building an AST, and returns the start symbol's value. Syntax errors may be repaired, as with
+// RepairErrors, but error productions are not used.
+func ParseWithJSONParser[T any](parser *JSONParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {
+	return lr.ParseWith(&parser.Parser, lexer, handler)
+}
+
+// JSONParserTables are the parser's LR tables, run by the lr package. The action and goto tables
+// are indexed by the IDs of Terminals and Nonterminals, and row-displaced, as lr.Tables documents.
+var JSONParserTables = &lr.Tables{
+	StartSymbol: "Json",
+	Terminals: tokens.NewKinds(
+		"EOF",
+		"colon",
+		"comma",
+		"false",
+		"lbracket",
+		"lcurly",
+		"null",
+		"number",
+		"rbracket",
+		"rcurly",
+		"string",
+		"true",
+	),
+	Nonterminals: []asts.NodeType{
+		"Array",
+		"Elements",
+		"Json",
+		"Member",
+		"Members",
+		"Object",
+		"Value",
+		"__pgpg_start_1",
+	},
+	ActionBase: []int32{
+		143, -1, 8, 17, 26, 35, 44, -9, 53, 62, 71, 80, 167, 168, 171, 172,
+		175, 89, 34, 176, 179, 98, 180, 183, 184, 187, 107, 6, 152, 116, 190, 192,
+		188, 193, 6, 125, 161, 197, 200, 201, 202, 204, 205, 208, 210, 134, 79, 213,
+		214, 216, 218, 222, 219, 224, 227, 229, 230,
+	},
+	ActionCheck: []int32{
+		1, 7, 7, 1, 1, 1, 1, 1, 27, 2, 1, 1, 2, 2, 2, 2,
+		2, 34, 3, 2, 2, 3, 3, 3, 3, 3, -1, 4, 3, 3, 4, 4,
+		4, 4, 4, -1, 5, 4, 4, 5, 5, 5, 5, 5, 18, 18, 5, 5,
+		6, 6, 6, 6, 6, 6, 8, 6, 6, 8, 8, 8, 8, 8, -1, 9,
+		8, 8, 9, 9, 9, 9, 9, -1, 10, 9, 9, 10, 10, 10, 10, 10,
+		-1, 11, 10, 10, 11, 11, 11, 11, 11, 46, 46, 11, 11, 17, 17, 17,
+		17, 17, 17, 21, 17, 17, 21, 21, 21, 21, 21, -1, 26, 21, 21, 26,
+		26, 26, 26, 26, -1, 29, 26, 26, 29, 29, 29, 29, 29, -1, 35, 29,
+		29, 35, 35, 35, 35, 35, -1, -1, 35, 35, 45, 45, 45, 45, 45, 45,
+		-1, 45, 45, 0, 0, 0, 0, 0, -1, -1, 0, 0, 28, 28, 28, 28,
+		28, -1, -1, 28, 28, 36, 36, 36, 36, 36, 12, 13, 36, 36, 14, 15,
+		12, 13, 16, 19, 14, 15, 20, 22, 16, 19, 23, 24, 20, 22, 25, 32,
+		23, 30, 24, 31, 33, 25, 32, 30, 37, 31, 33, 38, 39, 40, 37, 41,
+		42, 38, 39, 43, 40, 44, 41, 42, 47, 48, 43, 49, 44, 50, 52, 47,
+		48, 51, 49, 53, 50, 52, 54, 51, 55, 56, 53, -1, -1, 54, -1, 55,
+		56,
+	},
+	ActionEntries: []int32{
+		13, 104, 108, 13, 13, 13, 13, 13, 144, 2, 13, 13, 3, 3, 3, 3,
+		3, 108, 9, 3, 3, 9, 9, 9, 9, 9, 0, 5, 9, 9, 5, 5,
+		5, 5, 5, 0, 29, 5, 5, 29, 29, 29, 29, 29, 132, 108, 29, 29,
+		64, 68, 72, 76, 80, 84, 33, 88, 92, 33, 33, 33, 33, 33, 0, 21,
+		33, 33, 21, 21, 21, 21, 21, 0, 17, 21, 21, 17, 17, 17, 17, 17,
+		0, 25, 17, 17, 25, 25, 25, 25, 25, 216, 108, 25, 25, 64, 68, 72,
+		76, 80, 124, 57, 88, 92, 57, 57, 57, 57, 57, 0, 37, 57, 57, 37,
+		37, 37, 37, 37, 0, 61, 37, 37, 61, 61, 61, 61, 61, 0, 41, 61,
+		61, 41, 41, 41, 41, 41, 0, 0, 41, 41, 64, 68, 72, 76, 80, 208,
+		0, 88, 92, 20, 24, 28, 32, 36, 0, 0, 40, 44, 64, 68, 72, 76,
+		80, 0, 0, 88, 92, 176, 180, 184, 188, 192, 13, 112, 196, 200, 9, 65,
+		13, 116, 29, 33, 9, 65, 21, 17, 29, 33, 25, 45, 21, 17, 136, 136,
+		25, 112, 45, 57, 37, 140, 156, 152, 69, 57, 37, 61, 41, 49, 69, 13,
+		9, 61, 41, 53, 49, 29, 13, 9, 33, 21, 53, 17, 29, 25, 57, 33,
+		21, 112, 17, 136, 25, 57, 37, 220, 61, 41, 224, 0, 0, 37, 0, 61,
+		41,
+	},
+	GotoBase: []int32{
+		0, 0, 0, 0, 0, 0, 3, 19, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 10, 21, 0, 0, 0, 0, 0, 0, 0, 0, 0, 14, 0, 0, 0,
+		0, 0, -2, 0, 21, 0, 0, 0, 0, 0, 0, 0, 0, 12, 25, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0,
+	},
+	GotoCheck: []int32{
+		0, 34, 0, 6, 6, 0, 0, -1, 6, 6, 17, 17, 45, 45, 28, 17,
+		17, 45, 45, 28, 28, 36, 7, 7, 18, 18, 36, 36, 46, 46,
+	},
+	GotoTargets: []int32{
+		1, 40, 2, 12, 13, 3, 4, 0, 14, 15, 12, 30, 12, 51, 12, 14,
+		15, 14, 15, 14, 37, 41, 24, 25, 24, 32, 42, 43, 24, 53,
+	},
+	Productions: []lr.Production{
+		{LHS: asts.NodeType("__pgpg_start_1"), LHSID: 7, RHSCount: 1},
+		{LHS: asts.NodeType("Json"), LHSID: 2, RHSCount: 1},
+		{LHS: asts.NodeType("Value"), LHSID: 6, RHSCount: 1},
+		{LHS: asts.NodeType("Value"), LHSID: 6, RHSCount: 1},
+		{LHS: asts.NodeType("Value"), LHSID: 6, RHSCount: 1},
+		{LHS: asts.NodeType("Value"), LHSID: 6, RHSCount: 1},
+		{LHS: asts.NodeType("Value"), LHSID: 6, RHSCount: 1},
+		{LHS: asts.NodeType("Value"), LHSID: 6, RHSCount: 1},
+		{LHS: asts.NodeType("Value"), LHSID: 6, RHSCount: 1},
+		{LHS: asts.NodeType("Object"), LHSID: 5, RHSCount: 2, Hint: &lr.Hint{HasParentLiteral: true, ParentLiteral: "{}", NodeType: asts.NodeType("object")}},
+		{LHS: asts.NodeType("Object"), LHSID: 5, RHSCount: 3, Hint: &lr.Hint{HasParentLiteral: true, ParentLiteral: "{}", WithAdoptedGrandchildren: []int{1}, NodeType: asts.NodeType("object")}},
+		{LHS: asts.NodeType("Members"), LHSID: 4, RHSCount: 1, Hint: &lr.Hint{HasParentLiteral: true, ParentLiteral: "{temp}", ChildIndices: []int{0}}},
+		{LHS: asts.NodeType("Members"), LHSID: 4, RHSCount: 3, Hint: &lr.Hint{WithAppendedChildren: []int{2}}},
+		{LHS: asts.NodeType("Member"), LHSID: 3, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}}},
+		{LHS: asts.NodeType("Array"), LHSID: 0, RHSCount: 2, Hint: &lr.Hint{HasParentLiteral: true, ParentLiteral: "[]", NodeType: asts.NodeType("array")}},
+		{LHS: asts.NodeType("Array"), LHSID: 0, RHSCount: 3, Hint: &lr.Hint{HasParentLiteral: true, ParentLiteral: "[]", WithAdoptedGrandchildren: []int{1}, NodeType: asts.NodeType("array")}},
+		{LHS: asts.NodeType("Elements"), LHSID: 1, RHSCount: 1, Hint: &lr.Hint{HasParentLiteral: true, ParentLiteral: "[temp]", ChildIndices: []int{0}}},
+		{LHS: asts.NodeType("Elements"), LHSID: 1, RHSCount: 3, Hint: &lr.Hint{WithAppendedChildren: []int{2}}},
+	},
+	HintMode: "hints",
 }
@@ -9,7 +9,7 @@ import (
 
 // TestJSONParseOneMixedType verifies that multi-object input with mixed value types
 // (e.g. [] {} or {} [] or 1 2 3 [] 5) parses correctly via ParseOne. This depends on
-// the parse table generator adding reduce for all of First(Value) in value-complete states.
+// json.bnf's %records declaration.
 func TestJSONParseOneMixedType(t *testing.T) {
 	tests := []struct {
 		input   string
@@ -49,3 +49,27 @@ func TestJSONParseOneMixedType(t *testing.T) {
 		})
 	}
 }
+
+// BenchmarkJSONParseOne measures multi-record JSON parsing, as by tryparse -multi g:json.
+func BenchmarkJSONParseOne(b *testing.B) {
+	const record = `{"id": 17, "name": "user17", "score": 42.5, "tags": ["a", "b"], "nested": {"x": [1, 2, 3], "ok": true, "none": null}}` + "\n"
+	input := strings.Repeat(record, 1000)
+	for name, astMode := range map[string]string{"noast": "noast", "ast": ""} {
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(input)))
+			for b.Loop() {
+				lex := lexers.NewJSONLexer(strings.NewReader(input))
+				parser := NewJSONParser()
+				for {
+					_, done, err := parser.ParseOne(lex, astMode)
+					if err != nil {
+						b.Fatal(err)
+					}
+					if done {
+						break
+					}
+				}
+			}
+		})
+	}
+}
@@ -1,442 +1,80 @@
 package parsers
 
 import (
-	"fmt"
-	"os"
-	"strings"
-
 	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
 	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
 	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
 )
 
+// LISPParser parses with LISPParserTables. Parsing, error handling, and the parser's
+// options are those of lr.Parser.
 type LISPParser struct {
-	Trace            *LISPParserTraceHooks
-	stashedLookahead *tokens.Token
-}
-
-type LISPParserTraceHooks struct {
-	OnToken  func(tok *tokens.Token)
-	OnAction func(state int, action LISPParserAction, lookahead *tokens.Token)
-	OnStack  func(stateStack []int, nodeStack []*asts.ASTNode)
-}
-
-func NewLISPParser() *LISPParser { return &LISPParser{} }
-
-// noASTSentinel is used as a placeholder on the node stack when astMode == "noast".
-var LISPParserNoASTSentinel = &asts.ASTNode{}
-
-func (parser *LISPParser) Parse(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {
-	if lexer == nil {
-		return nil, fmt.Errorf("parser: nil lexer")
-	}
-	stateStack := []int{0}
-	nodeStack := []*asts.ASTNode{}
-	lookahead := lexer.Scan()
-	if parser.Trace != nil && parser.Trace.OnToken != nil {
-		parser.Trace.OnToken(lookahead)
-	}
-	for {
-		if lookahead == nil {
-			return nil, fmt.Errorf("parser: lexer returned nil token")
-		}
-		if lookahead.Type == tokens.TokenTypeError {
-			return nil, fmt.Errorf("lexer error: %s", string(lookahead.Lexeme))
-		}
-		state := stateStack[len(stateStack)-1]
-		action, ok := LISPParserActions[state][lookahead.Type]
-		if !ok {
-			return nil, fmt.Errorf("parse error: unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme))
-		}
-		if parser.Trace != nil && parser.Trace.OnAction != nil {
-			parser.Trace.OnAction(state, action, lookahead)
-		}
-		switch action.Kind {
-		case LISPParserActionShift:
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, LISPParserNoASTSentinel)
-			} else {
-				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
-			}
-			stateStack = append(stateStack, action.Target)
-			lookahead = lexer.Scan()
-			if parser.Trace != nil && parser.Trace.OnToken != nil {
-				parser.Trace.OnToken(lookahead)
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case LISPParserActionReduce:
-			prod := LISPParserProductions[action.Target]
-			rhsNodes := make([]*asts.ASTNode, prod.rhsCount)
-			for i := prod.rhsCount - 1; i >= 0; i-- {
-				stateStack = stateStack[:len(stateStack)-1]
-				rhsNodes[i] = nodeStack[len(nodeStack)-1]
-				nodeStack = nodeStack[:len(nodeStack)-1]
-			}
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, LISPParserNoASTSentinel)
-			} else {
-				if prod.rhsCount == 0 {
-					rhsNodes = []*asts.ASTNode{}
-				}
-				node := asts.NewASTNode(nil, prod.lhs, rhsNodes)
-				nodeStack = append(nodeStack, node)
-			}
-			state = stateStack[len(stateStack)-1]
-			nextState, ok := LISPParserGotos[state][prod.lhs]
-			if !ok {
-				return nil, fmt.Errorf("parse error: missing goto for %s", prod.lhs)
-			}
-			stateStack = append(stateStack, nextState)
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case LISPParserActionAccept:
-			if len(nodeStack) != 1 {
-				return nil, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			if astMode == "noast" {
-				return nil, nil
-			}
-			return asts.NewAST(nodeStack[0]), nil
-		case LISPParserActionAcceptAndYield:
-			return nil, fmt.Errorf("parse error: multiple objects; use ParseOne for multi-object input")
-		default:
-			return nil, fmt.Errorf("parse error: no action")
-		}
-	}
-}
-
-// ParseOne parses one record from the lexer. It is for multi-object input: call in a loop until done.
-// Returns (ast, true, nil) on EOF after a record, (ast, false, nil) when more input follows, or (nil, false, err) on error.
-func (parser *LISPParser) ParseOne(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, bool, error) {
-	if lexer == nil {
-		return nil, false, fmt.Errorf("parser: nil lexer")
-	}
-	stateStack := []int{0}
-	nodeStack := []*asts.ASTNode{}
-	var lookahead *tokens.Token
-	if parser.stashedLookahead != nil {
-		lookahead = parser.stashedLookahead
-		parser.stashedLookahead = nil
-	} else {
-		lookahead = lexer.Scan()
-	}
-	if parser.Trace != nil && parser.Trace.OnToken != nil {
-		parser.Trace.OnToken(lookahead)
-	}
-	for {
-		if lookahead == nil {
-			return nil, false, fmt.Errorf("parser: lexer returned nil token")
-		}
-		if lookahead.Type == tokens.TokenTypeError {
-			return nil, false, fmt.Errorf("lexer error: %s", string(lookahead.Lexeme))
-		}
-		state := stateStack[len(stateStack)-1]
-		action, ok := LISPParserActions[state][lookahead.Type]
-		if !ok {
-			return nil, false, fmt.Errorf("parse error: unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme))
-		}
-		if parser.Trace != nil && parser.Trace.OnAction != nil {
-			parser.Trace.OnAction(state, action, lookahead)
-		}
-		switch action.Kind {
-		case LISPParserActionShift:
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, LISPParserNoASTSentinel)
-			} else {
-				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
-			}
-			stateStack = append(stateStack, action.Target)
-			lookahead = lexer.Scan()
-			if parser.Trace != nil && parser.Trace.OnToken != nil {
-				parser.Trace.OnToken(lookahead)
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case LISPParserActionReduce:
-			prod := LISPParserProductions[action.Target]
-			rhsNodes := make([]*asts.ASTNode, prod.rhsCount)
-			for i := prod.rhsCount - 1; i >= 0; i-- {
-				stateStack = stateStack[:len(stateStack)-1]
-				rhsNodes[i] = nodeStack[len(nodeStack)-1]
-				nodeStack = nodeStack[:len(nodeStack)-1]
-			}
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, LISPParserNoASTSentinel)
-			} else {
-				if prod.rhsCount == 0 {
-					rhsNodes = []*asts.ASTNode{}
-				}
-				node := asts.NewASTNode(nil, prod.lhs, rhsNodes)
-				nodeStack = append(nodeStack, node)
-			}
-			state = stateStack[len(stateStack)-1]
-			nextState, ok := LISPParserGotos[state][prod.lhs]
-			if !ok {
-				return nil, false, fmt.Errorf("parse error: missing goto for %s", prod.lhs)
-			}
-			stateStack = append(stateStack, nextState)
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case LISPParserActionAccept:
-			if len(nodeStack) != 1 {
-				return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			if astMode == "noast" {
-				return nil, true, nil
-			}
-			return asts.NewAST(nodeStack[0]), true, nil
-		case LISPParserActionAcceptAndYield:
-			if len(nodeStack) != 1 {
-				return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			parser.stashedLookahead = lookahead
-			if astMode == "noast" {
-				return nil, false, nil
-			}
-			return asts.NewAST(nodeStack[0]), false, nil
-		default:
-			return nil, false, fmt.Errorf("parse error: no action")
-		}
-	}
-}
-
-// AttachCLITrace installs tracing hooks for CLI debugging.
-func (parser *LISPParser) AttachCLITrace(traceTokens bool, traceStates bool, traceStack bool) {
-	if !traceTokens && !traceStates && !traceStack {
-		return
-	}
-	parser.Trace = &LISPParserTraceHooks{
-		OnToken: func(tok *tokens.Token) {
-			if !traceTokens {
-				return
-			}
-			fmt.Fprintln(os.Stderr, formatLISPParserToken(tok))
-		},
-		OnAction: func(state int, action LISPParserAction, lookahead *tokens.Token) {
-			if !traceStates {
-				return
-			}
-			fmt.Fprintf(os.Stderr, "STATE %d %s on %s(%q)\n",
-				state, formatLISPParserAction(action), tokenTypeNameLISPParser(lookahead), tokenLexemeLISPParser(lookahead))
-		},
-		OnStack: func(stateStack []int, nodeStack []*asts.ASTNode) {
-			if !traceStack {
-				return
-			}
-			fmt.Fprintf(os.Stderr, "STACK states=%s nodes=%s\n",
-				formatLISPParserIntStack(stateStack), formatLISPParserNodeStack(nodeStack))
-		},
-	}
-}
-
-type LISPParserActionKind int
-
-const (
-	LISPParserActionShift LISPParserActionKind = iota
-	LISPParserActionReduce
-	LISPParserActionAccept
-	LISPParserActionAcceptAndYield
-)
-
-type LISPParserAction struct {
-	Kind   LISPParserActionKind
-	Target int
-}
-
-func formatLISPParserToken(tok *tokens.Token) string {
-	if tok == nil {
-		return "TOK <nil>"
-	}
-	return fmt.Sprintf("TOK type=%s lexeme=%q line=%d col=%d",
-		tok.Type, string(tok.Lexeme), tok.Location.LineNumber, tok.Location.ColumnNumber)
-}
-
-func tokenTypeNameLISPParser(tok *tokens.Token) string {
-	if tok == nil {
-		return "<nil>"
-	}
-	return string(tok.Type)
-}
-
-func tokenLexemeLISPParser(tok *tokens.Token) string {
-	if tok == nil {
-		return ""
-	}
-	return string(tok.Lexeme)
-}
-
-func formatLISPParserIntStack(stack []int) string {
-	parts := make([]string, len(stack))
-	for i, v := range stack {
-		parts[i] = fmt.Sprintf("%d", v)
-	}
-	return "[" + strings.Join(parts, " ") + "]"
-}
-
-func formatLISPParserNodeStack(stack []*asts.ASTNode) string {
-	parts := make([]string, len(stack))
-	for i, node := range stack {
-		if node == nil {
-			parts[i] = "<nil>"
-			continue
-		}
-		parts[i] = string(node.Type)
-	}
-	return "[" + strings.Join(parts, " ") + "]"
-}
-
-func formatLISPParserAction(action LISPParserAction) string {
-	switch action.Kind {
-	case LISPParserActionShift:
-		return fmt.Sprintf("shift(%d)", action.Target)
-	case LISPParserActionReduce:
-		return fmt.Sprintf("reduce(%d)", action.Target)
-	case LISPParserActionAccept:
-		return "accept"
-	case LISPParserActionAcceptAndYield:
-		return "accept_and_yield"
-	default:
-		return "unknown"
-	}
-}
-
-type LISPParserProduction struct {
-	lhs      asts.NodeType
-	rhsCount int
-}
-
-var LISPParserActions = map[int]map[tokens.TokenType]LISPParserAction{
-	0: {
-		tokens.TokenType("identifier"): {Kind: LISPParserActionShift, Target: 4},
-		tokens.TokenType("lparen"):     {Kind: LISPParserActionShift, Target: 5},
-	},
-	1: {
-		tokens.TokenTypeEOF:            {Kind: LISPParserActionReduce, Target: 1},
-		tokens.TokenType("identifier"): {Kind: LISPParserActionAcceptAndYield},
-		tokens.TokenType("lparen"):     {Kind: LISPParserActionAcceptAndYield},
-		tokens.TokenType("rparen"):     {Kind: LISPParserActionAcceptAndYield},
-	},
-	2: {
-		tokens.TokenTypeEOF: {Kind: LISPParserActionReduce, Target: 2},
-	},
-	3: {
-		tokens.TokenTypeEOF:            {Kind: LISPParserActionAccept},
-		tokens.TokenType("identifier"): {Kind: LISPParserActionAcceptAndYield},
-		tokens.TokenType("lparen"):     {Kind: LISPParserActionAcceptAndYield},
-		tokens.TokenType("rparen"):     {Kind: LISPParserActionAcceptAndYield},
-	},
-	4: {
-		tokens.TokenTypeEOF:            {Kind: LISPParserActionReduce, Target: 6},
-		tokens.TokenType("identifier"): {Kind: LISPParserActionReduce, Target: 6},
-	},
-	5: {
-		tokens.TokenType("identifier"): {Kind: LISPParserActionShift, Target: 9},
-		tokens.TokenType("lparen"):     {Kind: LISPParserActionShift, Target: 10},
-	},
-	6: {
-		tokens.TokenType("identifier"): {Kind: LISPParserActionAcceptAndYield},
-		tokens.TokenType("lparen"):     {Kind: LISPParserActionAcceptAndYield},
-		tokens.TokenType("rparen"):     {Kind: LISPParserActionAcceptAndYield},
-	},
-	8: {
-		tokens.TokenType("identifier"): {Kind: LISPParserActionShift, Target: 9},
-		tokens.TokenType("lparen"):     {Kind: LISPParserActionShift, Target: 10},
-		tokens.TokenType("rparen"):     {Kind: LISPParserActionReduce, Target: 3},
-	},
-	9: {
-		tokens.TokenType("identifier"): {Kind: LISPParserActionReduce, Target: 6},
-		tokens.TokenType("lparen"):     {Kind: LISPParserActionReduce, Target: 6},
-		tokens.TokenType("rparen"):     {Kind: LISPParserActionReduce, Target: 6},
-	},
-	10: {
-		tokens.TokenType("identifier"): {Kind: LISPParserActionShift, Target: 9},
-		tokens.TokenType("lparen"):     {Kind: LISPParserActionShift, Target: 10},
-	},
-	11: {
-		tokens.TokenType("identifier"): {Kind: LISPParserActionShift, Target: 9},
-		tokens.TokenType("lparen"):     {Kind: LISPParserActionShift, Target: 10},
-		tokens.TokenType("rparen"):     {Kind: LISPParserActionReduce, Target: 3},
-	},
-	12: {
-		tokens.TokenType("rparen"): {Kind: LISPParserActionShift, Target: 15},
-	},
-	13: {
-		tokens.TokenType("identifier"): {Kind: LISPParserActionShift, Target: 9},
-		tokens.TokenType("lparen"):     {Kind: LISPParserActionShift, Target: 10},
-		tokens.TokenType("rparen"):     {Kind: LISPParserActionReduce, Target: 3},
+	lr.Parser
+}
+
+func NewLISPParser() *LISPParser {
+	return &LISPParser{Parser: lr.Parser{Tables: LISPParserTables}}
+}
+
+// ParseWithLISPParser parses the input, computing values of type T with the handler in place of
+// building an AST, and returns the start symbol's value. Syntax errors may be repaired, as with
+// RepairErrors, but error productions are not used.
+func ParseWithLISPParser[T any](parser *LISPParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {
+	return lr.ParseWith(&parser.Parser, lexer, handler)
+}
+
+// LISPParserTables are the parser's LR tables, run by the lr package. The action and goto tables
+// are indexed by the IDs of Terminals and Nonterminals, and row-displaced, as lr.Tables documents.
+var LISPParserTables = &lr.Tables{
+	StartSymbol: "S_expression",
+	Terminals: tokens.NewKinds(
+		"EOF",
+		"identifier",
+		"lparen",
+		"rparen",
+	),
+	Nonterminals: []asts.NodeType{
+		"Atom",
+		"List",
+		"S_expression",
+		"__pgpg_repeat_1",
+		"__pgpg_start_2",
+	},
+	ActionBase: []int32{
+		19, 26, 27, 28, 29, 21, -2, 1, 4, 7, 23, 10, 27, 13, 28, 32,
+		30, 16,
+	},
+	ActionCheck: []int32{
+		6, 6, 6, 7, 7, 7, 8, 8, 8, 9, 9, 9, 11, 11, 11, 13,
+		13, 13, 17, 17, 17, 0, 0, 5, 5, 10, 10, 1, 2, 3, 4, 12,
+		14, 15, 16,
+	},
+	ActionEntries: []int32{
+		5, 5, 5, 9, 9, 9, 36, 40, 13, 25, 25, 25, 36, 40, 13, 36,
+		40, 13, 21, 21, 21, 16, 20, 36, 40, 36, 40, 5, 9, 2, 25, 60,
+		17, 21, 68,
+	},
+	GotoBase: []int32{
+		12, 0, 0, 0, 0, 15, 0, 0, 0, 0, 18, 4, 0, 8, 0, 0,
+		0, 0,
+	},
+	GotoCheck: []int32{
+		8, 8, 8, 8, 11, 11, 11, 11, 13, 13, 13, 13, 0, 0, 0, 5,
+		5, 5, 10, 10, 10,
+	},
+	GotoTargets: []int32{
+		6, 7, 11, 12, 6, 7, 11, 14, 6, 7, 11, 16, 1, 2, 3, 6,
+		7, 8, 6, 7, 13,
+	},
+	Productions: []lr.Production{
+		{LHS: asts.NodeType("__pgpg_start_2"), LHSID: 4, RHSCount: 1},
+		{LHS: asts.NodeType("S_expression"), LHSID: 2, RHSCount: 1},
+		{LHS: asts.NodeType("S_expression"), LHSID: 2, RHSCount: 1},
+		{LHS: asts.NodeType("__pgpg_repeat_1"), LHSID: 3, RHSCount: 0},
+		{LHS: asts.NodeType("__pgpg_repeat_1"), LHSID: 3, RHSCount: 2},
+		{LHS: asts.NodeType("List"), LHSID: 1, RHSCount: 4},
+		{LHS: asts.NodeType("Atom"), LHSID: 0, RHSCount: 1},
 	},
-	14: {
-		tokens.TokenType("rparen"): {Kind: LISPParserActionReduce, Target: 4},
-	},
-	15: {
-		tokens.TokenTypeEOF: {Kind: LISPParserActionReduce, Target: 5},
-	},
-	16: {
-		tokens.TokenType("rparen"): {Kind: LISPParserActionShift, Target: 17},
-	},
-	17: {
-		tokens.TokenType("identifier"): {Kind: LISPParserActionReduce, Target: 5},
-		tokens.TokenType("lparen"):     {Kind: LISPParserActionReduce, Target: 5},
-		tokens.TokenType("rparen"):     {Kind: LISPParserActionReduce, Target: 5},
-	},
-}
-
-var LISPParserGotos = map[int]map[asts.NodeType]int{
-	0: {
-		asts.NodeType("Atom"):         1,
-		asts.NodeType("List"):         2,
-		asts.NodeType("S_expression"): 3,
-	},
-	5: {
-		asts.NodeType("Atom"):         6,
-		asts.NodeType("List"):         7,
-		asts.NodeType("S_expression"): 8,
-	},
-	8: {
-		asts.NodeType("Atom"):            6,
-		asts.NodeType("List"):            7,
-		asts.NodeType("S_expression"):    11,
-		asts.NodeType("__pgpg_repeat_1"): 12,
-	},
-	10: {
-		asts.NodeType("Atom"):         6,
-		asts.NodeType("List"):         7,
-		asts.NodeType("S_expression"): 13,
-	},
-	11: {
-		asts.NodeType("Atom"):            6,
-		asts.NodeType("List"):            7,
-		asts.NodeType("S_expression"):    11,
-		asts.NodeType("__pgpg_repeat_1"): 14,
-	},
-	13: {
-		asts.NodeType("Atom"):            6,
-		asts.NodeType("List"):            7,
-		asts.NodeType("S_expression"):    11,
-		asts.NodeType("__pgpg_repeat_1"): 16,
-	},
-}
-
-var LISPParserProductions = []LISPParserProduction{
-	{lhs: asts.NodeType("__pgpg_start_2"), rhsCount: 1},
-	{lhs: asts.NodeType("S_expression"), rhsCount: 1},
-	{lhs: asts.NodeType("S_expression"), rhsCount: 1},
-	{lhs: asts.NodeType("__pgpg_repeat_1"), rhsCount: 0},
-	{lhs: asts.NodeType("__pgpg_repeat_1"), rhsCount: 2},
-	{lhs: asts.NodeType("List"), rhsCount: 4},
-	{lhs: asts.NodeType("Atom"), rhsCount: 1},
 }
@@ -0,0 +1,84 @@
+package parsers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/apps/go/generated/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+// bracketingHandler renders each terminal as its lexeme and each reduction of more than one
+// symbol as its right-hand side in brackets, showing the order and grouping of the callbacks.
+var bracketingHandler = libparsers.ValueHandlerFuncs[string]{
+	ShiftFunc: func(token *tokens.Token) (string, error) {
+		return string(token.Lexeme), nil
+	},
+	ReduceFunc: func(production int, lhs asts.NodeType, rhs []string) (string, error) {
+		if len(rhs) == 1 {
+			return rhs[0], nil
+		}
+		return "[" + strings.Join(rhs, " ") + "]", nil
+	},
+}
+
+// TestParseWith verifies that ParseWith drives a handler's value stack through the parse.
+func TestParseWith(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"1", "1"},
+		{"1 + 2 * 3", "[1 + [2 * 3]]"},
+		{"(1 - 2) - 3", "[[( [1 - 2] )] - 3]"},
+		{"-2 ** 3", "[- [2 ** 3]]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseWithPEMDASIntParser(NewPEMDASIntParser(), lexers.NewPEMDASIntLexer(strings.NewReader(tt.input)), bracketingHandler)
+			if err != nil {
+				t.Fatalf("ParseWith: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseWithEntryPoint verifies the ParseWith functions for the %start symbols of statements.bnf.
+func TestParseWithEntryPoint(t *testing.T) {
+	got, err := ParseExpressionWithStatementsParser(NewStatementsParser(), lexers.NewStatementsLexer(strings.NewReader("x = 1")), bracketingHandler)
+	if err != nil || got != "[x = 1]" {
+		t.Errorf("got %q, %v; want %q", got, err, "[x = 1]")
+	}
+
+	_, err = ParseExpressionWithStatementsParser(NewStatementsParser(), lexers.NewStatementsLexer(strings.NewReader("x = 1;")), bracketingHandler)
+	var parseError *libparsers.ParseError
+	if !errors.As(err, &parseError) {
+		t.Errorf("error: got %v, want a ParseError", err)
+	}
+}
+
+// TestParseWithHandlerError verifies that an error from the handler stops parsing.
+func TestParseWithHandlerError(t *testing.T) {
+	errDivision := errors.New("no division")
+	handler := libparsers.ValueHandlerFuncs[int]{
+		ShiftFunc: func(token *tokens.Token) (int, error) {
+			if token.Type == "divide" {
+				return 0, errDivision
+			}
+			return 0, nil
+		},
+		ReduceFunc: func(production int, lhs asts.NodeType, rhs []int) (int, error) {
+			return 0, nil
+		},
+	}
+	_, err := ParseWithPEMDASIntParser(NewPEMDASIntParser(), lexers.NewPEMDASIntLexer(strings.NewReader("1 + 2 / 3")), handler)
+	if err != errDivision {
+		t.Errorf("error: got %v, want %v", err, errDivision)
+	}
+}
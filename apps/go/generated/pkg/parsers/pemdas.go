@@ -1,1215 +1,179 @@
 package parsers
 
 import (
-	"fmt"
-	"os"
-	"strings"
-
 	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
 	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
 	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
 )
 
+// PEMDASParser parses with PEMDASParserTables. Parsing, error handling, and the parser's
+// options are those of lr.Parser.
 type PEMDASParser struct {
-	Trace            *PEMDASParserTraceHooks
-	stashedLookahead *tokens.Token
-}
-
-type PEMDASParserTraceHooks struct {
-	OnToken  func(tok *tokens.Token)
-	OnAction func(state int, action PEMDASParserAction, lookahead *tokens.Token)
-	OnStack  func(stateStack []int, nodeStack []*asts.ASTNode)
-}
-
-func NewPEMDASParser() *PEMDASParser { return &PEMDASParser{} }
-
-// noASTSentinel is used as a placeholder on the node stack when astMode == "noast".
-var PEMDASParserNoASTSentinel = &asts.ASTNode{}
-
-func (parser *PEMDASParser) Parse(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {
-	if lexer == nil {
-		return nil, fmt.Errorf("parser: nil lexer")
-	}
-	stateStack := []int{0}
-	nodeStack := []*asts.ASTNode{}
-	lookahead := lexer.Scan()
-	if parser.Trace != nil && parser.Trace.OnToken != nil {
-		parser.Trace.OnToken(lookahead)
-	}
-	for {
-		if lookahead == nil {
-			return nil, fmt.Errorf("parser: lexer returned nil token")
-		}
-		if lookahead.Type == tokens.TokenTypeError {
-			return nil, fmt.Errorf("lexer error: %s", string(lookahead.Lexeme))
-		}
-		state := stateStack[len(stateStack)-1]
-		action, ok := PEMDASParserActions[state][lookahead.Type]
-		if !ok {
-			return nil, fmt.Errorf("parse error: unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme))
-		}
-		if parser.Trace != nil && parser.Trace.OnAction != nil {
-			parser.Trace.OnAction(state, action, lookahead)
-		}
-		switch action.Kind {
-		case PEMDASParserActionShift:
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, PEMDASParserNoASTSentinel)
-			} else {
-				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
-			}
-			stateStack = append(stateStack, action.Target)
-			lookahead = lexer.Scan()
-			if parser.Trace != nil && parser.Trace.OnToken != nil {
-				parser.Trace.OnToken(lookahead)
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case PEMDASParserActionReduce:
-			prod := PEMDASParserProductions[action.Target]
-			rhsNodes := make([]*asts.ASTNode, prod.rhsCount)
-			for i := prod.rhsCount - 1; i >= 0; i-- {
-				stateStack = stateStack[:len(stateStack)-1]
-				rhsNodes[i] = nodeStack[len(nodeStack)-1]
-				nodeStack = nodeStack[:len(nodeStack)-1]
-			}
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, PEMDASParserNoASTSentinel)
-			} else {
-				var node *asts.ASTNode
-				useFullTree := (astMode == "fullast")
-				if !useFullTree && prod.hasPassthrough {
-					node = rhsNodes[prod.passthroughIndex]
-				} else if !useFullTree && prod.hasWithAppendedChildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					if parent != nil && parent.Children != nil {
-						newChildren = append(newChildren, parent.Children...)
-					}
-					for _, ci := range prod.withAppendedChildren {
-						newChildren = append(newChildren, rhsNodes[ci])
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasWithPrependedChildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					for _, ci := range prod.withPrependedChildren {
-						newChildren = append(newChildren, rhsNodes[ci])
-					}
-					if parent != nil && parent.Children != nil {
-						newChildren = append(newChildren, parent.Children...)
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasWithAdoptedGrandchildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					for _, ci := range prod.withAdoptedGrandchildren {
-						childNode := rhsNodes[ci]
-						if childNode != nil && childNode.Children != nil {
-							newChildren = append(newChildren, childNode.Children...)
-						}
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasHint {
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = prod.lhs
-					}
-					var parentToken *tokens.Token
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-					} else if prod.parentIndex >= 0 && prod.parentIndex < len(rhsNodes) {
-						parentToken = rhsNodes[prod.parentIndex].Token
-					}
-					hintChildren := make([]*asts.ASTNode, len(prod.childIndices))
-					for i, ci := range prod.childIndices {
-						hintChildren[i] = rhsNodes[ci]
-					}
-					node = asts.NewASTNode(parentToken, nodeType, hintChildren)
-				} else if prod.rhsCount == 1 {
-					node = rhsNodes[0]
-				} else if prod.rhsCount == 0 {
-					node = asts.NewASTNode(nil, prod.lhs, []*asts.ASTNode{})
-				} else {
-					node = asts.NewASTNode(nil, prod.lhs, rhsNodes)
-				}
-				nodeStack = append(nodeStack, node)
-			}
-			state = stateStack[len(stateStack)-1]
-			nextState, ok := PEMDASParserGotos[state][prod.lhs]
-			if !ok {
-				return nil, fmt.Errorf("parse error: missing goto for %s", prod.lhs)
-			}
-			stateStack = append(stateStack, nextState)
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case PEMDASParserActionAccept:
-			if len(nodeStack) != 1 {
-				return nil, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			if astMode == "noast" {
-				return nil, nil
-			}
-			return asts.NewAST(nodeStack[0]), nil
-		case PEMDASParserActionAcceptAndYield:
-			return nil, fmt.Errorf("parse error: multiple objects; use ParseOne for multi-object input")
-		default:
-			return nil, fmt.Errorf("parse error: no action")
-		}
-	}
-}
-
-// ParseOne parses one record from the lexer. It is for multi-object input: call in a loop until done.
-// Returns (ast, true, nil) on EOF after a record, (ast, false, nil) when more input follows, or (nil, false, err) on error.
-func (parser *PEMDASParser) ParseOne(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, bool, error) {
-	if lexer == nil {
-		return nil, false, fmt.Errorf("parser: nil lexer")
-	}
-	stateStack := []int{0}
-	nodeStack := []*asts.ASTNode{}
-	var lookahead *tokens.Token
-	if parser.stashedLookahead != nil {
-		lookahead = parser.stashedLookahead
-		parser.stashedLookahead = nil
-	} else {
-		lookahead = lexer.Scan()
-	}
-	if parser.Trace != nil && parser.Trace.OnToken != nil {
-		parser.Trace.OnToken(lookahead)
-	}
-	for {
-		if lookahead == nil {
-			return nil, false, fmt.Errorf("parser: lexer returned nil token")
-		}
-		if lookahead.Type == tokens.TokenTypeError {
-			return nil, false, fmt.Errorf("lexer error: %s", string(lookahead.Lexeme))
-		}
-		state := stateStack[len(stateStack)-1]
-		action, ok := PEMDASParserActions[state][lookahead.Type]
-		if !ok {
-			return nil, false, fmt.Errorf("parse error: unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme))
-		}
-		if parser.Trace != nil && parser.Trace.OnAction != nil {
-			parser.Trace.OnAction(state, action, lookahead)
-		}
-		switch action.Kind {
-		case PEMDASParserActionShift:
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, PEMDASParserNoASTSentinel)
-			} else {
-				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
-			}
-			stateStack = append(stateStack, action.Target)
-			lookahead = lexer.Scan()
-			if parser.Trace != nil && parser.Trace.OnToken != nil {
-				parser.Trace.OnToken(lookahead)
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case PEMDASParserActionReduce:
-			prod := PEMDASParserProductions[action.Target]
-			rhsNodes := make([]*asts.ASTNode, prod.rhsCount)
-			for i := prod.rhsCount - 1; i >= 0; i-- {
-				stateStack = stateStack[:len(stateStack)-1]
-				rhsNodes[i] = nodeStack[len(nodeStack)-1]
-				nodeStack = nodeStack[:len(nodeStack)-1]
-			}
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, PEMDASParserNoASTSentinel)
-			} else {
-				var node *asts.ASTNode
-				useFullTree := (astMode == "fullast")
-				if !useFullTree && prod.hasPassthrough {
-					node = rhsNodes[prod.passthroughIndex]
-				} else if !useFullTree && prod.hasWithAppendedChildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					if parent != nil && parent.Children != nil {
-						newChildren = append(newChildren, parent.Children...)
-					}
-					for _, ci := range prod.withAppendedChildren {
-						newChildren = append(newChildren, rhsNodes[ci])
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasWithPrependedChildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					for _, ci := range prod.withPrependedChildren {
-						newChildren = append(newChildren, rhsNodes[ci])
-					}
-					if parent != nil && parent.Children != nil {
-						newChildren = append(newChildren, parent.Children...)
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasWithAdoptedGrandchildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					for _, ci := range prod.withAdoptedGrandchildren {
-						childNode := rhsNodes[ci]
-						if childNode != nil && childNode.Children != nil {
-							newChildren = append(newChildren, childNode.Children...)
-						}
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasHint {
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = prod.lhs
-					}
-					var parentToken *tokens.Token
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-					} else if prod.parentIndex >= 0 && prod.parentIndex < len(rhsNodes) {
-						parentToken = rhsNodes[prod.parentIndex].Token
-					}
-					hintChildren := make([]*asts.ASTNode, len(prod.childIndices))
-					for i, ci := range prod.childIndices {
-						hintChildren[i] = rhsNodes[ci]
-					}
-					node = asts.NewASTNode(parentToken, nodeType, hintChildren)
-				} else if prod.rhsCount == 1 {
-					node = rhsNodes[0]
-				} else if prod.rhsCount == 0 {
-					node = asts.NewASTNode(nil, prod.lhs, []*asts.ASTNode{})
-				} else {
-					node = asts.NewASTNode(nil, prod.lhs, rhsNodes)
-				}
-				nodeStack = append(nodeStack, node)
-			}
-			state = stateStack[len(stateStack)-1]
-			nextState, ok := PEMDASParserGotos[state][prod.lhs]
-			if !ok {
-				return nil, false, fmt.Errorf("parse error: missing goto for %s", prod.lhs)
-			}
-			stateStack = append(stateStack, nextState)
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case PEMDASParserActionAccept:
-			if len(nodeStack) != 1 {
-				return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			if astMode == "noast" {
-				return nil, true, nil
-			}
-			return asts.NewAST(nodeStack[0]), true, nil
-		case PEMDASParserActionAcceptAndYield:
-			if len(nodeStack) != 1 {
-				return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			parser.stashedLookahead = lookahead
-			if astMode == "noast" {
-				return nil, false, nil
-			}
-			return asts.NewAST(nodeStack[0]), false, nil
-		default:
-			return nil, false, fmt.Errorf("parse error: no action")
-		}
-	}
-}
-
-// AttachCLITrace installs tracing hooks for CLI debugging.
-func (parser *PEMDASParser) AttachCLITrace(traceTokens bool, traceStates bool, traceStack bool) {
-	if !traceTokens && !traceStates && !traceStack {
-		return
-	}
-	parser.Trace = &PEMDASParserTraceHooks{
-		OnToken: func(tok *tokens.Token) {
-			if !traceTokens {
-				return
-			}
-			fmt.Fprintln(os.Stderr, formatPEMDASParserToken(tok))
-		},
-		OnAction: func(state int, action PEMDASParserAction, lookahead *tokens.Token) {
-			if !traceStates {
-				return
-			}
-			fmt.Fprintf(os.Stderr, "STATE %d %s on %s(%q)\n",
-				state, formatPEMDASParserAction(action), tokenTypeNamePEMDASParser(lookahead), tokenLexemePEMDASParser(lookahead))
-		},
-		OnStack: func(stateStack []int, nodeStack []*asts.ASTNode) {
-			if !traceStack {
-				return
-			}
-			fmt.Fprintf(os.Stderr, "STACK states=%s nodes=%s\n",
-				formatPEMDASParserIntStack(stateStack), formatPEMDASParserNodeStack(nodeStack))
-		},
-	}
-}
-
-type PEMDASParserActionKind int
-
-const (
-	PEMDASParserActionShift PEMDASParserActionKind = iota
-	PEMDASParserActionReduce
-	PEMDASParserActionAccept
-	PEMDASParserActionAcceptAndYield
-)
-
-type PEMDASParserAction struct {
-	Kind   PEMDASParserActionKind
-	Target int
-}
-
-func formatPEMDASParserToken(tok *tokens.Token) string {
-	if tok == nil {
-		return "TOK <nil>"
-	}
-	return fmt.Sprintf("TOK type=%s lexeme=%q line=%d col=%d",
-		tok.Type, string(tok.Lexeme), tok.Location.LineNumber, tok.Location.ColumnNumber)
-}
-
-func tokenTypeNamePEMDASParser(tok *tokens.Token) string {
-	if tok == nil {
-		return "<nil>"
-	}
-	return string(tok.Type)
-}
-
-func tokenLexemePEMDASParser(tok *tokens.Token) string {
-	if tok == nil {
-		return ""
-	}
-	return string(tok.Lexeme)
-}
-
-func formatPEMDASParserIntStack(stack []int) string {
-	parts := make([]string, len(stack))
-	for i, v := range stack {
-		parts[i] = fmt.Sprintf("%d", v)
-	}
-	return "[" + strings.Join(parts, " ") + "]"
-}
-
-func formatPEMDASParserNodeStack(stack []*asts.ASTNode) string {
-	parts := make([]string, len(stack))
-	for i, node := range stack {
-		if node == nil {
-			parts[i] = "<nil>"
-			continue
-		}
-		parts[i] = string(node.Type)
-	}
-	return "[" + strings.Join(parts, " ") + "]"
-}
-
-func formatPEMDASParserAction(action PEMDASParserAction) string {
-	switch action.Kind {
-	case PEMDASParserActionShift:
-		return fmt.Sprintf("shift(%d)", action.Target)
-	case PEMDASParserActionReduce:
-		return fmt.Sprintf("reduce(%d)", action.Target)
-	case PEMDASParserActionAccept:
-		return "accept"
-	case PEMDASParserActionAcceptAndYield:
-		return "accept_and_yield"
-	default:
-		return "unknown"
-	}
-}
-
-type PEMDASParserProduction struct {
-	lhs                         asts.NodeType
-	rhsCount                    int
-	hasHint                     bool
-	hasPassthrough              bool
-	hasParentLiteral            bool
-	hasWithAppendedChildren     bool
-	hasWithPrependedChildren    bool
-	hasWithAdoptedGrandchildren bool
-	parentIndex                 int
-	passthroughIndex            int
-	parentLiteral               string
-	childIndices                []int
-	withAppendedChildren        []int
-	withPrependedChildren       []int
-	withAdoptedGrandchildren    []int
-	nodeType                    asts.NodeType
-}
-
-var PEMDASParserActions = map[int]map[tokens.TokenType]PEMDASParserAction{
-	0: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 10},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 11},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 12},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 13},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 14},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 15},
-	},
-	1: {
-		tokens.TokenTypeEOF:               {Kind: PEMDASParserActionReduce, Target: 3},
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionReduce, Target: 3},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionReduce, Target: 3},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionReduce, Target: 3},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionReduce, Target: 3},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 16},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 17},
-	},
-	2: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASParserActionReduce, Target: 13},
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 13},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 13},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 13},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 13},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 13},
-	},
-	3: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASParserActionReduce, Target: 6},
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionShift, Target: 18},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 6},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionShift, Target: 19},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 6},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionShift, Target: 20},
-	},
-	4: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASParserActionReduce, Target: 16},
-		tokens.TokenType("divide"):         {Kind: PEMDASParserActionReduce, Target: 16},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASParserActionShift, Target: 21},
-		tokens.TokenType("minus"):          {Kind: PEMDASParserActionReduce, Target: 16},
-		tokens.TokenType("modulo"):         {Kind: PEMDASParserActionReduce, Target: 16},
-		tokens.TokenType("plus"):           {Kind: PEMDASParserActionReduce, Target: 16},
-		tokens.TokenType("times"):          {Kind: PEMDASParserActionReduce, Target: 16},
-	},
-	5: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASParserActionReduce, Target: 18},
-		tokens.TokenType("divide"):         {Kind: PEMDASParserActionReduce, Target: 18},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASParserActionReduce, Target: 18},
-		tokens.TokenType("minus"):          {Kind: PEMDASParserActionReduce, Target: 18},
-		tokens.TokenType("modulo"):         {Kind: PEMDASParserActionReduce, Target: 18},
-		tokens.TokenType("plus"):           {Kind: PEMDASParserActionReduce, Target: 18},
-		tokens.TokenType("times"):          {Kind: PEMDASParserActionReduce, Target: 18},
-	},
-	6: {
-		tokens.TokenTypeEOF:               {Kind: PEMDASParserActionReduce, Target: 2},
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionReduce, Target: 2},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionReduce, Target: 2},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionReduce, Target: 2},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionReduce, Target: 2},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionReduce, Target: 2},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionReduce, Target: 2},
-	},
-	7: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASParserActionAccept},
-		tokens.TokenType("divide"):         {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("float_literal"):  {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("hex_literal"):    {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("int_literal"):    {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("lparen"):         {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("minus"):          {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("modulo"):         {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("plus"):           {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("rparen"):         {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("times"):          {Kind: PEMDASParserActionAcceptAndYield},
-	},
-	8: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASParserActionReduce, Target: 1},
-		tokens.TokenType("divide"):         {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("float_literal"):  {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("hex_literal"):    {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("int_literal"):    {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("lparen"):         {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("minus"):          {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("modulo"):         {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("plus"):           {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("rparen"):         {Kind: PEMDASParserActionAcceptAndYield},
-		tokens.TokenType("times"):          {Kind: PEMDASParserActionAcceptAndYield},
-	},
-	9: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASParserActionReduce, Target: 10},
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 10},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 10},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 10},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 10},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 10},
-	},
-	10: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASParserActionReduce, Target: 21},
-		tokens.TokenType("divide"):         {Kind: PEMDASParserActionReduce, Target: 21},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASParserActionReduce, Target: 21},
-		tokens.TokenType("minus"):          {Kind: PEMDASParserActionReduce, Target: 21},
-		tokens.TokenType("modulo"):         {Kind: PEMDASParserActionReduce, Target: 21},
-		tokens.TokenType("plus"):           {Kind: PEMDASParserActionReduce, Target: 21},
-		tokens.TokenType("times"):          {Kind: PEMDASParserActionReduce, Target: 21},
-	},
-	11: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASParserActionReduce, Target: 20},
-		tokens.TokenType("divide"):         {Kind: PEMDASParserActionReduce, Target: 20},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASParserActionReduce, Target: 20},
-		tokens.TokenType("minus"):          {Kind: PEMDASParserActionReduce, Target: 20},
-		tokens.TokenType("modulo"):         {Kind: PEMDASParserActionReduce, Target: 20},
-		tokens.TokenType("plus"):           {Kind: PEMDASParserActionReduce, Target: 20},
-		tokens.TokenType("times"):          {Kind: PEMDASParserActionReduce, Target: 20},
-	},
-	12: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASParserActionReduce, Target: 19},
-		tokens.TokenType("divide"):         {Kind: PEMDASParserActionReduce, Target: 19},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASParserActionReduce, Target: 19},
-		tokens.TokenType("minus"):          {Kind: PEMDASParserActionReduce, Target: 19},
-		tokens.TokenType("modulo"):         {Kind: PEMDASParserActionReduce, Target: 19},
-		tokens.TokenType("plus"):           {Kind: PEMDASParserActionReduce, Target: 19},
-		tokens.TokenType("times"):          {Kind: PEMDASParserActionReduce, Target: 19},
-	},
-	13: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 29},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 30},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 31},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 32},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 33},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 34},
-	},
-	14: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 10},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 11},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 12},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 13},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 14},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 15},
-	},
-	15: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 10},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 11},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 12},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 13},
-	},
-	16: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 10},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 11},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 12},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 13},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 14},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 15},
-	},
-	17: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 10},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 11},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 12},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 13},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 14},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 15},
-	},
-	18: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 10},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 11},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 12},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 13},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 14},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 15},
-	},
-	19: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 10},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 11},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 12},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 13},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 14},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 15},
-	},
-	20: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 10},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 11},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 12},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 13},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 14},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 15},
-	},
-	21: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 10},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 11},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 12},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 13},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 43},
-	},
-	22: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionReduce, Target: 3},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionReduce, Target: 3},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionReduce, Target: 3},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionReduce, Target: 3},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 44},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 45},
-		tokens.TokenType("rparen"):        {Kind: PEMDASParserActionReduce, Target: 3},
-	},
-	23: {
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 13},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 13},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 13},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 13},
-		tokens.TokenType("rparen"): {Kind: PEMDASParserActionReduce, Target: 13},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 13},
-	},
-	24: {
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionShift, Target: 46},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 6},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionShift, Target: 47},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 6},
-		tokens.TokenType("rparen"): {Kind: PEMDASParserActionReduce, Target: 6},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionShift, Target: 48},
-	},
-	25: {
-		tokens.TokenType("divide"):         {Kind: PEMDASParserActionReduce, Target: 16},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASParserActionShift, Target: 49},
-		tokens.TokenType("minus"):          {Kind: PEMDASParserActionReduce, Target: 16},
-		tokens.TokenType("modulo"):         {Kind: PEMDASParserActionReduce, Target: 16},
-		tokens.TokenType("plus"):           {Kind: PEMDASParserActionReduce, Target: 16},
-		tokens.TokenType("rparen"):         {Kind: PEMDASParserActionReduce, Target: 16},
-		tokens.TokenType("times"):          {Kind: PEMDASParserActionReduce, Target: 16},
-	},
-	26: {
-		tokens.TokenType("divide"):         {Kind: PEMDASParserActionReduce, Target: 18},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASParserActionReduce, Target: 18},
-		tokens.TokenType("minus"):          {Kind: PEMDASParserActionReduce, Target: 18},
-		tokens.TokenType("modulo"):         {Kind: PEMDASParserActionReduce, Target: 18},
-		tokens.TokenType("plus"):           {Kind: PEMDASParserActionReduce, Target: 18},
-		tokens.TokenType("rparen"):         {Kind: PEMDASParserActionReduce, Target: 18},
-		tokens.TokenType("times"):          {Kind: PEMDASParserActionReduce, Target: 18},
-	},
-	27: {
-		tokens.TokenType("rparen"): {Kind: PEMDASParserActionShift, Target: 50},
-	},
-	28: {
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 10},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 10},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 10},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 10},
-		tokens.TokenType("rparen"): {Kind: PEMDASParserActionReduce, Target: 10},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 10},
-	},
-	29: {
-		tokens.TokenType("divide"):         {Kind: PEMDASParserActionReduce, Target: 21},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASParserActionReduce, Target: 21},
-		tokens.TokenType("minus"):          {Kind: PEMDASParserActionReduce, Target: 21},
-		tokens.TokenType("modulo"):         {Kind: PEMDASParserActionReduce, Target: 21},
-		tokens.TokenType("plus"):           {Kind: PEMDASParserActionReduce, Target: 21},
-		tokens.TokenType("rparen"):         {Kind: PEMDASParserActionReduce, Target: 21},
-		tokens.TokenType("times"):          {Kind: PEMDASParserActionReduce, Target: 21},
-	},
-	30: {
-		tokens.TokenType("divide"):         {Kind: PEMDASParserActionReduce, Target: 20},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASParserActionReduce, Target: 20},
-		tokens.TokenType("minus"):          {Kind: PEMDASParserActionReduce, Target: 20},
-		tokens.TokenType("modulo"):         {Kind: PEMDASParserActionReduce, Target: 20},
-		tokens.TokenType("plus"):           {Kind: PEMDASParserActionReduce, Target: 20},
-		tokens.TokenType("rparen"):         {Kind: PEMDASParserActionReduce, Target: 20},
-		tokens.TokenType("times"):          {Kind: PEMDASParserActionReduce, Target: 20},
-	},
-	31: {
-		tokens.TokenType("divide"):         {Kind: PEMDASParserActionReduce, Target: 19},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASParserActionReduce, Target: 19},
-		tokens.TokenType("minus"):          {Kind: PEMDASParserActionReduce, Target: 19},
-		tokens.TokenType("modulo"):         {Kind: PEMDASParserActionReduce, Target: 19},
-		tokens.TokenType("plus"):           {Kind: PEMDASParserActionReduce, Target: 19},
-		tokens.TokenType("rparen"):         {Kind: PEMDASParserActionReduce, Target: 19},
-		tokens.TokenType("times"):          {Kind: PEMDASParserActionReduce, Target: 19},
-	},
-	32: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 29},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 30},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 31},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 32},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 33},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 34},
-	},
-	33: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 29},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 30},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 31},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 32},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 33},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 34},
-	},
-	34: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 29},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 30},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 31},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 32},
-	},
-	35: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASParserActionReduce, Target: 12},
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 12},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 12},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 12},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 12},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 12},
-	},
-	36: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASParserActionReduce, Target: 11},
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 11},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 11},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 11},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 11},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 11},
-	},
-	37: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASParserActionReduce, Target: 5},
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionShift, Target: 18},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 5},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionShift, Target: 19},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 5},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionShift, Target: 20},
-	},
-	38: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASParserActionReduce, Target: 4},
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionShift, Target: 18},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 4},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionShift, Target: 19},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 4},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionShift, Target: 20},
-	},
-	39: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASParserActionReduce, Target: 8},
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 8},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 8},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 8},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 8},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 8},
-	},
-	40: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASParserActionReduce, Target: 9},
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 9},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 9},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 9},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 9},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 9},
-	},
-	41: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASParserActionReduce, Target: 7},
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 7},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 7},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 7},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 7},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 7},
-	},
-	42: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASParserActionReduce, Target: 14},
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 14},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 14},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 14},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 14},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 14},
-	},
-	43: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 10},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 11},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 12},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 13},
-	},
-	44: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 29},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 30},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 31},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 32},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 33},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 34},
-	},
-	45: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 29},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 30},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 31},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 32},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 33},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 34},
-	},
-	46: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 29},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 30},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 31},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 32},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 33},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 34},
-	},
-	47: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 29},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 30},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 31},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 32},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 33},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 34},
-	},
-	48: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 29},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 30},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 31},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 32},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 33},
-		tokens.TokenType("plus"):          {Kind: PEMDASParserActionShift, Target: 34},
-	},
-	49: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 29},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 30},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 31},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 32},
-		tokens.TokenType("minus"):         {Kind: PEMDASParserActionShift, Target: 61},
-	},
-	50: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASParserActionReduce, Target: 17},
-		tokens.TokenType("divide"):         {Kind: PEMDASParserActionReduce, Target: 17},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASParserActionReduce, Target: 17},
-		tokens.TokenType("minus"):          {Kind: PEMDASParserActionReduce, Target: 17},
-		tokens.TokenType("modulo"):         {Kind: PEMDASParserActionReduce, Target: 17},
-		tokens.TokenType("plus"):           {Kind: PEMDASParserActionReduce, Target: 17},
-		tokens.TokenType("times"):          {Kind: PEMDASParserActionReduce, Target: 17},
-	},
-	51: {
-		tokens.TokenType("rparen"): {Kind: PEMDASParserActionShift, Target: 62},
-	},
-	52: {
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 12},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 12},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 12},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 12},
-		tokens.TokenType("rparen"): {Kind: PEMDASParserActionReduce, Target: 12},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 12},
-	},
-	53: {
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 11},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 11},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 11},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 11},
-		tokens.TokenType("rparen"): {Kind: PEMDASParserActionReduce, Target: 11},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 11},
-	},
-	54: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASParserActionReduce, Target: 15},
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 15},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 15},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 15},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 15},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 15},
-	},
-	55: {
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionShift, Target: 46},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 5},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionShift, Target: 47},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 5},
-		tokens.TokenType("rparen"): {Kind: PEMDASParserActionReduce, Target: 5},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionShift, Target: 48},
-	},
-	56: {
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionShift, Target: 46},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 4},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionShift, Target: 47},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 4},
-		tokens.TokenType("rparen"): {Kind: PEMDASParserActionReduce, Target: 4},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionShift, Target: 48},
-	},
-	57: {
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 8},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 8},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 8},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 8},
-		tokens.TokenType("rparen"): {Kind: PEMDASParserActionReduce, Target: 8},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 8},
-	},
-	58: {
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 9},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 9},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 9},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 9},
-		tokens.TokenType("rparen"): {Kind: PEMDASParserActionReduce, Target: 9},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 9},
-	},
-	59: {
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 7},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 7},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 7},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 7},
-		tokens.TokenType("rparen"): {Kind: PEMDASParserActionReduce, Target: 7},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 7},
-	},
-	60: {
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 14},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 14},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 14},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 14},
-		tokens.TokenType("rparen"): {Kind: PEMDASParserActionReduce, Target: 14},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 14},
-	},
-	61: {
-		tokens.TokenType("float_literal"): {Kind: PEMDASParserActionShift, Target: 29},
-		tokens.TokenType("hex_literal"):   {Kind: PEMDASParserActionShift, Target: 30},
-		tokens.TokenType("int_literal"):   {Kind: PEMDASParserActionShift, Target: 31},
-		tokens.TokenType("lparen"):        {Kind: PEMDASParserActionShift, Target: 32},
-	},
-	62: {
-		tokens.TokenType("divide"):         {Kind: PEMDASParserActionReduce, Target: 17},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASParserActionReduce, Target: 17},
-		tokens.TokenType("minus"):          {Kind: PEMDASParserActionReduce, Target: 17},
-		tokens.TokenType("modulo"):         {Kind: PEMDASParserActionReduce, Target: 17},
-		tokens.TokenType("plus"):           {Kind: PEMDASParserActionReduce, Target: 17},
-		tokens.TokenType("rparen"):         {Kind: PEMDASParserActionReduce, Target: 17},
-		tokens.TokenType("times"):          {Kind: PEMDASParserActionReduce, Target: 17},
-	},
-	63: {
-		tokens.TokenType("divide"): {Kind: PEMDASParserActionReduce, Target: 15},
-		tokens.TokenType("minus"):  {Kind: PEMDASParserActionReduce, Target: 15},
-		tokens.TokenType("modulo"): {Kind: PEMDASParserActionReduce, Target: 15},
-		tokens.TokenType("plus"):   {Kind: PEMDASParserActionReduce, Target: 15},
-		tokens.TokenType("rparen"): {Kind: PEMDASParserActionReduce, Target: 15},
-		tokens.TokenType("times"):  {Kind: PEMDASParserActionReduce, Target: 15},
-	},
-}
-
-var PEMDASParserGotos = map[int]map[asts.NodeType]int{
-	0: {
-		asts.NodeType("AddSubTerm"):           1,
-		asts.NodeType("ExponentiationTerm"):   2,
-		asts.NodeType("MulDivTerm"):           3,
-		asts.NodeType("ParenTerm"):            4,
-		asts.NodeType("PrecedenceChainEnd"):   5,
-		asts.NodeType("PrecedenceChainStart"): 6,
-		asts.NodeType("Root"):                 7,
-		asts.NodeType("Rvalue"):               8,
-		asts.NodeType("UnaryTerm"):            9,
-	},
-	13: {
-		asts.NodeType("AddSubTerm"):           22,
-		asts.NodeType("ExponentiationTerm"):   23,
-		asts.NodeType("MulDivTerm"):           24,
-		asts.NodeType("ParenTerm"):            25,
-		asts.NodeType("PrecedenceChainEnd"):   26,
-		asts.NodeType("PrecedenceChainStart"): 27,
-		asts.NodeType("UnaryTerm"):            28,
-	},
-	14: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          35,
-	},
-	15: {
-		asts.NodeType("ExponentiationTerm"): 36,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-	},
-	16: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("MulDivTerm"):         37,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          9,
-	},
-	17: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("MulDivTerm"):         38,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          9,
-	},
-	18: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          39,
-	},
-	19: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          40,
-	},
-	20: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          41,
-	},
-	21: {
-		asts.NodeType("ExponentiationTerm"): 42,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-	},
-	32: {
-		asts.NodeType("AddSubTerm"):           22,
-		asts.NodeType("ExponentiationTerm"):   23,
-		asts.NodeType("MulDivTerm"):           24,
-		asts.NodeType("ParenTerm"):            25,
-		asts.NodeType("PrecedenceChainEnd"):   26,
-		asts.NodeType("PrecedenceChainStart"): 51,
-		asts.NodeType("UnaryTerm"):            28,
-	},
-	33: {
-		asts.NodeType("ExponentiationTerm"): 23,
-		asts.NodeType("ParenTerm"):          25,
-		asts.NodeType("PrecedenceChainEnd"): 26,
-		asts.NodeType("UnaryTerm"):          52,
-	},
-	34: {
-		asts.NodeType("ExponentiationTerm"): 53,
-		asts.NodeType("ParenTerm"):          25,
-		asts.NodeType("PrecedenceChainEnd"): 26,
-	},
-	43: {
-		asts.NodeType("ExponentiationTerm"): 54,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-	},
-	44: {
-		asts.NodeType("ExponentiationTerm"): 23,
-		asts.NodeType("MulDivTerm"):         55,
-		asts.NodeType("ParenTerm"):          25,
-		asts.NodeType("PrecedenceChainEnd"): 26,
-		asts.NodeType("UnaryTerm"):          28,
-	},
-	45: {
-		asts.NodeType("ExponentiationTerm"): 23,
-		asts.NodeType("MulDivTerm"):         56,
-		asts.NodeType("ParenTerm"):          25,
-		asts.NodeType("PrecedenceChainEnd"): 26,
-		asts.NodeType("UnaryTerm"):          28,
-	},
-	46: {
-		asts.NodeType("ExponentiationTerm"): 23,
-		asts.NodeType("ParenTerm"):          25,
-		asts.NodeType("PrecedenceChainEnd"): 26,
-		asts.NodeType("UnaryTerm"):          57,
-	},
-	47: {
-		asts.NodeType("ExponentiationTerm"): 23,
-		asts.NodeType("ParenTerm"):          25,
-		asts.NodeType("PrecedenceChainEnd"): 26,
-		asts.NodeType("UnaryTerm"):          58,
-	},
-	48: {
-		asts.NodeType("ExponentiationTerm"): 23,
-		asts.NodeType("ParenTerm"):          25,
-		asts.NodeType("PrecedenceChainEnd"): 26,
-		asts.NodeType("UnaryTerm"):          59,
-	},
-	49: {
-		asts.NodeType("ExponentiationTerm"): 60,
-		asts.NodeType("ParenTerm"):          25,
-		asts.NodeType("PrecedenceChainEnd"): 26,
-	},
-	61: {
-		asts.NodeType("ExponentiationTerm"): 63,
-		asts.NodeType("ParenTerm"):          25,
-		asts.NodeType("PrecedenceChainEnd"): 26,
-	},
-}
-
-var PEMDASParserProductions = []PEMDASParserProduction{
-	{lhs: asts.NodeType("__pgpg_start_1"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Root"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Rvalue"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("PrecedenceChainStart"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("AddSubTerm"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 2}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("operator")},
-	{lhs: asts.NodeType("AddSubTerm"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 2}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("operator")},
-	{lhs: asts.NodeType("AddSubTerm"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("MulDivTerm"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 2}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("operator")},
-	{lhs: asts.NodeType("MulDivTerm"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 2}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("operator")},
-	{lhs: asts.NodeType("MulDivTerm"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 2}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("operator")},
-	{lhs: asts.NodeType("MulDivTerm"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("UnaryTerm"), rhsCount: 2, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{1}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("unary")},
-	{lhs: asts.NodeType("UnaryTerm"), rhsCount: 2, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{1}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("unary")},
-	{lhs: asts.NodeType("UnaryTerm"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("ExponentiationTerm"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 2}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("operator")},
-	{lhs: asts.NodeType("ExponentiationTerm"), rhsCount: 4, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 3}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("operator")},
-	{lhs: asts.NodeType("ExponentiationTerm"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("ParenTerm"), rhsCount: 3, hasHint: false, hasPassthrough: true, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 1, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("ParenTerm"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("PrecedenceChainEnd"), rhsCount: 1, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("int_literal")},
-	{lhs: asts.NodeType("PrecedenceChainEnd"), rhsCount: 1, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("hex_literal")},
-	{lhs: asts.NodeType("PrecedenceChainEnd"), rhsCount: 1, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("float_literal")},
+	lr.Parser
+}
+
+func NewPEMDASParser() *PEMDASParser {
+	return &PEMDASParser{Parser: lr.Parser{Tables: PEMDASParserTables}}
+}
+
+// ParseWithPEMDASParser parses the input, computing values of type T with the handler in place of
+// building an AST, and returns the start symbol's value. Syntax errors may be repaired, as with
+// RepairErrors, but error productions are not used.
+func ParseWithPEMDASParser[T any](parser *PEMDASParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {
+	return lr.ParseWith(&parser.Parser, lexer, handler)
+}
+
+// PEMDASParserTables are the parser's LR tables, run by the lr package. The action and goto tables
+// are indexed by the IDs of Terminals and Nonterminals, and row-displaced, as lr.Tables documents.
+var PEMDASParserTables = &lr.Tables{
+	StartSymbol: "Root",
+	Terminals: tokens.NewKinds(
+		"EOF",
+		"divide",
+		"exponentiation",
+		"float_literal",
+		"hex_literal",
+		"int_literal",
+		"lparen",
+		"minus",
+		"modulo",
+		"plus",
+		"rparen",
+		"times",
+	),
+	Nonterminals: []asts.NodeType{
+		"AddSubTerm",
+		"ExponentiationTerm",
+		"MulDivTerm",
+		"ParenTerm",
+		"PrecedenceChainEnd",
+		"PrecedenceChainStart",
+		"Root",
+		"Rvalue",
+		"UnaryTerm",
+		"__pgpg_start_1",
+	},
+	ActionBase: []int32{
+		134, 443, 144, 149, -1, 11, 9, 21, 33, 161, 23, 35, 47, 170, 177, -1,
+		184, 191, 198, 205, 212, 160, 43, 219, 224, 58, 69, 35, 235, 80, 91, 102,
+		244, 251, 11, 261, 266, 278, 283, 295, 300, 312, 317, 23, 326, 333, 340, 347,
+		354, 234, 114, 41, 361, 366, 378, 383, 394, 399, 410, 415, 426, 35, 125, 431,
+	},
+	ActionCheck: []int32{
+		4, 4, 4, 15, 15, 15, 15, 4, 4, 4, 6, 4, 5, 5, 5, 34,
+		34, 34, 34, 5, 5, 5, 7, 5, 10, 10, 10, 43, 43, 43, 43, 10,
+		10, 10, 8, 10, 11, 11, 11, 61, 61, 61, 61, 11, 11, 11, 27, 11,
+		12, 12, 12, 22, 51, 22, 22, 12, 12, 12, -1, 12, 25, 25, -1, -1,
+		-1, -1, 25, 25, 25, 25, 25, 26, 26, -1, -1, -1, -1, 26, 26, 26,
+		26, 26, 29, 29, -1, -1, -1, -1, 29, 29, 29, 29, 29, 30, 30, -1,
+		-1, -1, -1, 30, 30, 30, 30, 30, 31, 31, -1, -1, -1, -1, 31, 31,
+		31, 31, 31, 50, 50, 50, -1, -1, -1, -1, 50, 50, 50, -1, 50, 62,
+		62, -1, -1, -1, -1, 62, 62, 62, 62, 62, 0, 0, 0, 0, 0, -1,
+		0, 2, 2, -1, -1, -1, 3, 3, 2, 2, 2, -1, 2, 3, 3, 3,
+		-1, 3, 9, 9, 21, 21, 21, 21, 21, 9, 9, 9, -1, 9, 13, 13,
+		13, 13, 13, -1, 13, 14, 14, 14, 14, 14, -1, 14, 16, 16, 16, 16,
+		16, -1, 16, 17, 17, 17, 17, 17, -1, 17, 18, 18, 18, 18, 18, -1,
+		18, 19, 19, 19, 19, 19, -1, 19, 20, 20, 20, 20, 20, 23, 20, -1,
+		-1, -1, 24, 23, 23, 23, 23, 23, 24, 24, 24, 24, 24, 28, 49, 49,
+		49, 49, 49, 28, 28, 28, 28, 28, 32, 32, 32, 32, 32, -1, 32, 33,
+		33, 33, 33, 33, -1, 33, 35, 35, -1, -1, -1, 36, 36, 35, 35, 35,
+		-1, 35, 36, 36, 36, -1, 36, 37, 37, -1, -1, -1, 38, 38, 37, 37,
+		37, -1, 37, 38, 38, 38, -1, 38, 39, 39, -1, -1, -1, 40, 40, 39,
+		39, 39, -1, 39, 40, 40, 40, -1, 40, 41, 41, -1, -1, -1, 42, 42,
+		41, 41, 41, -1, 41, 42, 42, 42, -1, 42, 44, 44, 44, 44, 44, -1,
+		44, 45, 45, 45, 45, 45, -1, 45, 46, 46, 46, 46, 46, -1, 46, 47,
+		47, 47, 47, 47, -1, 47, 48, 48, 48, 48, 48, 52, 48, -1, -1, -1,
+		53, 52, 52, 52, 52, 52, 53, 53, 53, 53, 53, 54, 54, -1, -1, -1,
+		-1, 55, 54, 54, 54, -1, 54, 55, 55, 55, 55, 55, 56, -1, -1, -1,
+		-1, 57, 56, 56, 56, 56, 56, 57, 57, 57, 57, 57, 58, -1, -1, -1,
+		-1, 59, 58, 58, 58, 58, 58, 59, 59, 59, 59, 59, 60, -1, -1, -1,
+		-1, 63, 60, 60, 60, 60, 60, 63, 63, 63, 63, 63, 1, -1, -1, -1,
+		-1, -1, -1, 1, -1, 1,
+	},
+	ActionEntries: []int32{
+		65, 65, 84, 40, 44, 48, 52, 65, 65, 65, 9, 65, 73, 73, 73, 116,
+		120, 124, 128, 73, 73, 73, 2, 73, 85, 85, 85, 40, 44, 48, 52, 85,
+		85, 85, 5, 85, 81, 81, 81, 116, 120, 124, 128, 81, 81, 81, 200, 81,
+		77, 77, 77, 176, 248, 180, 13, 77, 77, 77, 0, 77, 65, 196, 0, 0,
+		0, 0, 65, 65, 65, 65, 65, 73, 73, 0, 0, 0, 0, 73, 73, 73,
+		73, 73, 85, 85, 0, 0, 0, 0, 85, 85, 85, 85, 85, 81, 81, 0,
+		0, 0, 0, 81, 81, 81, 81, 81, 77, 77, 0, 0, 0, 0, 77, 77,
+		77, 77, 77, 69, 69, 69, 0, 0, 0, 0, 69, 69, 69, 0, 69, 69,
+		69, 0, 0, 0, 0, 69, 69, 69, 69, 69, 40, 44, 48, 52, 56, 0,
+		60, 53, 53, 0, 0, 0, 25, 72, 53, 53, 53, 0, 53, 25, 76, 25,
+		0, 80, 41, 41, 40, 44, 48, 52, 172, 41, 41, 41, 0, 41, 116, 120,
+		124, 128, 132, 0, 136, 40, 44, 48, 52, 56, 0, 60, 40, 44, 48, 52,
+		56, 0, 60, 40, 44, 48, 52, 56, 0, 60, 40, 44, 48, 52, 56, 0,
+		60, 40, 44, 48, 52, 56, 0, 60, 40, 44, 48, 52, 56, 53, 60, 0,
+		0, 0, 184, 53, 53, 53, 53, 53, 25, 188, 25, 25, 192, 41, 116, 120,
+		124, 128, 244, 41, 41, 41, 41, 41, 116, 120, 124, 128, 132, 0, 136, 116,
+		120, 124, 128, 132, 0, 136, 49, 49, 0, 0, 0, 45, 45, 49, 49, 49,
+		0, 49, 45, 45, 45, 0, 45, 21, 72, 0, 0, 0, 17, 72, 21, 76,
+		21, 0, 80, 17, 76, 17, 0, 80, 33, 33, 0, 0, 0, 37, 37, 33,
+		33, 33, 0, 33, 37, 37, 37, 0, 37, 29, 29, 0, 0, 0, 57, 57,
+		29, 29, 29, 0, 29, 57, 57, 57, 0, 57, 116, 120, 124, 128, 132, 0,
+		136, 116, 120, 124, 128, 132, 0, 136, 116, 120, 124, 128, 132, 0, 136, 116,
+		120, 124, 128, 132, 0, 136, 116, 120, 124, 128, 132, 49, 136, 0, 0, 0,
+		45, 49, 49, 49, 49, 49, 45, 45, 45, 45, 45, 61, 61, 0, 0, 0,
+		0, 184, 61, 61, 61, 0, 61, 21, 188, 21, 21, 192, 184, 0, 0, 0,
+		0, 33, 17, 188, 17, 17, 192, 33, 33, 33, 33, 33, 37, 0, 0, 0,
+		0, 29, 37, 37, 37, 37, 37, 29, 29, 29, 29, 29, 57, 0, 0, 0,
+		0, 61, 57, 57, 57, 57, 57, 61, 61, 61, 61, 61, 13, 0, 0, 0,
+		0, 0, 0, 64, 0, 68,
+	},
+	GotoBase: []int32{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 56, 104,
+		26, 34, 62, 68, 74, 108, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		18, 80, 112, 0, 0, 0, 0, 0, 0, 0, 0, 116, 42, 50, 86, 92,
+		98, 120, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 124, 0, 0,
+	},
+	GotoCheck: []int32{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 13, 13, 13, 13, 13, 13, -1,
+		-1, 13, 32, 32, 32, 32, 32, 32, -1, -1, 32, 16, 16, 16, 16, -1,
+		-1, -1, 16, 17, 17, 17, 17, -1, -1, -1, 17, 44, 44, 44, 44, -1,
+		-1, -1, 44, 45, 45, 45, 45, -1, -1, 14, 45, 14, 14, -1, -1, 18,
+		14, 18, 18, -1, -1, 19, 18, 19, 19, -1, -1, 20, 19, 20, 20, -1,
+		-1, 33, 20, 33, 33, -1, -1, 46, 33, 46, 46, -1, -1, 47, 46, 47,
+		47, -1, -1, 48, 47, 48, 48, -1, -1, 15, 48, 15, 15, 21, -1, 21,
+		21, 34, -1, 34, 34, 43, -1, 43, 43, 49, -1, 49, 49, 61, -1, 61,
+		61,
+	},
+	GotoTargets: []int32{
+		1, 2, 3, 4, 5, 6, 7, 8, 9, 22, 23, 24, 25, 26, 27, 0,
+		0, 28, 22, 23, 24, 25, 26, 51, 0, 0, 28, 2, 37, 4, 5, 0,
+		0, 0, 9, 2, 38, 4, 5, 0, 0, 0, 9, 23, 55, 25, 26, 0,
+		0, 0, 28, 23, 56, 25, 26, 0, 0, 2, 28, 4, 5, 0, 0, 2,
+		35, 4, 5, 0, 0, 2, 39, 4, 5, 0, 0, 2, 40, 4, 5, 0,
+		0, 23, 41, 25, 26, 0, 0, 23, 52, 25, 26, 0, 0, 23, 57, 25,
+		26, 0, 0, 23, 58, 25, 26, 0, 0, 36, 59, 4, 5, 42, 0, 4,
+		5, 53, 0, 25, 26, 54, 0, 4, 5, 60, 0, 25, 26, 63, 0, 25,
+		26,
+	},
+	Productions: []lr.Production{
+		{LHS: asts.NodeType("__pgpg_start_1"), LHSID: 9, RHSCount: 1},
+		{LHS: asts.NodeType("Root"), LHSID: 6, RHSCount: 1},
+		{LHS: asts.NodeType("Rvalue"), LHSID: 7, RHSCount: 1},
+		{LHS: asts.NodeType("PrecedenceChainStart"), LHSID: 5, RHSCount: 1},
+		{LHS: asts.NodeType("AddSubTerm"), LHSID: 0, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("AddSubTerm"), LHSID: 0, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("AddSubTerm"), LHSID: 0, RHSCount: 1},
+		{LHS: asts.NodeType("MulDivTerm"), LHSID: 2, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("MulDivTerm"), LHSID: 2, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("MulDivTerm"), LHSID: 2, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("MulDivTerm"), LHSID: 2, RHSCount: 1},
+		{LHS: asts.NodeType("UnaryTerm"), LHSID: 8, RHSCount: 2, Hint: &lr.Hint{ChildIndices: []int{1}, NodeType: asts.NodeType("unary")}},
+		{LHS: asts.NodeType("UnaryTerm"), LHSID: 8, RHSCount: 2, Hint: &lr.Hint{ChildIndices: []int{1}, NodeType: asts.NodeType("unary")}},
+		{LHS: asts.NodeType("UnaryTerm"), LHSID: 8, RHSCount: 1},
+		{LHS: asts.NodeType("ExponentiationTerm"), LHSID: 1, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("ExponentiationTerm"), LHSID: 1, RHSCount: 4, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 3}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("ExponentiationTerm"), LHSID: 1, RHSCount: 1},
+		{LHS: asts.NodeType("ParenTerm"), LHSID: 3, RHSCount: 3, Hint: &lr.Hint{Passthrough: true, PassthroughIndex: 1}},
+		{LHS: asts.NodeType("ParenTerm"), LHSID: 3, RHSCount: 1},
+		{LHS: asts.NodeType("PrecedenceChainEnd"), LHSID: 4, RHSCount: 1, Hint: &lr.Hint{NodeType: asts.NodeType("int_literal")}},
+		{LHS: asts.NodeType("PrecedenceChainEnd"), LHSID: 4, RHSCount: 1, Hint: &lr.Hint{NodeType: asts.NodeType("hex_literal")}},
+		{LHS: asts.NodeType("PrecedenceChainEnd"), LHSID: 4, RHSCount: 1, Hint: &lr.Hint{NodeType: asts.NodeType("float_literal")}},
+	},
+	HintMode: "hints",
 }
@@ -0,0 +1,190 @@
+package parsers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+func pemdasActionsInt(value any) (any, error) {
+	return strconv.Atoi(string(value.(*tokens.Token).Lexeme))
+}
+
+func pemdasActionsDivide(numerator any, denominator any) (any, error) {
+	if denominator.(int) == 0 {
+		return nil, fmt.Errorf("division by zero")
+	}
+	return numerator.(int) / denominator.(int), nil
+}
+
+func pemdasActionsPower(base any, exponent any) (any, error) {
+	if exponent.(int) < 0 {
+		return nil, fmt.Errorf("negative exponent %d", exponent.(int))
+	}
+	result := 1
+	for i := 0; i < exponent.(int); i++ {
+		result *= base.(int)
+	}
+	return result, nil
+}
+
+// PEMDASActionsParser parses with PEMDASActionsParserTables. Parsing, error handling, and the parser's
+// options are those of lr.Parser.
+type PEMDASActionsParser struct {
+	lr.Parser
+}
+
+func NewPEMDASActionsParser() *PEMDASActionsParser {
+	return &PEMDASActionsParser{Parser: lr.Parser{Tables: PEMDASActionsParserTables}}
+}
+
+// ParseWithPEMDASActionsParser parses the input, computing values of type T with the handler in place of
+// building an AST, and returns the start symbol's value. Syntax errors may be repaired, as with
+// RepairErrors, but error productions are not used.
+func ParseWithPEMDASActionsParser[T any](parser *PEMDASActionsParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {
+	return lr.ParseWith(&parser.Parser, lexer, handler)
+}
+
+// ParseValue parses the input, evaluating the grammar's semantic actions, and returns the value of
+// the start symbol. A terminal's value is its *tokens.Token, and that of a production without an
+// action is the value of its first symbol, or nil if it has none. An error returned by an action
+// stops parsing. Syntax errors are handled as by ParseWithPEMDASActionsParser.
+func (parser *PEMDASActionsParser) ParseValue(lexer liblexers.AbstractLexer) (any, error) {
+	return lr.ParseWith[any](&parser.Parser, lexer, semanticActionsPEMDASActionsParser)
+}
+
+// semanticActionsPEMDASActionsParser evaluates the grammar's semantic actions.
+var semanticActionsPEMDASActionsParser = libparsers.ValueHandlerFuncs[any]{
+	ShiftFunc: func(token *tokens.Token) (any, error) { return token, nil },
+	ReduceFunc: func(prod int, lhs asts.NodeType, X []any) (any, error) {
+		return reducePEMDASActionsParserValue(prod, X)
+	},
+}
+
+// reducePEMDASActionsParserValue returns the value of a reduction by prod from the values X of its
+// right-hand side, using the production's semantic action if it has one.
+func reducePEMDASActionsParserValue(prod int, X []any) (any, error) {
+	switch prod {
+	case 2: // Sum ::= Sum plus Product
+		return X[0].(int) + X[2].(int), nil
+	case 3: // Sum ::= Sum minus Product
+		return X[0].(int) - X[2].(int), nil
+	case 5: // Product ::= Product times Unary
+		return X[0].(int) * X[2].(int), nil
+	case 6: // Product ::= Product divide Unary
+		return pemdasActionsDivide(X[0], X[2])
+	case 8: // Unary ::= minus Unary
+		return -X[1].(int), nil
+	case 10: // Power ::= Primary exponentiation Unary
+		return pemdasActionsPower(X[0], X[2])
+	case 12: // Primary ::= lparen Sum rparen
+		return X[1], nil
+	case 13: // Primary ::= int_literal
+		return pemdasActionsInt(X[0])
+	}
+	if len(X) == 0 {
+		return nil, nil
+	}
+	return X[0], nil
+}
+
+// PEMDASActionsParserTables are the parser's LR tables, run by the lr package. The action and goto tables
+// are indexed by the IDs of Terminals and Nonterminals, and row-displaced, as lr.Tables documents.
+var PEMDASActionsParserTables = &lr.Tables{
+	StartSymbol: "Root",
+	Terminals: tokens.NewKinds(
+		"EOF",
+		"divide",
+		"exponentiation",
+		"int_literal",
+		"lparen",
+		"minus",
+		"plus",
+		"rparen",
+		"times",
+	),
+	Nonterminals: []asts.NodeType{
+		"Power",
+		"Primary",
+		"Product",
+		"Root",
+		"Sum",
+		"Unary",
+		"__pgpg_start_1",
+	},
+	ActionBase: []int32{
+		49, 50, -1, 59, 2, 6, 68, 8, 58, 67, 81, 89, 98, 107, 116, 74,
+		16, 82, 123, 90, 24, 134, 143, 99, 108, 117, 126, 135, 144, 157, 165, 173,
+		181, 189, 33, 194, 150, 158, 166, 174, 182, 190, 41,
+	},
+	ActionCheck: []int32{
+		2, 2, 2, 4, -1, 2, 2, 5, 2, 7, 7, 7, 5, 5, 7, 7,
+		-1, 7, 16, 16, -1, -1, 16, 16, 16, 16, 20, 20, -1, -1, 20, 20,
+		20, 20, 34, 34, 34, -1, -1, 34, 34, -1, 34, 42, 42, -1, -1, 42,
+		42, 42, 42, 1, 1, 0, 0, 0, 1, 1, -1, 1, 3, 3, 8, 8,
+		8, 3, 3, -1, 3, 6, 6, 9, 9, 9, 6, 6, 15, 6, -1, -1,
+		15, 15, 15, 15, 17, 10, 10, 10, 17, 17, 17, 17, 19, 11, 11, 11,
+		19, 19, 19, 19, 23, 23, 12, 12, 12, 23, 23, -1, 23, 24, 24, 13,
+		13, 13, 24, 24, -1, 24, 25, 25, 14, 14, 14, 25, 25, -1, 25, 26,
+		26, 18, 18, 18, 26, 26, -1, 26, 27, 27, 21, 21, 21, 27, 27, -1,
+		27, 28, 28, 22, 22, 22, 28, 28, 36, 28, -1, -1, 36, 36, 36, 36,
+		37, 29, 29, 29, 37, 37, 37, 37, 38, 30, 30, 30, 38, 38, 38, 38,
+		39, 31, 31, 31, 39, 39, 39, 39, 40, 32, 32, 32, 40, 40, 40, 40,
+		41, 33, 33, 33, 41, 41, 41, 41, 35, 35, 35,
+	},
+	ActionEntries: []int32{
+		45, 45, 40, 2, 0, 45, 45, 5, 45, 53, 53, 53, 52, 56, 53, 53,
+		0, 53, 45, 116, 0, 0, 45, 45, 45, 45, 53, 53, 0, 0, 53, 53,
+		53, 53, 49, 49, 49, 0, 0, 49, 49, 0, 49, 49, 49, 0, 0, 49,
+		49, 49, 49, 37, 37, 28, 32, 36, 37, 37, 0, 37, 17, 44, 80, 84,
+		88, 17, 17, 0, 48, 29, 29, 28, 32, 36, 29, 29, 37, 29, 0, 0,
+		37, 37, 37, 37, 120, 28, 32, 36, 17, 17, 17, 124, 29, 28, 32, 36,
+		29, 29, 29, 29, 33, 33, 28, 32, 36, 33, 33, 0, 33, 41, 41, 28,
+		32, 36, 41, 41, 0, 41, 25, 25, 28, 32, 36, 25, 25, 0, 25, 21,
+		21, 128, 132, 136, 21, 21, 0, 21, 13, 44, 80, 84, 88, 13, 13, 0,
+		48, 9, 44, 80, 84, 88, 9, 9, 33, 48, 0, 0, 33, 33, 33, 33,
+		41, 80, 84, 88, 41, 41, 41, 41, 25, 80, 84, 88, 25, 25, 25, 25,
+		21, 80, 84, 88, 21, 21, 21, 21, 120, 80, 84, 88, 13, 13, 13, 124,
+		120, 80, 84, 88, 9, 9, 9, 124, 128, 132, 168,
+	},
+	GotoBase: []int32{
+		0, 0, 0, 0, 0, 0, 0, 0, 6, 39, 42, 45, 48, 18, 24, 0,
+		0, 0, 0, 0, 0, 12, 51, 0, 0, 0, 0, 0, 0, 54, 57, 60,
+		30, 36, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	},
+	GotoCheck: []int32{
+		0, 0, 0, 0, 0, 0, 8, 8, 8, -1, 8, 8, 21, 21, 21, -1,
+		21, 21, 13, 13, 13, -1, -1, 13, 14, 14, 14, -1, -1, 14, 32, 32,
+		32, -1, -1, 32, 33, 33, 33, 9, 9, 33, 10, 10, 9, 11, 11, 10,
+		12, 12, 11, 22, 22, 12, 29, 29, 22, 30, 30, 29, 31, 31, 30, -1,
+		-1, 31,
+	},
+	GotoTargets: []int32{
+		1, 2, 3, 4, 5, 6, 15, 16, 17, 0, 18, 19, 15, 16, 17, 0,
+		35, 19, 1, 2, 27, 0, 0, 6, 1, 2, 28, 0, 0, 6, 15, 16,
+		40, 0, 0, 19, 15, 16, 41, 1, 2, 19, 1, 2, 23, 1, 2, 24,
+		1, 2, 25, 15, 16, 26, 15, 16, 36, 15, 16, 37, 15, 16, 38, 0,
+		0, 39,
+	},
+	Productions: []lr.Production{
+		{LHS: asts.NodeType("__pgpg_start_1"), LHSID: 6, RHSCount: 1},
+		{LHS: asts.NodeType("Root"), LHSID: 3, RHSCount: 1},
+		{LHS: asts.NodeType("Sum"), LHSID: 4, RHSCount: 3},
+		{LHS: asts.NodeType("Sum"), LHSID: 4, RHSCount: 3},
+		{LHS: asts.NodeType("Sum"), LHSID: 4, RHSCount: 1},
+		{LHS: asts.NodeType("Product"), LHSID: 2, RHSCount: 3},
+		{LHS: asts.NodeType("Product"), LHSID: 2, RHSCount: 3},
+		{LHS: asts.NodeType("Product"), LHSID: 2, RHSCount: 1},
+		{LHS: asts.NodeType("Unary"), LHSID: 5, RHSCount: 2},
+		{LHS: asts.NodeType("Unary"), LHSID: 5, RHSCount: 1},
+		{LHS: asts.NodeType("Power"), LHSID: 0, RHSCount: 3},
+		{LHS: asts.NodeType("Power"), LHSID: 0, RHSCount: 1},
+		{LHS: asts.NodeType("Primary"), LHSID: 1, RHSCount: 3},
+		{LHS: asts.NodeType("Primary"), LHSID: 1, RHSCount: 1},
+	},
+}
@@ -0,0 +1,70 @@
+package parsers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/apps/go/generated/pkg/lexers"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+)
+
+// TestPEMDASActionsParseValue verifies that ParseValue evaluates pemdas_actions.bnf's semantic
+// actions.
+func TestPEMDASActionsParseValue(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{"7", 7},
+		{"1 + 2 * 3", 7},
+		{"(1 + 2) * 3", 9},
+		{"10 - 4 - 3", 3},
+		{"-2 ** 2", -4},
+		{"2 ** 3 ** 2", 512},
+		{"17 / 5", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			value, err := NewPEMDASActionsParser().ParseValue(lexers.NewPEMDASActionsLexer(strings.NewReader(tt.input)))
+			if err != nil {
+				t.Fatalf("ParseValue: %v", err)
+			}
+			if value != tt.want {
+				t.Errorf("value: got %v, want %d", value, tt.want)
+			}
+		})
+	}
+}
+
+// TestPEMDASActionsParseValueErrors verifies that errors from actions stop parsing, and that syntax
+// errors are reported as by Parse.
+func TestPEMDASActionsParseValueErrors(t *testing.T) {
+	_, err := NewPEMDASActionsParser().ParseValue(lexers.NewPEMDASActionsLexer(strings.NewReader("1 / (2 - 2)")))
+	if err == nil || err.Error() != "division by zero" {
+		t.Errorf("error: got %v, want division by zero", err)
+	}
+
+	_, err = NewPEMDASActionsParser().ParseValue(lexers.NewPEMDASActionsLexer(strings.NewReader("1 + * 2")))
+	var parseError *libparsers.ParseError
+	if !errors.As(err, &parseError) {
+		t.Fatalf("error: got %v, want a ParseError", err)
+	}
+	if parseError.Location.ColumnNumber != 5 {
+		t.Errorf("column: got %d, want 5", parseError.Location.ColumnNumber)
+	}
+}
+
+// TestPEMDASActionsParseValueRepair verifies that ParseValue repairs syntax errors when
+// RepairErrors is set, evaluating the repaired input.
+func TestPEMDASActionsParseValueRepair(t *testing.T) {
+	parser := NewPEMDASActionsParser()
+	parser.RepairErrors = true
+	value, err := parser.ParseValue(lexers.NewPEMDASActionsLexer(strings.NewReader("(1 + 2 * 3")))
+	if value != 7 {
+		t.Errorf("value: got %v, want 7", value)
+	}
+	if err == nil || len(parser.Errors) != 1 {
+		t.Errorf("errors: got %v, want one repaired error", parser.Errors)
+	}
+}
@@ -0,0 +1,162 @@
+package parsers
+
+import (
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+// PEMDASFlatParser parses with PEMDASFlatParserTables. Parsing, error handling, and the parser's
+// options are those of lr.Parser.
+type PEMDASFlatParser struct {
+	lr.Parser
+}
+
+func NewPEMDASFlatParser() *PEMDASFlatParser {
+	return &PEMDASFlatParser{Parser: lr.Parser{Tables: PEMDASFlatParserTables}}
+}
+
+// ParseWithPEMDASFlatParser parses the input, computing values of type T with the handler in place of
+// building an AST, and returns the start symbol's value. Syntax errors may be repaired, as with
+// RepairErrors, but error productions are not used.
+func ParseWithPEMDASFlatParser[T any](parser *PEMDASFlatParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {
+	return lr.ParseWith(&parser.Parser, lexer, handler)
+}
+
+// PEMDASFlatParserTables are the parser's LR tables, run by the lr package. The action and goto tables
+// are indexed by the IDs of Terminals and Nonterminals, and row-displaced, as lr.Tables documents.
+var PEMDASFlatParserTables = &lr.Tables{
+	StartSymbol: "Root",
+	Terminals: tokens.NewKinds(
+		"EOF",
+		"divide",
+		"exponentiation",
+		"float_literal",
+		"hex_literal",
+		"int_literal",
+		"lparen",
+		"minus",
+		"modulo",
+		"plus",
+		"rparen",
+		"times",
+	),
+	Nonterminals: []asts.NodeType{
+		"Expr",
+		"Root",
+		"Rvalue",
+		"__pgpg_start_1",
+	},
+	ActionBase: []int32{
+		306, -1, 2, 3, 11, 23, 35, 313, 320, 327, 334, 341, 348, 355, 362, 369,
+		46, 57, 68, 79, 376, 383, 390, 91, 103, 115, 127, 139, 151, 163, 175, 397,
+		404, 411, 418, 425, 187, 432, 198, 209, 220, 231, 242, 253, 264, 275, 286, 297,
+	},
+	ActionCheck: []int32{
+		1, 1, 1, 2, 3, -1, -1, 1, 1, 1, -1, 1, 4, 4, 4, -1,
+		-1, -1, -1, 4, 4, 4, -1, 4, 5, 5, 5, -1, -1, -1, -1, 5,
+		5, 5, -1, 5, 6, 6, 6, -1, -1, -1, -1, 6, 6, 6, -1, 6,
+		16, 16, -1, -1, -1, -1, 16, 16, 16, 16, 16, 17, 17, -1, -1, -1,
+		-1, 17, 17, 17, 17, 17, 18, 18, -1, -1, -1, -1, 18, 18, 18, 18,
+		18, 19, 19, -1, -1, -1, -1, 19, 19, 19, 19, 19, 23, 23, 23, -1,
+		-1, -1, -1, 23, 23, 23, -1, 23, 24, 24, 24, -1, -1, -1, -1, 24,
+		24, 24, -1, 24, 25, 25, 25, -1, -1, -1, -1, 25, 25, 25, -1, 25,
+		26, 26, 26, -1, -1, -1, -1, 26, 26, 26, -1, 26, 27, 27, 27, -1,
+		-1, -1, -1, 27, 27, 27, -1, 27, 28, 28, 28, -1, -1, -1, -1, 28,
+		28, 28, -1, 28, 29, 29, 29, -1, -1, -1, -1, 29, 29, 29, -1, 29,
+		30, 30, 30, -1, -1, -1, -1, 30, 30, 30, -1, 30, 36, 36, 36, -1,
+		-1, -1, -1, 36, 36, 36, -1, 36, 38, 38, -1, -1, -1, -1, 38, 38,
+		38, 38, 38, 39, 39, -1, -1, -1, -1, 39, 39, 39, 39, 39, 40, 40,
+		-1, -1, -1, -1, 40, 40, 40, 40, 40, 41, 41, -1, -1, -1, -1, 41,
+		41, 41, 41, 41, 42, 42, -1, -1, -1, -1, 42, 42, 42, 42, 42, 43,
+		43, -1, -1, -1, -1, 43, 43, 43, 43, 43, 44, 44, -1, -1, -1, -1,
+		44, 44, 44, 44, 44, 45, 45, -1, -1, -1, -1, 45, 45, 45, 45, 45,
+		46, 46, -1, -1, -1, -1, 46, 46, 46, 46, 46, 47, 47, -1, -1, -1,
+		-1, 47, 47, 47, 47, 47, 0, 0, 0, 0, 0, -1, 0, 7, 7, 7,
+		7, 7, -1, 7, 8, 8, 8, 8, 8, -1, 8, 9, 9, 9, 9, 9,
+		-1, 9, 10, 10, 10, 10, 10, -1, 10, 11, 11, 11, 11, 11, -1, 11,
+		12, 12, 12, 12, 12, -1, 12, 13, 13, 13, 13, 13, -1, 13, 14, 14,
+		14, 14, 14, -1, 14, 15, 15, 15, 15, 15, -1, 15, 20, 20, 20, 20,
+		20, -1, 20, 21, 21, 21, 21, 21, -1, 21, 22, 22, 22, 22, 22, -1,
+		22, 31, 31, 31, 31, 31, -1, 31, 32, 32, 32, 32, 32, -1, 32, 33,
+		33, 33, 33, 33, -1, 33, 34, 34, 34, 34, 34, -1, 34, 35, 35, 35,
+		35, 35, -1, 35, 37, 37, 37, 37, 37, -1, 37,
+	},
+	ActionEntries: []int32{
+		9, 40, 44, 2, 5, 0, 0, 48, 52, 56, 0, 60, 57, 57, 57, 0,
+		0, 0, 0, 57, 57, 57, 0, 57, 53, 53, 53, 0, 0, 0, 0, 53,
+		53, 53, 0, 53, 49, 49, 49, 0, 0, 0, 0, 49, 49, 49, 0, 49,
+		124, 128, 0, 0, 0, 0, 132, 136, 140, 144, 148, 57, 57, 0, 0, 0,
+		0, 57, 57, 57, 57, 57, 53, 53, 0, 0, 0, 0, 53, 53, 53, 53,
+		53, 49, 49, 0, 0, 0, 0, 49, 49, 49, 49, 49, 41, 41, 44, 0,
+		0, 0, 0, 41, 41, 41, 0, 41, 37, 37, 44, 0, 0, 0, 0, 37,
+		37, 37, 0, 37, 25, 25, 44, 0, 0, 0, 0, 25, 25, 25, 0, 25,
+		33, 33, 44, 0, 0, 0, 0, 33, 33, 33, 0, 33, 17, 40, 44, 0,
+		0, 0, 0, 17, 52, 17, 0, 60, 29, 29, 44, 0, 0, 0, 0, 29,
+		29, 29, 0, 29, 13, 40, 44, 0, 0, 0, 0, 13, 52, 13, 0, 60,
+		21, 21, 44, 0, 0, 0, 0, 21, 21, 21, 0, 21, 45, 45, 45, 0,
+		0, 0, 0, 45, 45, 45, 0, 45, 124, 128, 0, 0, 0, 0, 132, 136,
+		140, 188, 148, 41, 128, 0, 0, 0, 0, 41, 41, 41, 41, 41, 37, 128,
+		0, 0, 0, 0, 37, 37, 37, 37, 37, 25, 128, 0, 0, 0, 0, 25,
+		25, 25, 25, 25, 33, 128, 0, 0, 0, 0, 33, 33, 33, 33, 33, 124,
+		128, 0, 0, 0, 0, 17, 136, 17, 17, 148, 29, 128, 0, 0, 0, 0,
+		29, 29, 29, 29, 29, 124, 128, 0, 0, 0, 0, 13, 136, 13, 13, 148,
+		21, 128, 0, 0, 0, 0, 21, 21, 21, 21, 21, 45, 45, 0, 0, 0,
+		0, 45, 45, 45, 45, 45, 16, 20, 24, 28, 32, 0, 36, 68, 72, 76,
+		80, 84, 0, 88, 16, 20, 24, 28, 32, 0, 36, 16, 20, 24, 28, 32,
+		0, 36, 16, 20, 24, 28, 32, 0, 36, 16, 20, 24, 28, 32, 0, 36,
+		16, 20, 24, 28, 32, 0, 36, 16, 20, 24, 28, 32, 0, 36, 16, 20,
+		24, 28, 32, 0, 36, 16, 20, 24, 28, 32, 0, 36, 68, 72, 76, 80,
+		84, 0, 88, 68, 72, 76, 80, 84, 0, 88, 68, 72, 76, 80, 84, 0,
+		88, 68, 72, 76, 80, 84, 0, 88, 68, 72, 76, 80, 84, 0, 88, 68,
+		72, 76, 80, 84, 0, 88, 68, 72, 76, 80, 84, 0, 88, 68, 72, 76,
+		80, 84, 0, 88, 68, 72, 76, 80, 84, 0, 88,
+	},
+	GotoBase: []int32{
+		0, 0, 0, 0, 0, 0, 0, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+		0, 0, 0, 0, 12, 13, 14, 0, 0, 0, 0, 0, 0, 0, 0, 15,
+		16, 17, 18, 19, 0, 20, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	},
+	GotoCheck: []int32{
+		0, 0, 0, 7, 8, 9, 10, 11, 12, 13, 14, 15, 20, 21, 22, 31,
+		32, 33, 34, 35, 37,
+	},
+	GotoTargets: []int32{
+		1, 2, 3, 16, 23, 24, 25, 26, 27, 28, 29, 30, 38, 39, 40, 41,
+		42, 43, 44, 45, 46,
+	},
+	Productions: []lr.Production{
+		{LHS: asts.NodeType("__pgpg_start_1"), LHSID: 3, RHSCount: 1},
+		{LHS: asts.NodeType("Root"), LHSID: 1, RHSCount: 1},
+		{LHS: asts.NodeType("Rvalue"), LHSID: 2, RHSCount: 1},
+		{LHS: asts.NodeType("Expr"), LHSID: 0, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("Expr"), LHSID: 0, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("Expr"), LHSID: 0, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("Expr"), LHSID: 0, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("Expr"), LHSID: 0, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("Expr"), LHSID: 0, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("Expr"), LHSID: 0, RHSCount: 2, Hint: &lr.Hint{ChildIndices: []int{1}, NodeType: asts.NodeType("unary")}},
+		{LHS: asts.NodeType("Expr"), LHSID: 0, RHSCount: 2, Hint: &lr.Hint{ChildIndices: []int{1}, NodeType: asts.NodeType("unary")}},
+		{LHS: asts.NodeType("Expr"), LHSID: 0, RHSCount: 3, Hint: &lr.Hint{Passthrough: true, PassthroughIndex: 1}},
+		{LHS: asts.NodeType("Expr"), LHSID: 0, RHSCount: 1, Hint: &lr.Hint{NodeType: asts.NodeType("int_literal")}},
+		{LHS: asts.NodeType("Expr"), LHSID: 0, RHSCount: 1, Hint: &lr.Hint{NodeType: asts.NodeType("hex_literal")}},
+		{LHS: asts.NodeType("Expr"), LHSID: 0, RHSCount: 1, Hint: &lr.Hint{NodeType: asts.NodeType("float_literal")}},
+	},
+	HintMode: "hints",
+	// DisplayNames are from the grammar's %display declarations.
+	DisplayNames: map[tokens.TokenType]string{
+		tokens.TokenType("divide"):         "'/'",
+		tokens.TokenType("exponentiation"): "'**'",
+		tokens.TokenType("float_literal"):  "float",
+		tokens.TokenType("hex_literal"):    "hex integer",
+		tokens.TokenType("int_literal"):    "integer",
+		tokens.TokenType("lparen"):         "'('",
+		tokens.TokenType("minus"):          "'-'",
+		tokens.TokenType("modulo"):         "'%'",
+		tokens.TokenType("plus"):           "'+'",
+		tokens.TokenType("rparen"):         "')'",
+		tokens.TokenType("times"):          "'*'",
+	},
+}
@@ -0,0 +1,69 @@
+package parsers
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/apps/go/generated/pkg/lexers"
+	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+)
+
+// TestPEMDASFlatSyntaxErrors verifies that syntax errors give their location, the unexpected token,
+// and the expected terminals, named as declared by pemdas_flat.bnf's %display directives.
+func TestPEMDASFlatSyntaxErrors(t *testing.T) {
+	tests := []struct {
+		input     string
+		wantError string
+	}{
+		{"1 +\n  )", `line 2, column 3: unexpected ')'; expected one of '(', '+', '-', float, hex integer, integer`},
+		{"(1 + 2", `line 1, column 7: unexpected end of input; expected one of '%', ')', '*', '**', '+', '-', '/'`},
+		{"1 2", `line 1, column 3: unexpected integer "2"; expected one of '%', '*', '**', '+', '-', '/', end of input`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := NewPEMDASFlatParser().Parse(lexers.NewPEMDASFlatLexer(strings.NewReader(tt.input)), "")
+			if err == nil || err.Error() != tt.wantError {
+				t.Errorf("error: got %v, want %q", err, tt.wantError)
+			}
+		})
+	}
+}
+
+// TestPEMDASFlatParseError verifies that syntax errors are ParseErrors carrying the offending
+// token, the expected terminals, and the parser state.
+func TestPEMDASFlatParseError(t *testing.T) {
+	parser := NewPEMDASFlatParser()
+	parser.SourceName = "input"
+	_, err := parser.Parse(lexers.NewPEMDASFlatLexer(strings.NewReader("1 +\n  )")), "")
+	var parseError *libparsers.ParseError
+	if !errors.As(err, &parseError) {
+		t.Fatalf("error: got %v, want a ParseError", err)
+	}
+	if got := err.Error(); !strings.HasPrefix(got, "input, line 2, column 3: ") {
+		t.Errorf("error: got %q", got)
+	}
+	if string(parseError.Token.Lexeme) != ")" {
+		t.Errorf("token: got %q, want %q", string(parseError.Token.Lexeme), ")")
+	}
+	if want := []string{"'('", "'+'", "'-'", "float", "hex integer", "integer"}; !reflect.DeepEqual(parseError.Expected, want) {
+		t.Errorf("expected: got %v, want %v", parseError.Expected, want)
+	}
+	if parseError.State < 0 || parseError.State >= len(PEMDASFlatParserTables.ActionBase) {
+		t.Errorf("state: got %d, not a parser state", parseError.State)
+	}
+}
+
+// TestPEMDASFlatLexError verifies that lexer errors are LexErrors carrying their location.
+func TestPEMDASFlatLexError(t *testing.T) {
+	_, err := NewPEMDASFlatParser().Parse(lexers.NewPEMDASFlatLexer(strings.NewReader("1 + $")), "")
+	var lexError *liblexers.LexError
+	if !errors.As(err, &lexError) {
+		t.Fatalf("error: got %v, want a LexError", err)
+	}
+	if lexError.Location.LineNumber != 1 || lexError.Location.ColumnNumber != 5 {
+		t.Errorf("location: got line %d, column %d", lexError.Location.LineNumber, lexError.Location.ColumnNumber)
+	}
+}
@@ -1,1170 +1,175 @@
 package parsers
 
 import (
-	"fmt"
-	"os"
-	"strings"
-
 	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
 	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
 	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
 )
 
+// PEMDASModParser parses with PEMDASModParserTables. Parsing, error handling, and the parser's
+// options are those of lr.Parser.
 type PEMDASModParser struct {
-	Trace            *PEMDASModParserTraceHooks
-	stashedLookahead *tokens.Token
-}
-
-type PEMDASModParserTraceHooks struct {
-	OnToken  func(tok *tokens.Token)
-	OnAction func(state int, action PEMDASModParserAction, lookahead *tokens.Token)
-	OnStack  func(stateStack []int, nodeStack []*asts.ASTNode)
-}
-
-func NewPEMDASModParser() *PEMDASModParser { return &PEMDASModParser{} }
-
-// noASTSentinel is used as a placeholder on the node stack when astMode == "noast".
-var PEMDASModParserNoASTSentinel = &asts.ASTNode{}
-
-func (parser *PEMDASModParser) Parse(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {
-	if lexer == nil {
-		return nil, fmt.Errorf("parser: nil lexer")
-	}
-	stateStack := []int{0}
-	nodeStack := []*asts.ASTNode{}
-	lookahead := lexer.Scan()
-	if parser.Trace != nil && parser.Trace.OnToken != nil {
-		parser.Trace.OnToken(lookahead)
-	}
-	for {
-		if lookahead == nil {
-			return nil, fmt.Errorf("parser: lexer returned nil token")
-		}
-		if lookahead.Type == tokens.TokenTypeError {
-			return nil, fmt.Errorf("lexer error: %s", string(lookahead.Lexeme))
-		}
-		state := stateStack[len(stateStack)-1]
-		action, ok := PEMDASModParserActions[state][lookahead.Type]
-		if !ok {
-			return nil, fmt.Errorf("parse error: unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme))
-		}
-		if parser.Trace != nil && parser.Trace.OnAction != nil {
-			parser.Trace.OnAction(state, action, lookahead)
-		}
-		switch action.Kind {
-		case PEMDASModParserActionShift:
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, PEMDASModParserNoASTSentinel)
-			} else {
-				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
-			}
-			stateStack = append(stateStack, action.Target)
-			lookahead = lexer.Scan()
-			if parser.Trace != nil && parser.Trace.OnToken != nil {
-				parser.Trace.OnToken(lookahead)
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case PEMDASModParserActionReduce:
-			prod := PEMDASModParserProductions[action.Target]
-			rhsNodes := make([]*asts.ASTNode, prod.rhsCount)
-			for i := prod.rhsCount - 1; i >= 0; i-- {
-				stateStack = stateStack[:len(stateStack)-1]
-				rhsNodes[i] = nodeStack[len(nodeStack)-1]
-				nodeStack = nodeStack[:len(nodeStack)-1]
-			}
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, PEMDASModParserNoASTSentinel)
-			} else {
-				var node *asts.ASTNode
-				useFullTree := (astMode == "fullast")
-				if !useFullTree && prod.hasPassthrough {
-					node = rhsNodes[prod.passthroughIndex]
-				} else if !useFullTree && prod.hasWithAppendedChildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					if parent != nil && parent.Children != nil {
-						newChildren = append(newChildren, parent.Children...)
-					}
-					for _, ci := range prod.withAppendedChildren {
-						newChildren = append(newChildren, rhsNodes[ci])
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasWithPrependedChildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					for _, ci := range prod.withPrependedChildren {
-						newChildren = append(newChildren, rhsNodes[ci])
-					}
-					if parent != nil && parent.Children != nil {
-						newChildren = append(newChildren, parent.Children...)
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasWithAdoptedGrandchildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					for _, ci := range prod.withAdoptedGrandchildren {
-						childNode := rhsNodes[ci]
-						if childNode != nil && childNode.Children != nil {
-							newChildren = append(newChildren, childNode.Children...)
-						}
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasHint {
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = prod.lhs
-					}
-					var parentToken *tokens.Token
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-					} else if prod.parentIndex >= 0 && prod.parentIndex < len(rhsNodes) {
-						parentToken = rhsNodes[prod.parentIndex].Token
-					}
-					hintChildren := make([]*asts.ASTNode, len(prod.childIndices))
-					for i, ci := range prod.childIndices {
-						hintChildren[i] = rhsNodes[ci]
-					}
-					node = asts.NewASTNode(parentToken, nodeType, hintChildren)
-				} else if prod.rhsCount == 1 {
-					node = rhsNodes[0]
-				} else if prod.rhsCount == 0 {
-					node = asts.NewASTNode(nil, prod.lhs, []*asts.ASTNode{})
-				} else {
-					node = asts.NewASTNode(nil, prod.lhs, rhsNodes)
-				}
-				nodeStack = append(nodeStack, node)
-			}
-			state = stateStack[len(stateStack)-1]
-			nextState, ok := PEMDASModParserGotos[state][prod.lhs]
-			if !ok {
-				return nil, fmt.Errorf("parse error: missing goto for %s", prod.lhs)
-			}
-			stateStack = append(stateStack, nextState)
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case PEMDASModParserActionAccept:
-			if len(nodeStack) != 1 {
-				return nil, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			if astMode == "noast" {
-				return nil, nil
-			}
-			return asts.NewAST(nodeStack[0]), nil
-		case PEMDASModParserActionAcceptAndYield:
-			return nil, fmt.Errorf("parse error: multiple objects; use ParseOne for multi-object input")
-		default:
-			return nil, fmt.Errorf("parse error: no action")
-		}
-	}
-}
-
-// ParseOne parses one record from the lexer. It is for multi-object input: call in a loop until done.
-// Returns (ast, true, nil) on EOF after a record, (ast, false, nil) when more input follows, or (nil, false, err) on error.
-func (parser *PEMDASModParser) ParseOne(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, bool, error) {
-	if lexer == nil {
-		return nil, false, fmt.Errorf("parser: nil lexer")
-	}
-	stateStack := []int{0}
-	nodeStack := []*asts.ASTNode{}
-	var lookahead *tokens.Token
-	if parser.stashedLookahead != nil {
-		lookahead = parser.stashedLookahead
-		parser.stashedLookahead = nil
-	} else {
-		lookahead = lexer.Scan()
-	}
-	if parser.Trace != nil && parser.Trace.OnToken != nil {
-		parser.Trace.OnToken(lookahead)
-	}
-	for {
-		if lookahead == nil {
-			return nil, false, fmt.Errorf("parser: lexer returned nil token")
-		}
-		if lookahead.Type == tokens.TokenTypeError {
-			return nil, false, fmt.Errorf("lexer error: %s", string(lookahead.Lexeme))
-		}
-		state := stateStack[len(stateStack)-1]
-		action, ok := PEMDASModParserActions[state][lookahead.Type]
-		if !ok {
-			return nil, false, fmt.Errorf("parse error: unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme))
-		}
-		if parser.Trace != nil && parser.Trace.OnAction != nil {
-			parser.Trace.OnAction(state, action, lookahead)
-		}
-		switch action.Kind {
-		case PEMDASModParserActionShift:
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, PEMDASModParserNoASTSentinel)
-			} else {
-				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
-			}
-			stateStack = append(stateStack, action.Target)
-			lookahead = lexer.Scan()
-			if parser.Trace != nil && parser.Trace.OnToken != nil {
-				parser.Trace.OnToken(lookahead)
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case PEMDASModParserActionReduce:
-			prod := PEMDASModParserProductions[action.Target]
-			rhsNodes := make([]*asts.ASTNode, prod.rhsCount)
-			for i := prod.rhsCount - 1; i >= 0; i-- {
-				stateStack = stateStack[:len(stateStack)-1]
-				rhsNodes[i] = nodeStack[len(nodeStack)-1]
-				nodeStack = nodeStack[:len(nodeStack)-1]
-			}
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, PEMDASModParserNoASTSentinel)
-			} else {
-				var node *asts.ASTNode
-				useFullTree := (astMode == "fullast")
-				if !useFullTree && prod.hasPassthrough {
-					node = rhsNodes[prod.passthroughIndex]
-				} else if !useFullTree && prod.hasWithAppendedChildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					if parent != nil && parent.Children != nil {
-						newChildren = append(newChildren, parent.Children...)
-					}
-					for _, ci := range prod.withAppendedChildren {
-						newChildren = append(newChildren, rhsNodes[ci])
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasWithPrependedChildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					for _, ci := range prod.withPrependedChildren {
-						newChildren = append(newChildren, rhsNodes[ci])
-					}
-					if parent != nil && parent.Children != nil {
-						newChildren = append(newChildren, parent.Children...)
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasWithAdoptedGrandchildren {
-					var parent *asts.ASTNode
-					var parentToken *tokens.Token
-					var parentType asts.NodeType
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-						parentType = asts.NodeType(prod.parentLiteral)
-						parent = nil
-					} else {
-						parent = rhsNodes[prod.parentIndex]
-						parentToken = parent.Token
-						parentType = parent.Type
-					}
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = parentType
-					}
-					newChildren := make([]*asts.ASTNode, 0)
-					for _, ci := range prod.withAdoptedGrandchildren {
-						childNode := rhsNodes[ci]
-						if childNode != nil && childNode.Children != nil {
-							newChildren = append(newChildren, childNode.Children...)
-						}
-					}
-					node = asts.NewASTNode(parentToken, nodeType, newChildren)
-				} else if !useFullTree && prod.hasHint {
-					nodeType := prod.nodeType
-					if nodeType == "" {
-						nodeType = prod.lhs
-					}
-					var parentToken *tokens.Token
-					if prod.hasParentLiteral {
-						parentToken = tokens.NewToken([]rune(prod.parentLiteral), tokens.TokenType(prod.parentLiteral), tokens.NewTokenLocation())
-					} else if prod.parentIndex >= 0 && prod.parentIndex < len(rhsNodes) {
-						parentToken = rhsNodes[prod.parentIndex].Token
-					}
-					hintChildren := make([]*asts.ASTNode, len(prod.childIndices))
-					for i, ci := range prod.childIndices {
-						hintChildren[i] = rhsNodes[ci]
-					}
-					node = asts.NewASTNode(parentToken, nodeType, hintChildren)
-				} else if prod.rhsCount == 1 {
-					node = rhsNodes[0]
-				} else if prod.rhsCount == 0 {
-					node = asts.NewASTNode(nil, prod.lhs, []*asts.ASTNode{})
-				} else {
-					node = asts.NewASTNode(nil, prod.lhs, rhsNodes)
-				}
-				nodeStack = append(nodeStack, node)
-			}
-			state = stateStack[len(stateStack)-1]
-			nextState, ok := PEMDASModParserGotos[state][prod.lhs]
-			if !ok {
-				return nil, false, fmt.Errorf("parse error: missing goto for %s", prod.lhs)
-			}
-			stateStack = append(stateStack, nextState)
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case PEMDASModParserActionAccept:
-			if len(nodeStack) != 1 {
-				return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			if astMode == "noast" {
-				return nil, true, nil
-			}
-			return asts.NewAST(nodeStack[0]), true, nil
-		case PEMDASModParserActionAcceptAndYield:
-			if len(nodeStack) != 1 {
-				return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			parser.stashedLookahead = lookahead
-			if astMode == "noast" {
-				return nil, false, nil
-			}
-			return asts.NewAST(nodeStack[0]), false, nil
-		default:
-			return nil, false, fmt.Errorf("parse error: no action")
-		}
-	}
-}
-
-// AttachCLITrace installs tracing hooks for CLI debugging.
-func (parser *PEMDASModParser) AttachCLITrace(traceTokens bool, traceStates bool, traceStack bool) {
-	if !traceTokens && !traceStates && !traceStack {
-		return
-	}
-	parser.Trace = &PEMDASModParserTraceHooks{
-		OnToken: func(tok *tokens.Token) {
-			if !traceTokens {
-				return
-			}
-			fmt.Fprintln(os.Stderr, formatPEMDASModParserToken(tok))
-		},
-		OnAction: func(state int, action PEMDASModParserAction, lookahead *tokens.Token) {
-			if !traceStates {
-				return
-			}
-			fmt.Fprintf(os.Stderr, "STATE %d %s on %s(%q)\n",
-				state, formatPEMDASModParserAction(action), tokenTypeNamePEMDASModParser(lookahead), tokenLexemePEMDASModParser(lookahead))
-		},
-		OnStack: func(stateStack []int, nodeStack []*asts.ASTNode) {
-			if !traceStack {
-				return
-			}
-			fmt.Fprintf(os.Stderr, "STACK states=%s nodes=%s\n",
-				formatPEMDASModParserIntStack(stateStack), formatPEMDASModParserNodeStack(nodeStack))
-		},
-	}
-}
-
-type PEMDASModParserActionKind int
-
-const (
-	PEMDASModParserActionShift PEMDASModParserActionKind = iota
-	PEMDASModParserActionReduce
-	PEMDASModParserActionAccept
-	PEMDASModParserActionAcceptAndYield
-)
-
-type PEMDASModParserAction struct {
-	Kind   PEMDASModParserActionKind
-	Target int
-}
-
-func formatPEMDASModParserToken(tok *tokens.Token) string {
-	if tok == nil {
-		return "TOK <nil>"
-	}
-	return fmt.Sprintf("TOK type=%s lexeme=%q line=%d col=%d",
-		tok.Type, string(tok.Lexeme), tok.Location.LineNumber, tok.Location.ColumnNumber)
-}
-
-func tokenTypeNamePEMDASModParser(tok *tokens.Token) string {
-	if tok == nil {
-		return "<nil>"
-	}
-	return string(tok.Type)
-}
-
-func tokenLexemePEMDASModParser(tok *tokens.Token) string {
-	if tok == nil {
-		return ""
-	}
-	return string(tok.Lexeme)
-}
-
-func formatPEMDASModParserIntStack(stack []int) string {
-	parts := make([]string, len(stack))
-	for i, v := range stack {
-		parts[i] = fmt.Sprintf("%d", v)
-	}
-	return "[" + strings.Join(parts, " ") + "]"
-}
-
-func formatPEMDASModParserNodeStack(stack []*asts.ASTNode) string {
-	parts := make([]string, len(stack))
-	for i, node := range stack {
-		if node == nil {
-			parts[i] = "<nil>"
-			continue
-		}
-		parts[i] = string(node.Type)
-	}
-	return "[" + strings.Join(parts, " ") + "]"
-}
-
-func formatPEMDASModParserAction(action PEMDASModParserAction) string {
-	switch action.Kind {
-	case PEMDASModParserActionShift:
-		return fmt.Sprintf("shift(%d)", action.Target)
-	case PEMDASModParserActionReduce:
-		return fmt.Sprintf("reduce(%d)", action.Target)
-	case PEMDASModParserActionAccept:
-		return "accept"
-	case PEMDASModParserActionAcceptAndYield:
-		return "accept_and_yield"
-	default:
-		return "unknown"
-	}
-}
-
-type PEMDASModParserProduction struct {
-	lhs                         asts.NodeType
-	rhsCount                    int
-	hasHint                     bool
-	hasPassthrough              bool
-	hasParentLiteral            bool
-	hasWithAppendedChildren     bool
-	hasWithPrependedChildren    bool
-	hasWithAdoptedGrandchildren bool
-	parentIndex                 int
-	passthroughIndex            int
-	parentLiteral               string
-	childIndices                []int
-	withAppendedChildren        []int
-	withPrependedChildren       []int
-	withAdoptedGrandchildren    []int
-	nodeType                    asts.NodeType
-}
-
-var PEMDASModParserActions = map[int]map[tokens.TokenType]PEMDASModParserAction{
-	0: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 10},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 11},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 12},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 13},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 14},
-	},
-	1: {
-		tokens.TokenTypeEOF:             {Kind: PEMDASModParserActionReduce, Target: 3},
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionReduce, Target: 3},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionReduce, Target: 3},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionReduce, Target: 3},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 15},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 16},
-	},
-	2: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASModParserActionReduce, Target: 13},
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 13},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 13},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 13},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 13},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 13},
-	},
-	3: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASModParserActionReduce, Target: 6},
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionShift, Target: 17},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 6},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionShift, Target: 18},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 6},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionShift, Target: 19},
-	},
-	4: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASModParserActionReduce, Target: 16},
-		tokens.TokenType("divide"):         {Kind: PEMDASModParserActionReduce, Target: 16},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASModParserActionShift, Target: 20},
-		tokens.TokenType("minus"):          {Kind: PEMDASModParserActionReduce, Target: 16},
-		tokens.TokenType("modulo"):         {Kind: PEMDASModParserActionReduce, Target: 16},
-		tokens.TokenType("plus"):           {Kind: PEMDASModParserActionReduce, Target: 16},
-		tokens.TokenType("times"):          {Kind: PEMDASModParserActionReduce, Target: 16},
-	},
-	5: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASModParserActionReduce, Target: 18},
-		tokens.TokenType("divide"):         {Kind: PEMDASModParserActionReduce, Target: 18},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASModParserActionReduce, Target: 18},
-		tokens.TokenType("minus"):          {Kind: PEMDASModParserActionReduce, Target: 18},
-		tokens.TokenType("modulo"):         {Kind: PEMDASModParserActionReduce, Target: 18},
-		tokens.TokenType("plus"):           {Kind: PEMDASModParserActionReduce, Target: 18},
-		tokens.TokenType("times"):          {Kind: PEMDASModParserActionReduce, Target: 18},
-	},
-	6: {
-		tokens.TokenTypeEOF:             {Kind: PEMDASModParserActionReduce, Target: 2},
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionReduce, Target: 2},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionReduce, Target: 2},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionReduce, Target: 2},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionReduce, Target: 2},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionReduce, Target: 2},
-	},
-	7: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASModParserActionAccept},
-		tokens.TokenType("divide"):         {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("hex_literal"):    {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("int_literal"):    {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("lparen"):         {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("minus"):          {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("modulo"):         {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("plus"):           {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("rparen"):         {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("times"):          {Kind: PEMDASModParserActionAcceptAndYield},
-	},
-	8: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASModParserActionReduce, Target: 1},
-		tokens.TokenType("divide"):         {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("hex_literal"):    {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("int_literal"):    {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("lparen"):         {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("minus"):          {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("modulo"):         {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("plus"):           {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("rparen"):         {Kind: PEMDASModParserActionAcceptAndYield},
-		tokens.TokenType("times"):          {Kind: PEMDASModParserActionAcceptAndYield},
-	},
-	9: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASModParserActionReduce, Target: 10},
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 10},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 10},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 10},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 10},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 10},
-	},
-	10: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASModParserActionReduce, Target: 20},
-		tokens.TokenType("divide"):         {Kind: PEMDASModParserActionReduce, Target: 20},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASModParserActionReduce, Target: 20},
-		tokens.TokenType("minus"):          {Kind: PEMDASModParserActionReduce, Target: 20},
-		tokens.TokenType("modulo"):         {Kind: PEMDASModParserActionReduce, Target: 20},
-		tokens.TokenType("plus"):           {Kind: PEMDASModParserActionReduce, Target: 20},
-		tokens.TokenType("times"):          {Kind: PEMDASModParserActionReduce, Target: 20},
-	},
-	11: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASModParserActionReduce, Target: 19},
-		tokens.TokenType("divide"):         {Kind: PEMDASModParserActionReduce, Target: 19},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASModParserActionReduce, Target: 19},
-		tokens.TokenType("minus"):          {Kind: PEMDASModParserActionReduce, Target: 19},
-		tokens.TokenType("modulo"):         {Kind: PEMDASModParserActionReduce, Target: 19},
-		tokens.TokenType("plus"):           {Kind: PEMDASModParserActionReduce, Target: 19},
-		tokens.TokenType("times"):          {Kind: PEMDASModParserActionReduce, Target: 19},
-	},
-	12: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 28},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 29},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 30},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 31},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 32},
-	},
-	13: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 10},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 11},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 12},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 13},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 14},
-	},
-	14: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 10},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 11},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 12},
-	},
-	15: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 10},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 11},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 12},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 13},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 14},
-	},
-	16: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 10},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 11},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 12},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 13},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 14},
-	},
-	17: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 10},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 11},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 12},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 13},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 14},
-	},
-	18: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 10},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 11},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 12},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 13},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 14},
-	},
-	19: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 10},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 11},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 12},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 13},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 14},
-	},
-	20: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 10},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 11},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 12},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 41},
-	},
-	21: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionReduce, Target: 3},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionReduce, Target: 3},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionReduce, Target: 3},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 42},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 43},
-		tokens.TokenType("rparen"):      {Kind: PEMDASModParserActionReduce, Target: 3},
-	},
-	22: {
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 13},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 13},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 13},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 13},
-		tokens.TokenType("rparen"): {Kind: PEMDASModParserActionReduce, Target: 13},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 13},
-	},
-	23: {
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionShift, Target: 44},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 6},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionShift, Target: 45},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 6},
-		tokens.TokenType("rparen"): {Kind: PEMDASModParserActionReduce, Target: 6},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionShift, Target: 46},
-	},
-	24: {
-		tokens.TokenType("divide"):         {Kind: PEMDASModParserActionReduce, Target: 16},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASModParserActionShift, Target: 47},
-		tokens.TokenType("minus"):          {Kind: PEMDASModParserActionReduce, Target: 16},
-		tokens.TokenType("modulo"):         {Kind: PEMDASModParserActionReduce, Target: 16},
-		tokens.TokenType("plus"):           {Kind: PEMDASModParserActionReduce, Target: 16},
-		tokens.TokenType("rparen"):         {Kind: PEMDASModParserActionReduce, Target: 16},
-		tokens.TokenType("times"):          {Kind: PEMDASModParserActionReduce, Target: 16},
-	},
-	25: {
-		tokens.TokenType("divide"):         {Kind: PEMDASModParserActionReduce, Target: 18},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASModParserActionReduce, Target: 18},
-		tokens.TokenType("minus"):          {Kind: PEMDASModParserActionReduce, Target: 18},
-		tokens.TokenType("modulo"):         {Kind: PEMDASModParserActionReduce, Target: 18},
-		tokens.TokenType("plus"):           {Kind: PEMDASModParserActionReduce, Target: 18},
-		tokens.TokenType("rparen"):         {Kind: PEMDASModParserActionReduce, Target: 18},
-		tokens.TokenType("times"):          {Kind: PEMDASModParserActionReduce, Target: 18},
-	},
-	26: {
-		tokens.TokenType("rparen"): {Kind: PEMDASModParserActionShift, Target: 48},
-	},
-	27: {
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 10},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 10},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 10},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 10},
-		tokens.TokenType("rparen"): {Kind: PEMDASModParserActionReduce, Target: 10},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 10},
-	},
-	28: {
-		tokens.TokenType("divide"):         {Kind: PEMDASModParserActionReduce, Target: 20},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASModParserActionReduce, Target: 20},
-		tokens.TokenType("minus"):          {Kind: PEMDASModParserActionReduce, Target: 20},
-		tokens.TokenType("modulo"):         {Kind: PEMDASModParserActionReduce, Target: 20},
-		tokens.TokenType("plus"):           {Kind: PEMDASModParserActionReduce, Target: 20},
-		tokens.TokenType("rparen"):         {Kind: PEMDASModParserActionReduce, Target: 20},
-		tokens.TokenType("times"):          {Kind: PEMDASModParserActionReduce, Target: 20},
-	},
-	29: {
-		tokens.TokenType("divide"):         {Kind: PEMDASModParserActionReduce, Target: 19},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASModParserActionReduce, Target: 19},
-		tokens.TokenType("minus"):          {Kind: PEMDASModParserActionReduce, Target: 19},
-		tokens.TokenType("modulo"):         {Kind: PEMDASModParserActionReduce, Target: 19},
-		tokens.TokenType("plus"):           {Kind: PEMDASModParserActionReduce, Target: 19},
-		tokens.TokenType("rparen"):         {Kind: PEMDASModParserActionReduce, Target: 19},
-		tokens.TokenType("times"):          {Kind: PEMDASModParserActionReduce, Target: 19},
-	},
-	30: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 28},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 29},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 30},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 31},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 32},
-	},
-	31: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 28},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 29},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 30},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 31},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 32},
-	},
-	32: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 28},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 29},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 30},
-	},
-	33: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASModParserActionReduce, Target: 12},
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 12},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 12},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 12},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 12},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 12},
-	},
-	34: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASModParserActionReduce, Target: 11},
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 11},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 11},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 11},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 11},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 11},
-	},
-	35: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASModParserActionReduce, Target: 5},
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionShift, Target: 17},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 5},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionShift, Target: 18},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 5},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionShift, Target: 19},
-	},
-	36: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASModParserActionReduce, Target: 4},
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionShift, Target: 17},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 4},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionShift, Target: 18},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 4},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionShift, Target: 19},
-	},
-	37: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASModParserActionReduce, Target: 8},
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 8},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 8},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 8},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 8},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 8},
-	},
-	38: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASModParserActionReduce, Target: 9},
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 9},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 9},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 9},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 9},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 9},
-	},
-	39: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASModParserActionReduce, Target: 7},
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 7},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 7},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 7},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 7},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 7},
-	},
-	40: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASModParserActionReduce, Target: 14},
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 14},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 14},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 14},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 14},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 14},
-	},
-	41: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 10},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 11},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 12},
-	},
-	42: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 28},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 29},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 30},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 31},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 32},
-	},
-	43: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 28},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 29},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 30},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 31},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 32},
-	},
-	44: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 28},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 29},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 30},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 31},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 32},
-	},
-	45: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 28},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 29},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 30},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 31},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 32},
-	},
-	46: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 28},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 29},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 30},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 31},
-		tokens.TokenType("plus"):        {Kind: PEMDASModParserActionShift, Target: 32},
-	},
-	47: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 28},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 29},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 30},
-		tokens.TokenType("minus"):       {Kind: PEMDASModParserActionShift, Target: 59},
-	},
-	48: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASModParserActionReduce, Target: 17},
-		tokens.TokenType("divide"):         {Kind: PEMDASModParserActionReduce, Target: 17},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASModParserActionReduce, Target: 17},
-		tokens.TokenType("minus"):          {Kind: PEMDASModParserActionReduce, Target: 17},
-		tokens.TokenType("modulo"):         {Kind: PEMDASModParserActionReduce, Target: 17},
-		tokens.TokenType("plus"):           {Kind: PEMDASModParserActionReduce, Target: 17},
-		tokens.TokenType("times"):          {Kind: PEMDASModParserActionReduce, Target: 17},
-	},
-	49: {
-		tokens.TokenType("rparen"): {Kind: PEMDASModParserActionShift, Target: 60},
-	},
-	50: {
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 12},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 12},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 12},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 12},
-		tokens.TokenType("rparen"): {Kind: PEMDASModParserActionReduce, Target: 12},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 12},
-	},
-	51: {
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 11},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 11},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 11},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 11},
-		tokens.TokenType("rparen"): {Kind: PEMDASModParserActionReduce, Target: 11},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 11},
-	},
-	52: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASModParserActionReduce, Target: 15},
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 15},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 15},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 15},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 15},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 15},
-	},
-	53: {
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionShift, Target: 44},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 5},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionShift, Target: 45},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 5},
-		tokens.TokenType("rparen"): {Kind: PEMDASModParserActionReduce, Target: 5},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionShift, Target: 46},
-	},
-	54: {
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionShift, Target: 44},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 4},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionShift, Target: 45},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 4},
-		tokens.TokenType("rparen"): {Kind: PEMDASModParserActionReduce, Target: 4},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionShift, Target: 46},
-	},
-	55: {
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 8},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 8},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 8},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 8},
-		tokens.TokenType("rparen"): {Kind: PEMDASModParserActionReduce, Target: 8},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 8},
-	},
-	56: {
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 9},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 9},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 9},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 9},
-		tokens.TokenType("rparen"): {Kind: PEMDASModParserActionReduce, Target: 9},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 9},
-	},
-	57: {
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 7},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 7},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 7},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 7},
-		tokens.TokenType("rparen"): {Kind: PEMDASModParserActionReduce, Target: 7},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 7},
-	},
-	58: {
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 14},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 14},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 14},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 14},
-		tokens.TokenType("rparen"): {Kind: PEMDASModParserActionReduce, Target: 14},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 14},
-	},
-	59: {
-		tokens.TokenType("hex_literal"): {Kind: PEMDASModParserActionShift, Target: 28},
-		tokens.TokenType("int_literal"): {Kind: PEMDASModParserActionShift, Target: 29},
-		tokens.TokenType("lparen"):      {Kind: PEMDASModParserActionShift, Target: 30},
-	},
-	60: {
-		tokens.TokenType("divide"):         {Kind: PEMDASModParserActionReduce, Target: 17},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASModParserActionReduce, Target: 17},
-		tokens.TokenType("minus"):          {Kind: PEMDASModParserActionReduce, Target: 17},
-		tokens.TokenType("modulo"):         {Kind: PEMDASModParserActionReduce, Target: 17},
-		tokens.TokenType("plus"):           {Kind: PEMDASModParserActionReduce, Target: 17},
-		tokens.TokenType("rparen"):         {Kind: PEMDASModParserActionReduce, Target: 17},
-		tokens.TokenType("times"):          {Kind: PEMDASModParserActionReduce, Target: 17},
-	},
-	61: {
-		tokens.TokenType("divide"): {Kind: PEMDASModParserActionReduce, Target: 15},
-		tokens.TokenType("minus"):  {Kind: PEMDASModParserActionReduce, Target: 15},
-		tokens.TokenType("modulo"): {Kind: PEMDASModParserActionReduce, Target: 15},
-		tokens.TokenType("plus"):   {Kind: PEMDASModParserActionReduce, Target: 15},
-		tokens.TokenType("rparen"): {Kind: PEMDASModParserActionReduce, Target: 15},
-		tokens.TokenType("times"):  {Kind: PEMDASModParserActionReduce, Target: 15},
-	},
-}
-
-var PEMDASModParserGotos = map[int]map[asts.NodeType]int{
-	0: {
-		asts.NodeType("AddSubTerm"):           1,
-		asts.NodeType("ExponentiationTerm"):   2,
-		asts.NodeType("MulDivTerm"):           3,
-		asts.NodeType("ParenTerm"):            4,
-		asts.NodeType("PrecedenceChainEnd"):   5,
-		asts.NodeType("PrecedenceChainStart"): 6,
-		asts.NodeType("Root"):                 7,
-		asts.NodeType("Rvalue"):               8,
-		asts.NodeType("UnaryTerm"):            9,
-	},
-	12: {
-		asts.NodeType("AddSubTerm"):           21,
-		asts.NodeType("ExponentiationTerm"):   22,
-		asts.NodeType("MulDivTerm"):           23,
-		asts.NodeType("ParenTerm"):            24,
-		asts.NodeType("PrecedenceChainEnd"):   25,
-		asts.NodeType("PrecedenceChainStart"): 26,
-		asts.NodeType("UnaryTerm"):            27,
-	},
-	13: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          33,
-	},
-	14: {
-		asts.NodeType("ExponentiationTerm"): 34,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-	},
-	15: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("MulDivTerm"):         35,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          9,
-	},
-	16: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("MulDivTerm"):         36,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          9,
-	},
-	17: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          37,
-	},
-	18: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          38,
-	},
-	19: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          39,
-	},
-	20: {
-		asts.NodeType("ExponentiationTerm"): 40,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-	},
-	30: {
-		asts.NodeType("AddSubTerm"):           21,
-		asts.NodeType("ExponentiationTerm"):   22,
-		asts.NodeType("MulDivTerm"):           23,
-		asts.NodeType("ParenTerm"):            24,
-		asts.NodeType("PrecedenceChainEnd"):   25,
-		asts.NodeType("PrecedenceChainStart"): 49,
-		asts.NodeType("UnaryTerm"):            27,
-	},
-	31: {
-		asts.NodeType("ExponentiationTerm"): 22,
-		asts.NodeType("ParenTerm"):          24,
-		asts.NodeType("PrecedenceChainEnd"): 25,
-		asts.NodeType("UnaryTerm"):          50,
-	},
-	32: {
-		asts.NodeType("ExponentiationTerm"): 51,
-		asts.NodeType("ParenTerm"):          24,
-		asts.NodeType("PrecedenceChainEnd"): 25,
-	},
-	41: {
-		asts.NodeType("ExponentiationTerm"): 52,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-	},
-	42: {
-		asts.NodeType("ExponentiationTerm"): 22,
-		asts.NodeType("MulDivTerm"):         53,
-		asts.NodeType("ParenTerm"):          24,
-		asts.NodeType("PrecedenceChainEnd"): 25,
-		asts.NodeType("UnaryTerm"):          27,
-	},
-	43: {
-		asts.NodeType("ExponentiationTerm"): 22,
-		asts.NodeType("MulDivTerm"):         54,
-		asts.NodeType("ParenTerm"):          24,
-		asts.NodeType("PrecedenceChainEnd"): 25,
-		asts.NodeType("UnaryTerm"):          27,
-	},
-	44: {
-		asts.NodeType("ExponentiationTerm"): 22,
-		asts.NodeType("ParenTerm"):          24,
-		asts.NodeType("PrecedenceChainEnd"): 25,
-		asts.NodeType("UnaryTerm"):          55,
-	},
-	45: {
-		asts.NodeType("ExponentiationTerm"): 22,
-		asts.NodeType("ParenTerm"):          24,
-		asts.NodeType("PrecedenceChainEnd"): 25,
-		asts.NodeType("UnaryTerm"):          56,
-	},
-	46: {
-		asts.NodeType("ExponentiationTerm"): 22,
-		asts.NodeType("ParenTerm"):          24,
-		asts.NodeType("PrecedenceChainEnd"): 25,
-		asts.NodeType("UnaryTerm"):          57,
-	},
-	47: {
-		asts.NodeType("ExponentiationTerm"): 58,
-		asts.NodeType("ParenTerm"):          24,
-		asts.NodeType("PrecedenceChainEnd"): 25,
-	},
-	59: {
-		asts.NodeType("ExponentiationTerm"): 61,
-		asts.NodeType("ParenTerm"):          24,
-		asts.NodeType("PrecedenceChainEnd"): 25,
-	},
-}
-
-var PEMDASModParserProductions = []PEMDASModParserProduction{
-	{lhs: asts.NodeType("__pgpg_start_1"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Root"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("Rvalue"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("PrecedenceChainStart"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("AddSubTerm"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 2}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("operator")},
-	{lhs: asts.NodeType("AddSubTerm"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 2}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("operator")},
-	{lhs: asts.NodeType("AddSubTerm"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("MulDivTerm"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 2}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("operator")},
-	{lhs: asts.NodeType("MulDivTerm"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 2}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("operator")},
-	{lhs: asts.NodeType("MulDivTerm"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 2}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("operator")},
-	{lhs: asts.NodeType("MulDivTerm"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("UnaryTerm"), rhsCount: 2, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{1}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("unary")},
-	{lhs: asts.NodeType("UnaryTerm"), rhsCount: 2, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{1}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("unary")},
-	{lhs: asts.NodeType("UnaryTerm"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("ExponentiationTerm"), rhsCount: 3, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 2}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("operator")},
-	{lhs: asts.NodeType("ExponentiationTerm"), rhsCount: 4, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 1, passthroughIndex: 0, parentLiteral: "", childIndices: []int{0, 3}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("operator")},
-	{lhs: asts.NodeType("ExponentiationTerm"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("ParenTerm"), rhsCount: 3, hasHint: false, hasPassthrough: true, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 1, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("ParenTerm"), rhsCount: 1, hasHint: false, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}},
-	{lhs: asts.NodeType("PrecedenceChainEnd"), rhsCount: 1, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("int_literal")},
-	{lhs: asts.NodeType("PrecedenceChainEnd"), rhsCount: 1, hasHint: true, hasPassthrough: false, hasParentLiteral: false, hasWithAppendedChildren: false, hasWithPrependedChildren: false, hasWithAdoptedGrandchildren: false, parentIndex: 0, passthroughIndex: 0, parentLiteral: "", childIndices: []int{}, withAppendedChildren: []int{}, withPrependedChildren: []int{}, withAdoptedGrandchildren: []int{}, nodeType: asts.NodeType("hex_literal")},
+	lr.Parser
+}
+
+func NewPEMDASModParser() *PEMDASModParser {
+	return &PEMDASModParser{Parser: lr.Parser{Tables: PEMDASModParserTables}}
+}
+
+// ParseWithPEMDASModParser parses the input, computing values of type T with the handler in place of
+// building an AST, and returns the start symbol's value. Syntax errors may be repaired, as with
+// RepairErrors, but error productions are not used.
+func ParseWithPEMDASModParser[T any](parser *PEMDASModParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {
+	return lr.ParseWith(&parser.Parser, lexer, handler)
+}
+
+// PEMDASModParserTables are the parser's LR tables, run by the lr package. The action and goto tables
+// are indexed by the IDs of Terminals and Nonterminals, and row-displaced, as lr.Tables documents.
+var PEMDASModParserTables = &lr.Tables{
+	StartSymbol: "Root",
+	Terminals: tokens.NewKinds(
+		"EOF",
+		"divide",
+		"exponentiation",
+		"hex_literal",
+		"int_literal",
+		"lparen",
+		"minus",
+		"modulo",
+		"plus",
+		"rparen",
+		"times",
+	),
+	Nonterminals: []asts.NodeType{
+		"AddSubTerm",
+		"ExponentiationTerm",
+		"MulDivTerm",
+		"ParenTerm",
+		"PrecedenceChainEnd",
+		"PrecedenceChainStart",
+		"Root",
+		"Rvalue",
+		"UnaryTerm",
+		"__pgpg_start_1",
+	},
+	ActionBase: []int32{
+		347, 131, 104, 115, -1, 10, 8, 19, 30, 126, 21, 32, 353, 359, -1, 365,
+		371, 377, 383, 389, 103, 140, 134, 144, 42, 52, 32, 154, 62, 72, 395, 401,
+		10, 165, 176, 187, 198, 209, 220, 231, 242, 21, 407, 413, 419, 425, 431, 114,
+		83, 36, 250, 260, 271, 279, 289, 299, 309, 319, 329, 32, 93, 339,
+	},
+	ActionCheck: []int32{
+		4, 4, 4, 14, 14, 14, 4, 4, 4, 6, 4, 5, 5, 5, 32, 32,
+		32, 5, 5, 5, 7, 5, 10, 10, 10, 41, 41, 41, 10, 10, 10, 8,
+		10, 11, 11, 11, 59, 59, 59, 11, 11, 11, 26, 11, 24, 24, 49, -1,
+		-1, 24, 24, 24, 24, 24, 25, 25, -1, -1, -1, 25, 25, 25, 25, 25,
+		28, 28, -1, -1, -1, 28, 28, 28, 28, 28, 29, 29, -1, -1, -1, 29,
+		29, 29, 29, 29, 48, 48, 48, -1, -1, -1, 48, 48, 48, -1, 48, 60,
+		60, -1, -1, -1, 60, 60, 60, 60, 60, 2, 2, 20, 20, 20, 20, 2,
+		2, 2, -1, 2, 3, 3, 47, 47, 47, 47, 3, 3, 3, -1, 3, 9,
+		9, -1, -1, -1, 1, 9, 9, 9, 22, 9, 1, -1, 1, 22, 22, 22,
+		22, 22, 23, 21, -1, 21, 21, 23, 23, 23, 23, 23, 27, -1, -1, -1,
+		-1, 27, 27, 27, 27, 27, 33, 33, -1, -1, -1, -1, 33, 33, 33, -1,
+		33, 34, 34, -1, -1, -1, -1, 34, 34, 34, -1, 34, 35, 35, -1, -1,
+		-1, -1, 35, 35, 35, -1, 35, 36, 36, -1, -1, -1, -1, 36, 36, 36,
+		-1, 36, 37, 37, -1, -1, -1, -1, 37, 37, 37, -1, 37, 38, 38, -1,
+		-1, -1, -1, 38, 38, 38, -1, 38, 39, 39, -1, -1, -1, -1, 39, 39,
+		39, -1, 39, 40, 40, -1, -1, -1, -1, 40, 40, 40, 50, 40, -1, -1,
+		-1, 50, 50, 50, 50, 50, 51, -1, -1, -1, -1, 51, 51, 51, 51, 51,
+		52, 52, -1, -1, -1, -1, 52, 52, 52, 53, 52, -1, -1, -1, 53, 53,
+		53, 53, 53, 54, -1, -1, -1, -1, 54, 54, 54, 54, 54, 55, -1, -1,
+		-1, -1, 55, 55, 55, 55, 55, 56, -1, -1, -1, -1, 56, 56, 56, 56,
+		56, 57, -1, -1, -1, -1, 57, 57, 57, 57, 57, 58, -1, -1, -1, -1,
+		58, 58, 58, 58, 58, 61, -1, -1, -1, -1, 61, 61, 61, 61, 61, 0,
+		0, 0, 0, -1, 0, 12, 12, 12, 12, -1, 12, 13, 13, 13, 13, -1,
+		13, 15, 15, 15, 15, -1, 15, 16, 16, 16, 16, -1, 16, 17, 17, 17,
+		17, -1, 17, 18, 18, 18, 18, -1, 18, 19, 19, 19, 19, -1, 19, 30,
+		30, 30, 30, -1, 30, 31, 31, 31, 31, -1, 31, 42, 42, 42, 42, -1,
+		42, 43, 43, 43, 43, -1, 43, 44, 44, 44, 44, -1, 44, 45, 45, 45,
+		45, -1, 45, 46, 46, 46, 46, -1, 46,
+	},
+	ActionEntries: []int32{
+		65, 65, 80, 40, 44, 48, 65, 65, 65, 9, 65, 73, 73, 73, 112, 116,
+		120, 73, 73, 73, 2, 73, 81, 81, 81, 40, 44, 48, 81, 81, 81, 5,
+		81, 77, 77, 77, 112, 116, 120, 77, 77, 77, 192, 77, 65, 188, 240, 0,
+		0, 65, 65, 65, 65, 65, 73, 73, 0, 0, 0, 73, 73, 73, 73, 73,
+		81, 81, 0, 0, 0, 81, 81, 81, 81, 81, 77, 77, 0, 0, 0, 77,
+		77, 77, 77, 77, 69, 69, 69, 0, 0, 0, 69, 69, 69, 0, 69, 69,
+		69, 0, 0, 0, 69, 69, 69, 69, 69, 53, 53, 40, 44, 48, 164, 53,
+		53, 53, 0, 53, 25, 68, 112, 116, 120, 236, 25, 72, 25, 0, 76, 41,
+		41, 0, 0, 0, 13, 41, 41, 41, 53, 41, 60, 0, 64, 53, 53, 53,
+		53, 53, 176, 168, 0, 172, 13, 25, 180, 25, 25, 184, 41, 0, 0, 0,
+		0, 41, 41, 41, 41, 41, 49, 49, 0, 0, 0, 0, 49, 49, 49, 0,
+		49, 45, 45, 0, 0, 0, 0, 45, 45, 45, 0, 45, 21, 68, 0, 0,
+		0, 0, 21, 72, 21, 0, 76, 17, 68, 0, 0, 0, 0, 17, 72, 17,
+		0, 76, 33, 33, 0, 0, 0, 0, 33, 33, 33, 0, 33, 37, 37, 0,
+		0, 0, 0, 37, 37, 37, 0, 37, 29, 29, 0, 0, 0, 0, 29, 29,
+		29, 0, 29, 57, 57, 0, 0, 0, 0, 57, 57, 57, 49, 57, 0, 0,
+		0, 49, 49, 49, 49, 49, 45, 0, 0, 0, 0, 45, 45, 45, 45, 45,
+		61, 61, 0, 0, 0, 0, 61, 61, 61, 176, 61, 0, 0, 0, 21, 180,
+		21, 21, 184, 176, 0, 0, 0, 0, 17, 180, 17, 17, 184, 33, 0, 0,
+		0, 0, 33, 33, 33, 33, 33, 37, 0, 0, 0, 0, 37, 37, 37, 37,
+		37, 29, 0, 0, 0, 0, 29, 29, 29, 29, 29, 57, 0, 0, 0, 0,
+		57, 57, 57, 57, 57, 61, 0, 0, 0, 0, 61, 61, 61, 61, 61, 40,
+		44, 48, 52, 0, 56, 112, 116, 120, 124, 0, 128, 40, 44, 48, 52, 0,
+		56, 40, 44, 48, 52, 0, 56, 40, 44, 48, 52, 0, 56, 40, 44, 48,
+		52, 0, 56, 40, 44, 48, 52, 0, 56, 40, 44, 48, 52, 0, 56, 112,
+		116, 120, 124, 0, 128, 112, 116, 120, 124, 0, 128, 112, 116, 120, 124, 0,
+		128, 112, 116, 120, 124, 0, 128, 112, 116, 120, 124, 0, 128, 112, 116, 120,
+		124, 0, 128, 112, 116, 120, 124, 0, 128,
+	},
+	GotoBase: []int32{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 56, 104, 26,
+		34, 62, 68, 74, 108, 0, 0, 0, 0, 0, 0, 0, 0, 0, 18, 80,
+		112, 0, 0, 0, 0, 0, 0, 0, 0, 116, 42, 50, 86, 92, 98, 120,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 124, 0, 0,
+	},
+	GotoCheck: []int32{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 12, 12, 12, 12, 12, 12, -1,
+		-1, 12, 30, 30, 30, 30, 30, 30, -1, -1, 30, 15, 15, 15, 15, -1,
+		-1, -1, 15, 16, 16, 16, 16, -1, -1, -1, 16, 42, 42, 42, 42, -1,
+		-1, -1, 42, 43, 43, 43, 43, -1, -1, 13, 43, 13, 13, -1, -1, 17,
+		13, 17, 17, -1, -1, 18, 17, 18, 18, -1, -1, 19, 18, 19, 19, -1,
+		-1, 31, 19, 31, 31, -1, -1, 44, 31, 44, 44, -1, -1, 45, 44, 45,
+		45, -1, -1, 46, 45, 46, 46, -1, -1, 14, 46, 14, 14, 20, -1, 20,
+		20, 32, -1, 32, 32, 41, -1, 41, 41, 47, -1, 47, 47, 59, -1, 59,
+		59,
+	},
+	GotoTargets: []int32{
+		1, 2, 3, 4, 5, 6, 7, 8, 9, 21, 22, 23, 24, 25, 26, 0,
+		0, 27, 21, 22, 23, 24, 25, 49, 0, 0, 27, 2, 35, 4, 5, 0,
+		0, 0, 9, 2, 36, 4, 5, 0, 0, 0, 9, 22, 53, 24, 25, 0,
+		0, 0, 27, 22, 54, 24, 25, 0, 0, 2, 27, 4, 5, 0, 0, 2,
+		33, 4, 5, 0, 0, 2, 37, 4, 5, 0, 0, 2, 38, 4, 5, 0,
+		0, 22, 39, 24, 25, 0, 0, 22, 50, 24, 25, 0, 0, 22, 55, 24,
+		25, 0, 0, 22, 56, 24, 25, 0, 0, 34, 57, 4, 5, 40, 0, 4,
+		5, 51, 0, 24, 25, 52, 0, 4, 5, 58, 0, 24, 25, 61, 0, 24,
+		25,
+	},
+	Productions: []lr.Production{
+		{LHS: asts.NodeType("__pgpg_start_1"), LHSID: 9, RHSCount: 1},
+		{LHS: asts.NodeType("Root"), LHSID: 6, RHSCount: 1},
+		{LHS: asts.NodeType("Rvalue"), LHSID: 7, RHSCount: 1},
+		{LHS: asts.NodeType("PrecedenceChainStart"), LHSID: 5, RHSCount: 1},
+		{LHS: asts.NodeType("AddSubTerm"), LHSID: 0, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("AddSubTerm"), LHSID: 0, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("AddSubTerm"), LHSID: 0, RHSCount: 1},
+		{LHS: asts.NodeType("MulDivTerm"), LHSID: 2, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("MulDivTerm"), LHSID: 2, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("MulDivTerm"), LHSID: 2, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("MulDivTerm"), LHSID: 2, RHSCount: 1},
+		{LHS: asts.NodeType("UnaryTerm"), LHSID: 8, RHSCount: 2, Hint: &lr.Hint{ChildIndices: []int{1}, NodeType: asts.NodeType("unary")}},
+		{LHS: asts.NodeType("UnaryTerm"), LHSID: 8, RHSCount: 2, Hint: &lr.Hint{ChildIndices: []int{1}, NodeType: asts.NodeType("unary")}},
+		{LHS: asts.NodeType("UnaryTerm"), LHSID: 8, RHSCount: 1},
+		{LHS: asts.NodeType("ExponentiationTerm"), LHSID: 1, RHSCount: 3, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("ExponentiationTerm"), LHSID: 1, RHSCount: 4, Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 3}, NodeType: asts.NodeType("operator")}},
+		{LHS: asts.NodeType("ExponentiationTerm"), LHSID: 1, RHSCount: 1},
+		{LHS: asts.NodeType("ParenTerm"), LHSID: 3, RHSCount: 3, Hint: &lr.Hint{Passthrough: true, PassthroughIndex: 1}},
+		{LHS: asts.NodeType("ParenTerm"), LHSID: 3, RHSCount: 1},
+		{LHS: asts.NodeType("PrecedenceChainEnd"), LHSID: 4, RHSCount: 1, Hint: &lr.Hint{NodeType: asts.NodeType("int_literal")}},
+		{LHS: asts.NodeType("PrecedenceChainEnd"), LHSID: 4, RHSCount: 1, Hint: &lr.Hint{NodeType: asts.NodeType("hex_literal")}},
+	},
+	HintMode: "hints",
 }
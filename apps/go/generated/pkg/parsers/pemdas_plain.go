@@ -1,915 +1,164 @@
 package parsers
 
 import (
-	"fmt"
-	"os"
-	"strings"
-
 	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
 	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
 	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
 )
 
+// PEMDASPlainParser parses with PEMDASPlainParserTables. Parsing, error handling, and the parser's
+// options are those of lr.Parser.
 type PEMDASPlainParser struct {
-	Trace            *PEMDASPlainParserTraceHooks
-	stashedLookahead *tokens.Token
-}
-
-type PEMDASPlainParserTraceHooks struct {
-	OnToken  func(tok *tokens.Token)
-	OnAction func(state int, action PEMDASPlainParserAction, lookahead *tokens.Token)
-	OnStack  func(stateStack []int, nodeStack []*asts.ASTNode)
-}
-
-func NewPEMDASPlainParser() *PEMDASPlainParser { return &PEMDASPlainParser{} }
-
-// noASTSentinel is used as a placeholder on the node stack when astMode == "noast".
-var PEMDASPlainParserNoASTSentinel = &asts.ASTNode{}
-
-func (parser *PEMDASPlainParser) Parse(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {
-	if lexer == nil {
-		return nil, fmt.Errorf("parser: nil lexer")
-	}
-	stateStack := []int{0}
-	nodeStack := []*asts.ASTNode{}
-	lookahead := lexer.Scan()
-	if parser.Trace != nil && parser.Trace.OnToken != nil {
-		parser.Trace.OnToken(lookahead)
-	}
-	for {
-		if lookahead == nil {
-			return nil, fmt.Errorf("parser: lexer returned nil token")
-		}
-		if lookahead.Type == tokens.TokenTypeError {
-			return nil, fmt.Errorf("lexer error: %s", string(lookahead.Lexeme))
-		}
-		state := stateStack[len(stateStack)-1]
-		action, ok := PEMDASPlainParserActions[state][lookahead.Type]
-		if !ok {
-			return nil, fmt.Errorf("parse error: unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme))
-		}
-		if parser.Trace != nil && parser.Trace.OnAction != nil {
-			parser.Trace.OnAction(state, action, lookahead)
-		}
-		switch action.Kind {
-		case PEMDASPlainParserActionShift:
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, PEMDASPlainParserNoASTSentinel)
-			} else {
-				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
-			}
-			stateStack = append(stateStack, action.Target)
-			lookahead = lexer.Scan()
-			if parser.Trace != nil && parser.Trace.OnToken != nil {
-				parser.Trace.OnToken(lookahead)
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case PEMDASPlainParserActionReduce:
-			prod := PEMDASPlainParserProductions[action.Target]
-			rhsNodes := make([]*asts.ASTNode, prod.rhsCount)
-			for i := prod.rhsCount - 1; i >= 0; i-- {
-				stateStack = stateStack[:len(stateStack)-1]
-				rhsNodes[i] = nodeStack[len(nodeStack)-1]
-				nodeStack = nodeStack[:len(nodeStack)-1]
-			}
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, PEMDASPlainParserNoASTSentinel)
-			} else {
-				if prod.rhsCount == 0 {
-					rhsNodes = []*asts.ASTNode{}
-				}
-				node := asts.NewASTNode(nil, prod.lhs, rhsNodes)
-				nodeStack = append(nodeStack, node)
-			}
-			state = stateStack[len(stateStack)-1]
-			nextState, ok := PEMDASPlainParserGotos[state][prod.lhs]
-			if !ok {
-				return nil, fmt.Errorf("parse error: missing goto for %s", prod.lhs)
-			}
-			stateStack = append(stateStack, nextState)
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case PEMDASPlainParserActionAccept:
-			if len(nodeStack) != 1 {
-				return nil, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			if astMode == "noast" {
-				return nil, nil
-			}
-			return asts.NewAST(nodeStack[0]), nil
-		case PEMDASPlainParserActionAcceptAndYield:
-			return nil, fmt.Errorf("parse error: multiple objects; use ParseOne for multi-object input")
-		default:
-			return nil, fmt.Errorf("parse error: no action")
-		}
-	}
-}
-
-// ParseOne parses one record from the lexer. It is for multi-object input: call in a loop until done.
-// Returns (ast, true, nil) on EOF after a record, (ast, false, nil) when more input follows, or (nil, false, err) on error.
-func (parser *PEMDASPlainParser) ParseOne(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, bool, error) {
-	if lexer == nil {
-		return nil, false, fmt.Errorf("parser: nil lexer")
-	}
-	stateStack := []int{0}
-	nodeStack := []*asts.ASTNode{}
-	var lookahead *tokens.Token
-	if parser.stashedLookahead != nil {
-		lookahead = parser.stashedLookahead
-		parser.stashedLookahead = nil
-	} else {
-		lookahead = lexer.Scan()
-	}
-	if parser.Trace != nil && parser.Trace.OnToken != nil {
-		parser.Trace.OnToken(lookahead)
-	}
-	for {
-		if lookahead == nil {
-			return nil, false, fmt.Errorf("parser: lexer returned nil token")
-		}
-		if lookahead.Type == tokens.TokenTypeError {
-			return nil, false, fmt.Errorf("lexer error: %s", string(lookahead.Lexeme))
-		}
-		state := stateStack[len(stateStack)-1]
-		action, ok := PEMDASPlainParserActions[state][lookahead.Type]
-		if !ok {
-			return nil, false, fmt.Errorf("parse error: unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme))
-		}
-		if parser.Trace != nil && parser.Trace.OnAction != nil {
-			parser.Trace.OnAction(state, action, lookahead)
-		}
-		switch action.Kind {
-		case PEMDASPlainParserActionShift:
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, PEMDASPlainParserNoASTSentinel)
-			} else {
-				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
-			}
-			stateStack = append(stateStack, action.Target)
-			lookahead = lexer.Scan()
-			if parser.Trace != nil && parser.Trace.OnToken != nil {
-				parser.Trace.OnToken(lookahead)
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case PEMDASPlainParserActionReduce:
-			prod := PEMDASPlainParserProductions[action.Target]
-			rhsNodes := make([]*asts.ASTNode, prod.rhsCount)
-			for i := prod.rhsCount - 1; i >= 0; i-- {
-				stateStack = stateStack[:len(stateStack)-1]
-				rhsNodes[i] = nodeStack[len(nodeStack)-1]
-				nodeStack = nodeStack[:len(nodeStack)-1]
-			}
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, PEMDASPlainParserNoASTSentinel)
-			} else {
-				if prod.rhsCount == 0 {
-					rhsNodes = []*asts.ASTNode{}
-				}
-				node := asts.NewASTNode(nil, prod.lhs, rhsNodes)
-				nodeStack = append(nodeStack, node)
-			}
-			state = stateStack[len(stateStack)-1]
-			nextState, ok := PEMDASPlainParserGotos[state][prod.lhs]
-			if !ok {
-				return nil, false, fmt.Errorf("parse error: missing goto for %s", prod.lhs)
-			}
-			stateStack = append(stateStack, nextState)
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case PEMDASPlainParserActionAccept:
-			if len(nodeStack) != 1 {
-				return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			if astMode == "noast" {
-				return nil, true, nil
-			}
-			return asts.NewAST(nodeStack[0]), true, nil
-		case PEMDASPlainParserActionAcceptAndYield:
-			if len(nodeStack) != 1 {
-				return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			parser.stashedLookahead = lookahead
-			if astMode == "noast" {
-				return nil, false, nil
-			}
-			return asts.NewAST(nodeStack[0]), false, nil
-		default:
-			return nil, false, fmt.Errorf("parse error: no action")
-		}
-	}
-}
-
-// AttachCLITrace installs tracing hooks for CLI debugging.
-func (parser *PEMDASPlainParser) AttachCLITrace(traceTokens bool, traceStates bool, traceStack bool) {
-	if !traceTokens && !traceStates && !traceStack {
-		return
-	}
-	parser.Trace = &PEMDASPlainParserTraceHooks{
-		OnToken: func(tok *tokens.Token) {
-			if !traceTokens {
-				return
-			}
-			fmt.Fprintln(os.Stderr, formatPEMDASPlainParserToken(tok))
-		},
-		OnAction: func(state int, action PEMDASPlainParserAction, lookahead *tokens.Token) {
-			if !traceStates {
-				return
-			}
-			fmt.Fprintf(os.Stderr, "STATE %d %s on %s(%q)\n",
-				state, formatPEMDASPlainParserAction(action), tokenTypeNamePEMDASPlainParser(lookahead), tokenLexemePEMDASPlainParser(lookahead))
-		},
-		OnStack: func(stateStack []int, nodeStack []*asts.ASTNode) {
-			if !traceStack {
-				return
-			}
-			fmt.Fprintf(os.Stderr, "STACK states=%s nodes=%s\n",
-				formatPEMDASPlainParserIntStack(stateStack), formatPEMDASPlainParserNodeStack(nodeStack))
-		},
-	}
-}
-
-type PEMDASPlainParserActionKind int
-
-const (
-	PEMDASPlainParserActionShift PEMDASPlainParserActionKind = iota
-	PEMDASPlainParserActionReduce
-	PEMDASPlainParserActionAccept
-	PEMDASPlainParserActionAcceptAndYield
-)
-
-type PEMDASPlainParserAction struct {
-	Kind   PEMDASPlainParserActionKind
-	Target int
-}
-
-func formatPEMDASPlainParserToken(tok *tokens.Token) string {
-	if tok == nil {
-		return "TOK <nil>"
-	}
-	return fmt.Sprintf("TOK type=%s lexeme=%q line=%d col=%d",
-		tok.Type, string(tok.Lexeme), tok.Location.LineNumber, tok.Location.ColumnNumber)
-}
-
-func tokenTypeNamePEMDASPlainParser(tok *tokens.Token) string {
-	if tok == nil {
-		return "<nil>"
-	}
-	return string(tok.Type)
-}
-
-func tokenLexemePEMDASPlainParser(tok *tokens.Token) string {
-	if tok == nil {
-		return ""
-	}
-	return string(tok.Lexeme)
-}
-
-func formatPEMDASPlainParserIntStack(stack []int) string {
-	parts := make([]string, len(stack))
-	for i, v := range stack {
-		parts[i] = fmt.Sprintf("%d", v)
-	}
-	return "[" + strings.Join(parts, " ") + "]"
-}
-
-func formatPEMDASPlainParserNodeStack(stack []*asts.ASTNode) string {
-	parts := make([]string, len(stack))
-	for i, node := range stack {
-		if node == nil {
-			parts[i] = "<nil>"
-			continue
-		}
-		parts[i] = string(node.Type)
-	}
-	return "[" + strings.Join(parts, " ") + "]"
-}
-
-func formatPEMDASPlainParserAction(action PEMDASPlainParserAction) string {
-	switch action.Kind {
-	case PEMDASPlainParserActionShift:
-		return fmt.Sprintf("shift(%d)", action.Target)
-	case PEMDASPlainParserActionReduce:
-		return fmt.Sprintf("reduce(%d)", action.Target)
-	case PEMDASPlainParserActionAccept:
-		return "accept"
-	case PEMDASPlainParserActionAcceptAndYield:
-		return "accept_and_yield"
-	default:
-		return "unknown"
-	}
-}
-
-type PEMDASPlainParserProduction struct {
-	lhs      asts.NodeType
-	rhsCount int
-}
-
-var PEMDASPlainParserActions = map[int]map[tokens.TokenType]PEMDASPlainParserAction{
-	0: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 10},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 11},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 12},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 13},
-	},
-	1: {
-		tokens.TokenTypeEOF:             {Kind: PEMDASPlainParserActionReduce, Target: 3},
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionReduce, Target: 3},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionReduce, Target: 3},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 14},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 15},
-	},
-	2: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASPlainParserActionReduce, Target: 13},
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 13},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 13},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 13},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 13},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 13},
-	},
-	3: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASPlainParserActionReduce, Target: 6},
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionShift, Target: 16},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 6},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionShift, Target: 17},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 6},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionShift, Target: 18},
-	},
-	4: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASPlainParserActionReduce, Target: 16},
-		tokens.TokenType("divide"):         {Kind: PEMDASPlainParserActionReduce, Target: 16},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASPlainParserActionShift, Target: 19},
-		tokens.TokenType("minus"):          {Kind: PEMDASPlainParserActionReduce, Target: 16},
-		tokens.TokenType("modulo"):         {Kind: PEMDASPlainParserActionReduce, Target: 16},
-		tokens.TokenType("plus"):           {Kind: PEMDASPlainParserActionReduce, Target: 16},
-		tokens.TokenType("times"):          {Kind: PEMDASPlainParserActionReduce, Target: 16},
-	},
-	5: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASPlainParserActionReduce, Target: 18},
-		tokens.TokenType("divide"):         {Kind: PEMDASPlainParserActionReduce, Target: 18},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASPlainParserActionReduce, Target: 18},
-		tokens.TokenType("minus"):          {Kind: PEMDASPlainParserActionReduce, Target: 18},
-		tokens.TokenType("modulo"):         {Kind: PEMDASPlainParserActionReduce, Target: 18},
-		tokens.TokenType("plus"):           {Kind: PEMDASPlainParserActionReduce, Target: 18},
-		tokens.TokenType("times"):          {Kind: PEMDASPlainParserActionReduce, Target: 18},
-	},
-	6: {
-		tokens.TokenTypeEOF:             {Kind: PEMDASPlainParserActionReduce, Target: 2},
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionReduce, Target: 2},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionReduce, Target: 2},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionReduce, Target: 2},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionReduce, Target: 2},
-	},
-	7: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASPlainParserActionAccept},
-		tokens.TokenType("divide"):         {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("int_literal"):    {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("lparen"):         {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("minus"):          {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("modulo"):         {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("plus"):           {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("rparen"):         {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("times"):          {Kind: PEMDASPlainParserActionAcceptAndYield},
-	},
-	8: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASPlainParserActionReduce, Target: 1},
-		tokens.TokenType("divide"):         {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("int_literal"):    {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("lparen"):         {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("minus"):          {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("modulo"):         {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("plus"):           {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("rparen"):         {Kind: PEMDASPlainParserActionAcceptAndYield},
-		tokens.TokenType("times"):          {Kind: PEMDASPlainParserActionAcceptAndYield},
-	},
-	9: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASPlainParserActionReduce, Target: 10},
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 10},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 10},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 10},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 10},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 10},
-	},
-	10: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASPlainParserActionReduce, Target: 19},
-		tokens.TokenType("divide"):         {Kind: PEMDASPlainParserActionReduce, Target: 19},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASPlainParserActionReduce, Target: 19},
-		tokens.TokenType("minus"):          {Kind: PEMDASPlainParserActionReduce, Target: 19},
-		tokens.TokenType("modulo"):         {Kind: PEMDASPlainParserActionReduce, Target: 19},
-		tokens.TokenType("plus"):           {Kind: PEMDASPlainParserActionReduce, Target: 19},
-		tokens.TokenType("times"):          {Kind: PEMDASPlainParserActionReduce, Target: 19},
-	},
-	11: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 27},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 28},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 29},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 30},
-	},
-	12: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 10},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 11},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 12},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 13},
-	},
-	13: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 10},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 11},
-	},
-	14: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 10},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 11},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 12},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 13},
-	},
-	15: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 10},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 11},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 12},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 13},
-	},
-	16: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 10},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 11},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 12},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 13},
-	},
-	17: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 10},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 11},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 12},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 13},
-	},
-	18: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 10},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 11},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 12},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 13},
-	},
-	19: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 10},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 11},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 39},
-	},
-	20: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionReduce, Target: 3},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionReduce, Target: 3},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 40},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 41},
-		tokens.TokenType("rparen"):      {Kind: PEMDASPlainParserActionReduce, Target: 3},
-	},
-	21: {
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 13},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 13},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 13},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 13},
-		tokens.TokenType("rparen"): {Kind: PEMDASPlainParserActionReduce, Target: 13},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 13},
-	},
-	22: {
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionShift, Target: 42},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 6},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionShift, Target: 43},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 6},
-		tokens.TokenType("rparen"): {Kind: PEMDASPlainParserActionReduce, Target: 6},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionShift, Target: 44},
-	},
-	23: {
-		tokens.TokenType("divide"):         {Kind: PEMDASPlainParserActionReduce, Target: 16},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASPlainParserActionShift, Target: 45},
-		tokens.TokenType("minus"):          {Kind: PEMDASPlainParserActionReduce, Target: 16},
-		tokens.TokenType("modulo"):         {Kind: PEMDASPlainParserActionReduce, Target: 16},
-		tokens.TokenType("plus"):           {Kind: PEMDASPlainParserActionReduce, Target: 16},
-		tokens.TokenType("rparen"):         {Kind: PEMDASPlainParserActionReduce, Target: 16},
-		tokens.TokenType("times"):          {Kind: PEMDASPlainParserActionReduce, Target: 16},
-	},
-	24: {
-		tokens.TokenType("divide"):         {Kind: PEMDASPlainParserActionReduce, Target: 18},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASPlainParserActionReduce, Target: 18},
-		tokens.TokenType("minus"):          {Kind: PEMDASPlainParserActionReduce, Target: 18},
-		tokens.TokenType("modulo"):         {Kind: PEMDASPlainParserActionReduce, Target: 18},
-		tokens.TokenType("plus"):           {Kind: PEMDASPlainParserActionReduce, Target: 18},
-		tokens.TokenType("rparen"):         {Kind: PEMDASPlainParserActionReduce, Target: 18},
-		tokens.TokenType("times"):          {Kind: PEMDASPlainParserActionReduce, Target: 18},
-	},
-	25: {
-		tokens.TokenType("rparen"): {Kind: PEMDASPlainParserActionShift, Target: 46},
-	},
-	26: {
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 10},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 10},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 10},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 10},
-		tokens.TokenType("rparen"): {Kind: PEMDASPlainParserActionReduce, Target: 10},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 10},
+	lr.Parser
+}
+
+func NewPEMDASPlainParser() *PEMDASPlainParser {
+	return &PEMDASPlainParser{Parser: lr.Parser{Tables: PEMDASPlainParserTables}}
+}
+
+// ParseWithPEMDASPlainParser parses the input, computing values of type T with the handler in place of
+// building an AST, and returns the start symbol's value. Syntax errors may be repaired, as with
+// RepairErrors, but error productions are not used.
+func ParseWithPEMDASPlainParser[T any](parser *PEMDASPlainParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {
+	return lr.ParseWith(&parser.Parser, lexer, handler)
+}
+
+// PEMDASPlainParserTables are the parser's LR tables, run by the lr package. The action and goto tables
+// are indexed by the IDs of Terminals and Nonterminals, and row-displaced, as lr.Tables documents.
+var PEMDASPlainParserTables = &lr.Tables{
+	StartSymbol: "Root",
+	Terminals: tokens.NewKinds(
+		"EOF",
+		"divide",
+		"exponentiation",
+		"int_literal",
+		"lparen",
+		"minus",
+		"modulo",
+		"plus",
+		"rparen",
+		"times",
+	),
+	Nonterminals: []asts.NodeType{
+		"AddSubTerm",
+		"ExponentiationTerm",
+		"MulDivTerm",
+		"ParenTerm",
+		"PrecedenceChainEnd",
+		"PrecedenceChainStart",
+		"Root",
+		"Rvalue",
+		"UnaryTerm",
+		"__pgpg_start_1",
+	},
+	ActionBase: []int32{
+		294, 365, 75, 85, -1, 9, 7, 17, 27, 95, 19, 299, 304, -1, 309, 314,
+		319, 324, 329, 74, 368, 102, 111, 28, 37, 32, 120, 46, 334, 339, 9, 130,
+		140, 150, 160, 170, 180, 190, 200, 19, 344, 349, 354, 359, 364, 84, 56, 33,
+		207, 216, 226, 233, 242, 251, 260, 269, 278, 28, 65, 287,
+	},
+	ActionCheck: []int32{
+		4, 4, 4, 13, 13, 4, 4, 4, 6, 4, 5, 5, 5, 30, 30, 5,
+		5, 5, 7, 5, 10, 10, 10, 39, 39, 10, 10, 10, 8, 10, 23, 23,
+		57, 57, 23, 23, 23, 23, 23, 24, 24, 25, 47, 24, 24, 24, 24, 24,
+		27, 27, -1, -1, 27, 27, 27, 27, 27, 46, 46, 46, -1, -1, 46, 46,
+		46, -1, 46, 58, 58, -1, -1, 58, 58, 58, 58, 58, 2, 2, 19, 19,
+		19, 2, 2, 2, -1, 2, 3, 3, 45, 45, 45, 3, 3, 3, -1, 3,
+		9, 9, -1, -1, -1, 9, 9, 9, 21, 9, -1, -1, 21, 21, 21, 21,
+		21, 22, -1, -1, -1, 22, 22, 22, 22, 22, 26, -1, -1, -1, 26, 26,
+		26, 26, 26, 31, 31, -1, -1, -1, 31, 31, 31, -1, 31, 32, 32, -1,
+		-1, -1, 32, 32, 32, -1, 32, 33, 33, -1, -1, -1, 33, 33, 33, -1,
+		33, 34, 34, -1, -1, -1, 34, 34, 34, -1, 34, 35, 35, -1, -1, -1,
+		35, 35, 35, -1, 35, 36, 36, -1, -1, -1, 36, 36, 36, -1, 36, 37,
+		37, -1, -1, -1, 37, 37, 37, -1, 37, 38, 38, -1, -1, -1, 38, 38,
+		38, 48, 38, -1, -1, 48, 48, 48, 48, 48, 49, -1, -1, -1, 49, 49,
+		49, 49, 49, 50, 50, -1, -1, -1, 50, 50, 50, 51, 50, -1, -1, 51,
+		51, 51, 51, 51, 52, -1, -1, -1, 52, 52, 52, 52, 52, 53, -1, -1,
+		-1, 53, 53, 53, 53, 53, 54, -1, -1, -1, 54, 54, 54, 54, 54, 55,
+		-1, -1, -1, 55, 55, 55, 55, 55, 56, -1, -1, -1, 56, 56, 56, 56,
+		56, 59, -1, -1, -1, 59, 59, 59, 59, 59, 0, 0, 0, -1, 0, 11,
+		11, 11, -1, 11, 12, 12, 12, -1, 12, 14, 14, 14, -1, 14, 15, 15,
+		15, -1, 15, 16, 16, 16, -1, 16, 17, 17, 17, -1, 17, 18, 18, 18,
+		-1, 18, 28, 28, 28, -1, 28, 29, 29, 29, -1, 29, 40, 40, 40, -1,
+		40, 41, 41, 41, -1, 41, 42, 42, 42, -1, 42, 43, 43, 43, 1, 43,
+		44, 44, 44, 1, 44, 1, 20, -1, 20, 20,
+	},
+	ActionEntries: []int32{
+		65, 65, 76, 40, 44, 65, 65, 65, 9, 65, 73, 73, 73, 108, 112, 73,
+		73, 73, 2, 73, 77, 77, 77, 40, 44, 77, 77, 77, 5, 77, 65, 180,
+		108, 112, 65, 65, 65, 65, 65, 73, 73, 184, 232, 73, 73, 73, 73, 73,
+		77, 77, 0, 0, 77, 77, 77, 77, 77, 69, 69, 69, 0, 0, 69, 69,
+		69, 0, 69, 69, 69, 0, 0, 69, 69, 69, 69, 69, 53, 53, 40, 44,
+		156, 53, 53, 53, 0, 53, 25, 64, 108, 112, 228, 25, 68, 25, 0, 72,
+		41, 41, 0, 0, 0, 41, 41, 41, 53, 41, 0, 0, 53, 53, 53, 53,
+		53, 168, 0, 0, 0, 25, 172, 25, 25, 176, 41, 0, 0, 0, 41, 41,
+		41, 41, 41, 49, 49, 0, 0, 0, 49, 49, 49, 0, 49, 45, 45, 0,
+		0, 0, 45, 45, 45, 0, 45, 21, 64, 0, 0, 0, 21, 68, 21, 0,
+		72, 17, 64, 0, 0, 0, 17, 68, 17, 0, 72, 33, 33, 0, 0, 0,
+		33, 33, 33, 0, 33, 37, 37, 0, 0, 0, 37, 37, 37, 0, 37, 29,
+		29, 0, 0, 0, 29, 29, 29, 0, 29, 57, 57, 0, 0, 0, 57, 57,
+		57, 49, 57, 0, 0, 49, 49, 49, 49, 49, 45, 0, 0, 0, 45, 45,
+		45, 45, 45, 61, 61, 0, 0, 0, 61, 61, 61, 168, 61, 0, 0, 21,
+		172, 21, 21, 176, 168, 0, 0, 0, 17, 172, 17, 17, 176, 33, 0, 0,
+		0, 33, 33, 33, 33, 33, 37, 0, 0, 0, 37, 37, 37, 37, 37, 29,
+		0, 0, 0, 29, 29, 29, 29, 29, 57, 0, 0, 0, 57, 57, 57, 57,
+		57, 61, 0, 0, 0, 61, 61, 61, 61, 61, 40, 44, 48, 0, 52, 108,
+		112, 116, 0, 120, 40, 44, 48, 0, 52, 40, 44, 48, 0, 52, 40, 44,
+		48, 0, 52, 40, 44, 48, 0, 52, 40, 44, 48, 0, 52, 40, 44, 48,
+		0, 52, 108, 112, 116, 0, 120, 108, 112, 116, 0, 120, 108, 112, 116, 0,
+		120, 108, 112, 116, 0, 120, 108, 112, 116, 0, 120, 108, 112, 116, 13, 120,
+		108, 112, 116, 56, 120, 60, 160, 0, 164, 13,
+	},
+	GotoBase: []int32{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 56, 104, 26, 34,
+		62, 68, 74, 108, 0, 0, 0, 0, 0, 0, 0, 0, 18, 80, 112, 0,
+		0, 0, 0, 0, 0, 0, 0, 116, 42, 50, 86, 92, 98, 120, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 124, 0, 0,
+	},
+	GotoCheck: []int32{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 11, 11, 11, 11, 11, 11, -1,
+		-1, 11, 28, 28, 28, 28, 28, 28, -1, -1, 28, 14, 14, 14, 14, -1,
+		-1, -1, 14, 15, 15, 15, 15, -1, -1, -1, 15, 40, 40, 40, 40, -1,
+		-1, -1, 40, 41, 41, 41, 41, -1, -1, 12, 41, 12, 12, -1, -1, 16,
+		12, 16, 16, -1, -1, 17, 16, 17, 17, -1, -1, 18, 17, 18, 18, -1,
+		-1, 29, 18, 29, 29, -1, -1, 42, 29, 42, 42, -1, -1, 43, 42, 43,
+		43, -1, -1, 44, 43, 44, 44, -1, -1, 13, 44, 13, 13, 19, -1, 19,
+		19, 30, -1, 30, 30, 39, -1, 39, 39, 45, -1, 45, 45, 57, -1, 57,
+		57,
+	},
+	GotoTargets: []int32{
+		1, 2, 3, 4, 5, 6, 7, 8, 9, 20, 21, 22, 23, 24, 25, 0,
+		0, 26, 20, 21, 22, 23, 24, 47, 0, 0, 26, 2, 33, 4, 5, 0,
+		0, 0, 9, 2, 34, 4, 5, 0, 0, 0, 9, 21, 51, 23, 24, 0,
+		0, 0, 26, 21, 52, 23, 24, 0, 0, 2, 26, 4, 5, 0, 0, 2,
+		31, 4, 5, 0, 0, 2, 35, 4, 5, 0, 0, 2, 36, 4, 5, 0,
+		0, 21, 37, 23, 24, 0, 0, 21, 48, 23, 24, 0, 0, 21, 53, 23,
+		24, 0, 0, 21, 54, 23, 24, 0, 0, 32, 55, 4, 5, 38, 0, 4,
+		5, 49, 0, 23, 24, 50, 0, 4, 5, 56, 0, 23, 24, 59, 0, 23,
+		24,
+	},
+	Productions: []lr.Production{
+		{LHS: asts.NodeType("__pgpg_start_1"), LHSID: 9, RHSCount: 1},
+		{LHS: asts.NodeType("Root"), LHSID: 6, RHSCount: 1},
+		{LHS: asts.NodeType("Rvalue"), LHSID: 7, RHSCount: 1},
+		{LHS: asts.NodeType("PrecedenceChainStart"), LHSID: 5, RHSCount: 1},
+		{LHS: asts.NodeType("AddSubTerm"), LHSID: 0, RHSCount: 3},
+		{LHS: asts.NodeType("AddSubTerm"), LHSID: 0, RHSCount: 3},
+		{LHS: asts.NodeType("AddSubTerm"), LHSID: 0, RHSCount: 1},
+		{LHS: asts.NodeType("MulDivTerm"), LHSID: 2, RHSCount: 3},
+		{LHS: asts.NodeType("MulDivTerm"), LHSID: 2, RHSCount: 3},
+		{LHS: asts.NodeType("MulDivTerm"), LHSID: 2, RHSCount: 3},
+		{LHS: asts.NodeType("MulDivTerm"), LHSID: 2, RHSCount: 1},
+		{LHS: asts.NodeType("UnaryTerm"), LHSID: 8, RHSCount: 2},
+		{LHS: asts.NodeType("UnaryTerm"), LHSID: 8, RHSCount: 2},
+		{LHS: asts.NodeType("UnaryTerm"), LHSID: 8, RHSCount: 1},
+		{LHS: asts.NodeType("ExponentiationTerm"), LHSID: 1, RHSCount: 3},
+		{LHS: asts.NodeType("ExponentiationTerm"), LHSID: 1, RHSCount: 4},
+		{LHS: asts.NodeType("ExponentiationTerm"), LHSID: 1, RHSCount: 1},
+		{LHS: asts.NodeType("ParenTerm"), LHSID: 3, RHSCount: 3},
+		{LHS: asts.NodeType("ParenTerm"), LHSID: 3, RHSCount: 1},
+		{LHS: asts.NodeType("PrecedenceChainEnd"), LHSID: 4, RHSCount: 1},
 	},
-	27: {
-		tokens.TokenType("divide"):         {Kind: PEMDASPlainParserActionReduce, Target: 19},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASPlainParserActionReduce, Target: 19},
-		tokens.TokenType("minus"):          {Kind: PEMDASPlainParserActionReduce, Target: 19},
-		tokens.TokenType("modulo"):         {Kind: PEMDASPlainParserActionReduce, Target: 19},
-		tokens.TokenType("plus"):           {Kind: PEMDASPlainParserActionReduce, Target: 19},
-		tokens.TokenType("rparen"):         {Kind: PEMDASPlainParserActionReduce, Target: 19},
-		tokens.TokenType("times"):          {Kind: PEMDASPlainParserActionReduce, Target: 19},
-	},
-	28: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 27},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 28},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 29},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 30},
-	},
-	29: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 27},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 28},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 29},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 30},
-	},
-	30: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 27},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 28},
-	},
-	31: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASPlainParserActionReduce, Target: 12},
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 12},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 12},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 12},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 12},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 12},
-	},
-	32: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASPlainParserActionReduce, Target: 11},
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 11},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 11},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 11},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 11},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 11},
-	},
-	33: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASPlainParserActionReduce, Target: 5},
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionShift, Target: 16},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 5},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionShift, Target: 17},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 5},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionShift, Target: 18},
-	},
-	34: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASPlainParserActionReduce, Target: 4},
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionShift, Target: 16},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 4},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionShift, Target: 17},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 4},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionShift, Target: 18},
-	},
-	35: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASPlainParserActionReduce, Target: 8},
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 8},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 8},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 8},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 8},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 8},
-	},
-	36: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASPlainParserActionReduce, Target: 9},
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 9},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 9},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 9},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 9},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 9},
-	},
-	37: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASPlainParserActionReduce, Target: 7},
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 7},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 7},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 7},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 7},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 7},
-	},
-	38: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASPlainParserActionReduce, Target: 14},
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 14},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 14},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 14},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 14},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 14},
-	},
-	39: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 10},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 11},
-	},
-	40: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 27},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 28},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 29},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 30},
-	},
-	41: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 27},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 28},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 29},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 30},
-	},
-	42: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 27},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 28},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 29},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 30},
-	},
-	43: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 27},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 28},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 29},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 30},
-	},
-	44: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 27},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 28},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 29},
-		tokens.TokenType("plus"):        {Kind: PEMDASPlainParserActionShift, Target: 30},
-	},
-	45: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 27},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 28},
-		tokens.TokenType("minus"):       {Kind: PEMDASPlainParserActionShift, Target: 57},
-	},
-	46: {
-		tokens.TokenTypeEOF:                {Kind: PEMDASPlainParserActionReduce, Target: 17},
-		tokens.TokenType("divide"):         {Kind: PEMDASPlainParserActionReduce, Target: 17},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASPlainParserActionReduce, Target: 17},
-		tokens.TokenType("minus"):          {Kind: PEMDASPlainParserActionReduce, Target: 17},
-		tokens.TokenType("modulo"):         {Kind: PEMDASPlainParserActionReduce, Target: 17},
-		tokens.TokenType("plus"):           {Kind: PEMDASPlainParserActionReduce, Target: 17},
-		tokens.TokenType("times"):          {Kind: PEMDASPlainParserActionReduce, Target: 17},
-	},
-	47: {
-		tokens.TokenType("rparen"): {Kind: PEMDASPlainParserActionShift, Target: 58},
-	},
-	48: {
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 12},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 12},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 12},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 12},
-		tokens.TokenType("rparen"): {Kind: PEMDASPlainParserActionReduce, Target: 12},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 12},
-	},
-	49: {
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 11},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 11},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 11},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 11},
-		tokens.TokenType("rparen"): {Kind: PEMDASPlainParserActionReduce, Target: 11},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 11},
-	},
-	50: {
-		tokens.TokenTypeEOF:        {Kind: PEMDASPlainParserActionReduce, Target: 15},
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 15},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 15},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 15},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 15},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 15},
-	},
-	51: {
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionShift, Target: 42},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 5},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionShift, Target: 43},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 5},
-		tokens.TokenType("rparen"): {Kind: PEMDASPlainParserActionReduce, Target: 5},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionShift, Target: 44},
-	},
-	52: {
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionShift, Target: 42},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 4},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionShift, Target: 43},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 4},
-		tokens.TokenType("rparen"): {Kind: PEMDASPlainParserActionReduce, Target: 4},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionShift, Target: 44},
-	},
-	53: {
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 8},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 8},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 8},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 8},
-		tokens.TokenType("rparen"): {Kind: PEMDASPlainParserActionReduce, Target: 8},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 8},
-	},
-	54: {
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 9},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 9},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 9},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 9},
-		tokens.TokenType("rparen"): {Kind: PEMDASPlainParserActionReduce, Target: 9},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 9},
-	},
-	55: {
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 7},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 7},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 7},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 7},
-		tokens.TokenType("rparen"): {Kind: PEMDASPlainParserActionReduce, Target: 7},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 7},
-	},
-	56: {
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 14},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 14},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 14},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 14},
-		tokens.TokenType("rparen"): {Kind: PEMDASPlainParserActionReduce, Target: 14},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 14},
-	},
-	57: {
-		tokens.TokenType("int_literal"): {Kind: PEMDASPlainParserActionShift, Target: 27},
-		tokens.TokenType("lparen"):      {Kind: PEMDASPlainParserActionShift, Target: 28},
-	},
-	58: {
-		tokens.TokenType("divide"):         {Kind: PEMDASPlainParserActionReduce, Target: 17},
-		tokens.TokenType("exponentiation"): {Kind: PEMDASPlainParserActionReduce, Target: 17},
-		tokens.TokenType("minus"):          {Kind: PEMDASPlainParserActionReduce, Target: 17},
-		tokens.TokenType("modulo"):         {Kind: PEMDASPlainParserActionReduce, Target: 17},
-		tokens.TokenType("plus"):           {Kind: PEMDASPlainParserActionReduce, Target: 17},
-		tokens.TokenType("rparen"):         {Kind: PEMDASPlainParserActionReduce, Target: 17},
-		tokens.TokenType("times"):          {Kind: PEMDASPlainParserActionReduce, Target: 17},
-	},
-	59: {
-		tokens.TokenType("divide"): {Kind: PEMDASPlainParserActionReduce, Target: 15},
-		tokens.TokenType("minus"):  {Kind: PEMDASPlainParserActionReduce, Target: 15},
-		tokens.TokenType("modulo"): {Kind: PEMDASPlainParserActionReduce, Target: 15},
-		tokens.TokenType("plus"):   {Kind: PEMDASPlainParserActionReduce, Target: 15},
-		tokens.TokenType("rparen"): {Kind: PEMDASPlainParserActionReduce, Target: 15},
-		tokens.TokenType("times"):  {Kind: PEMDASPlainParserActionReduce, Target: 15},
-	},
-}
-
-var PEMDASPlainParserGotos = map[int]map[asts.NodeType]int{
-	0: {
-		asts.NodeType("AddSubTerm"):           1,
-		asts.NodeType("ExponentiationTerm"):   2,
-		asts.NodeType("MulDivTerm"):           3,
-		asts.NodeType("ParenTerm"):            4,
-		asts.NodeType("PrecedenceChainEnd"):   5,
-		asts.NodeType("PrecedenceChainStart"): 6,
-		asts.NodeType("Root"):                 7,
-		asts.NodeType("Rvalue"):               8,
-		asts.NodeType("UnaryTerm"):            9,
-	},
-	11: {
-		asts.NodeType("AddSubTerm"):           20,
-		asts.NodeType("ExponentiationTerm"):   21,
-		asts.NodeType("MulDivTerm"):           22,
-		asts.NodeType("ParenTerm"):            23,
-		asts.NodeType("PrecedenceChainEnd"):   24,
-		asts.NodeType("PrecedenceChainStart"): 25,
-		asts.NodeType("UnaryTerm"):            26,
-	},
-	12: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          31,
-	},
-	13: {
-		asts.NodeType("ExponentiationTerm"): 32,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-	},
-	14: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("MulDivTerm"):         33,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          9,
-	},
-	15: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("MulDivTerm"):         34,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          9,
-	},
-	16: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          35,
-	},
-	17: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          36,
-	},
-	18: {
-		asts.NodeType("ExponentiationTerm"): 2,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-		asts.NodeType("UnaryTerm"):          37,
-	},
-	19: {
-		asts.NodeType("ExponentiationTerm"): 38,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-	},
-	28: {
-		asts.NodeType("AddSubTerm"):           20,
-		asts.NodeType("ExponentiationTerm"):   21,
-		asts.NodeType("MulDivTerm"):           22,
-		asts.NodeType("ParenTerm"):            23,
-		asts.NodeType("PrecedenceChainEnd"):   24,
-		asts.NodeType("PrecedenceChainStart"): 47,
-		asts.NodeType("UnaryTerm"):            26,
-	},
-	29: {
-		asts.NodeType("ExponentiationTerm"): 21,
-		asts.NodeType("ParenTerm"):          23,
-		asts.NodeType("PrecedenceChainEnd"): 24,
-		asts.NodeType("UnaryTerm"):          48,
-	},
-	30: {
-		asts.NodeType("ExponentiationTerm"): 49,
-		asts.NodeType("ParenTerm"):          23,
-		asts.NodeType("PrecedenceChainEnd"): 24,
-	},
-	39: {
-		asts.NodeType("ExponentiationTerm"): 50,
-		asts.NodeType("ParenTerm"):          4,
-		asts.NodeType("PrecedenceChainEnd"): 5,
-	},
-	40: {
-		asts.NodeType("ExponentiationTerm"): 21,
-		asts.NodeType("MulDivTerm"):         51,
-		asts.NodeType("ParenTerm"):          23,
-		asts.NodeType("PrecedenceChainEnd"): 24,
-		asts.NodeType("UnaryTerm"):          26,
-	},
-	41: {
-		asts.NodeType("ExponentiationTerm"): 21,
-		asts.NodeType("MulDivTerm"):         52,
-		asts.NodeType("ParenTerm"):          23,
-		asts.NodeType("PrecedenceChainEnd"): 24,
-		asts.NodeType("UnaryTerm"):          26,
-	},
-	42: {
-		asts.NodeType("ExponentiationTerm"): 21,
-		asts.NodeType("ParenTerm"):          23,
-		asts.NodeType("PrecedenceChainEnd"): 24,
-		asts.NodeType("UnaryTerm"):          53,
-	},
-	43: {
-		asts.NodeType("ExponentiationTerm"): 21,
-		asts.NodeType("ParenTerm"):          23,
-		asts.NodeType("PrecedenceChainEnd"): 24,
-		asts.NodeType("UnaryTerm"):          54,
-	},
-	44: {
-		asts.NodeType("ExponentiationTerm"): 21,
-		asts.NodeType("ParenTerm"):          23,
-		asts.NodeType("PrecedenceChainEnd"): 24,
-		asts.NodeType("UnaryTerm"):          55,
-	},
-	45: {
-		asts.NodeType("ExponentiationTerm"): 56,
-		asts.NodeType("ParenTerm"):          23,
-		asts.NodeType("PrecedenceChainEnd"): 24,
-	},
-	57: {
-		asts.NodeType("ExponentiationTerm"): 59,
-		asts.NodeType("ParenTerm"):          23,
-		asts.NodeType("PrecedenceChainEnd"): 24,
-	},
-}
-
-var PEMDASPlainParserProductions = []PEMDASPlainParserProduction{
-	{lhs: asts.NodeType("__pgpg_start_1"), rhsCount: 1},
-	{lhs: asts.NodeType("Root"), rhsCount: 1},
-	{lhs: asts.NodeType("Rvalue"), rhsCount: 1},
-	{lhs: asts.NodeType("PrecedenceChainStart"), rhsCount: 1},
-	{lhs: asts.NodeType("AddSubTerm"), rhsCount: 3},
-	{lhs: asts.NodeType("AddSubTerm"), rhsCount: 3},
-	{lhs: asts.NodeType("AddSubTerm"), rhsCount: 1},
-	{lhs: asts.NodeType("MulDivTerm"), rhsCount: 3},
-	{lhs: asts.NodeType("MulDivTerm"), rhsCount: 3},
-	{lhs: asts.NodeType("MulDivTerm"), rhsCount: 3},
-	{lhs: asts.NodeType("MulDivTerm"), rhsCount: 1},
-	{lhs: asts.NodeType("UnaryTerm"), rhsCount: 2},
-	{lhs: asts.NodeType("UnaryTerm"), rhsCount: 2},
-	{lhs: asts.NodeType("UnaryTerm"), rhsCount: 1},
-	{lhs: asts.NodeType("ExponentiationTerm"), rhsCount: 3},
-	{lhs: asts.NodeType("ExponentiationTerm"), rhsCount: 4},
-	{lhs: asts.NodeType("ExponentiationTerm"), rhsCount: 1},
-	{lhs: asts.NodeType("ParenTerm"), rhsCount: 3},
-	{lhs: asts.NodeType("ParenTerm"), rhsCount: 1},
-	{lhs: asts.NodeType("PrecedenceChainEnd"), rhsCount: 1},
 }
@@ -0,0 +1,79 @@
+package parsers
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/apps/go/generated/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+)
+
+// TestRuntimeTablesFromJSON verifies that the generated code declares the same tables as the JSON
+// files in apps/jsons which it was generated from.
+func TestRuntimeTablesFromJSON(t *testing.T) {
+	for name, generated := range map[string]*lr.Tables{
+		"json":           JSONParserTables,
+		"lisp":           LISPParserTables,
+		"pemdas":         PEMDASParserTables,
+		"pemdas_actions": PEMDASActionsParserTables,
+		"pemdas_flat":    PEMDASFlatParserTables,
+		"pemdas_plain":   PEMDASPlainParserTables,
+		"seng_glr":       SENGGLRParserTables,
+		"statements":     StatementsParserTables,
+	} {
+		t.Run(name, func(t *testing.T) {
+			tables, err := lr.DecodeTables(readJSON(t, name+"-parse.json"))
+			if err != nil {
+				t.Fatalf("lr.DecodeTables: %v", err)
+			}
+			if !reflect.DeepEqual(tables, generated) {
+				t.Error("parser tables from JSON differ from the generated ones")
+			}
+		})
+	}
+	tables, err := dfa.DecodeTables(readJSON(t, "pemdas-lex.json"))
+	if err != nil {
+		t.Fatalf("dfa.DecodeTables: %v", err)
+	}
+	if !reflect.DeepEqual(tables, lexers.PEMDASLexerTables) {
+		t.Error("lexer tables from JSON differ from the generated ones")
+	}
+}
+
+// TestRuntimeParse verifies that tables loaded from JSON, run directly by the lr and dfa packages,
+// parse as the generated code does.
+func TestRuntimeParse(t *testing.T) {
+	lexTables, err := dfa.DecodeTables(readJSON(t, "pemdas-lex.json"))
+	if err != nil {
+		t.Fatalf("dfa.DecodeTables: %v", err)
+	}
+	parseTables, err := lr.DecodeTables(readJSON(t, "pemdas-parse.json"))
+	if err != nil {
+		t.Fatalf("lr.DecodeTables: %v", err)
+	}
+	const input = "1 + 2 * (3 - 4) ** 2"
+	want, err := NewPEMDASParser().Parse(lexers.NewPEMDASLexerFromString(input), "")
+	if err != nil {
+		t.Fatalf("generated parser: %v", err)
+	}
+	got, err := lr.NewParser(parseTables).Parse(dfa.NewLexer(lexTables, strings.NewReader(input)), "")
+	if err != nil {
+		t.Fatalf("runtime parser: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("runtime AST differs from the generated parser's")
+	}
+}
+
+func readJSON(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "jsons", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
@@ -1,569 +1,107 @@
 package parsers
 
 import (
-	"fmt"
-	"os"
-	"strings"
-
 	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
 	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
 	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
 )
 
+// SENGParser parses with SENGParserTables. Parsing, error handling, and the parser's
+// options are those of lr.Parser.
 type SENGParser struct {
-	Trace            *SENGParserTraceHooks
-	stashedLookahead *tokens.Token
-}
-
-type SENGParserTraceHooks struct {
-	OnToken  func(tok *tokens.Token)
-	OnAction func(state int, action SENGParserAction, lookahead *tokens.Token)
-	OnStack  func(stateStack []int, nodeStack []*asts.ASTNode)
-}
-
-func NewSENGParser() *SENGParser { return &SENGParser{} }
-
-// noASTSentinel is used as a placeholder on the node stack when astMode == "noast".
-var SENGParserNoASTSentinel = &asts.ASTNode{}
-
-func (parser *SENGParser) Parse(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {
-	if lexer == nil {
-		return nil, fmt.Errorf("parser: nil lexer")
-	}
-	stateStack := []int{0}
-	nodeStack := []*asts.ASTNode{}
-	lookahead := lexer.Scan()
-	if parser.Trace != nil && parser.Trace.OnToken != nil {
-		parser.Trace.OnToken(lookahead)
-	}
-	for {
-		if lookahead == nil {
-			return nil, fmt.Errorf("parser: lexer returned nil token")
-		}
-		if lookahead.Type == tokens.TokenTypeError {
-			return nil, fmt.Errorf("lexer error: %s", string(lookahead.Lexeme))
-		}
-		state := stateStack[len(stateStack)-1]
-		action, ok := SENGParserActions[state][lookahead.Type]
-		if !ok {
-			return nil, fmt.Errorf("parse error: unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme))
-		}
-		if parser.Trace != nil && parser.Trace.OnAction != nil {
-			parser.Trace.OnAction(state, action, lookahead)
-		}
-		switch action.Kind {
-		case SENGParserActionShift:
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, SENGParserNoASTSentinel)
-			} else {
-				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
-			}
-			stateStack = append(stateStack, action.Target)
-			lookahead = lexer.Scan()
-			if parser.Trace != nil && parser.Trace.OnToken != nil {
-				parser.Trace.OnToken(lookahead)
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case SENGParserActionReduce:
-			prod := SENGParserProductions[action.Target]
-			rhsNodes := make([]*asts.ASTNode, prod.rhsCount)
-			for i := prod.rhsCount - 1; i >= 0; i-- {
-				stateStack = stateStack[:len(stateStack)-1]
-				rhsNodes[i] = nodeStack[len(nodeStack)-1]
-				nodeStack = nodeStack[:len(nodeStack)-1]
-			}
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, SENGParserNoASTSentinel)
-			} else {
-				if prod.rhsCount == 0 {
-					rhsNodes = []*asts.ASTNode{}
-				}
-				node := asts.NewASTNode(nil, prod.lhs, rhsNodes)
-				nodeStack = append(nodeStack, node)
-			}
-			state = stateStack[len(stateStack)-1]
-			nextState, ok := SENGParserGotos[state][prod.lhs]
-			if !ok {
-				return nil, fmt.Errorf("parse error: missing goto for %s", prod.lhs)
-			}
-			stateStack = append(stateStack, nextState)
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case SENGParserActionAccept:
-			if len(nodeStack) != 1 {
-				return nil, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			if astMode == "noast" {
-				return nil, nil
-			}
-			return asts.NewAST(nodeStack[0]), nil
-		case SENGParserActionAcceptAndYield:
-			return nil, fmt.Errorf("parse error: multiple objects; use ParseOne for multi-object input")
-		default:
-			return nil, fmt.Errorf("parse error: no action")
-		}
-	}
-}
-
-// ParseOne parses one record from the lexer. It is for multi-object input: call in a loop until done.
-// Returns (ast, true, nil) on EOF after a record, (ast, false, nil) when more input follows, or (nil, false, err) on error.
-func (parser *SENGParser) ParseOne(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, bool, error) {
-	if lexer == nil {
-		return nil, false, fmt.Errorf("parser: nil lexer")
-	}
-	stateStack := []int{0}
-	nodeStack := []*asts.ASTNode{}
-	var lookahead *tokens.Token
-	if parser.stashedLookahead != nil {
-		lookahead = parser.stashedLookahead
-		parser.stashedLookahead = nil
-	} else {
-		lookahead = lexer.Scan()
-	}
-	if parser.Trace != nil && parser.Trace.OnToken != nil {
-		parser.Trace.OnToken(lookahead)
-	}
-	for {
-		if lookahead == nil {
-			return nil, false, fmt.Errorf("parser: lexer returned nil token")
-		}
-		if lookahead.Type == tokens.TokenTypeError {
-			return nil, false, fmt.Errorf("lexer error: %s", string(lookahead.Lexeme))
-		}
-		state := stateStack[len(stateStack)-1]
-		action, ok := SENGParserActions[state][lookahead.Type]
-		if !ok {
-			return nil, false, fmt.Errorf("parse error: unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme))
-		}
-		if parser.Trace != nil && parser.Trace.OnAction != nil {
-			parser.Trace.OnAction(state, action, lookahead)
-		}
-		switch action.Kind {
-		case SENGParserActionShift:
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, SENGParserNoASTSentinel)
-			} else {
-				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
-			}
-			stateStack = append(stateStack, action.Target)
-			lookahead = lexer.Scan()
-			if parser.Trace != nil && parser.Trace.OnToken != nil {
-				parser.Trace.OnToken(lookahead)
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case SENGParserActionReduce:
-			prod := SENGParserProductions[action.Target]
-			rhsNodes := make([]*asts.ASTNode, prod.rhsCount)
-			for i := prod.rhsCount - 1; i >= 0; i-- {
-				stateStack = stateStack[:len(stateStack)-1]
-				rhsNodes[i] = nodeStack[len(nodeStack)-1]
-				nodeStack = nodeStack[:len(nodeStack)-1]
-			}
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, SENGParserNoASTSentinel)
-			} else {
-				if prod.rhsCount == 0 {
-					rhsNodes = []*asts.ASTNode{}
-				}
-				node := asts.NewASTNode(nil, prod.lhs, rhsNodes)
-				nodeStack = append(nodeStack, node)
-			}
-			state = stateStack[len(stateStack)-1]
-			nextState, ok := SENGParserGotos[state][prod.lhs]
-			if !ok {
-				return nil, false, fmt.Errorf("parse error: missing goto for %s", prod.lhs)
-			}
-			stateStack = append(stateStack, nextState)
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case SENGParserActionAccept:
-			if len(nodeStack) != 1 {
-				return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			if astMode == "noast" {
-				return nil, true, nil
-			}
-			return asts.NewAST(nodeStack[0]), true, nil
-		case SENGParserActionAcceptAndYield:
-			if len(nodeStack) != 1 {
-				return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			parser.stashedLookahead = lookahead
-			if astMode == "noast" {
-				return nil, false, nil
-			}
-			return asts.NewAST(nodeStack[0]), false, nil
-		default:
-			return nil, false, fmt.Errorf("parse error: no action")
-		}
-	}
-}
-
-// AttachCLITrace installs tracing hooks for CLI debugging.
-func (parser *SENGParser) AttachCLITrace(traceTokens bool, traceStates bool, traceStack bool) {
-	if !traceTokens && !traceStates && !traceStack {
-		return
-	}
-	parser.Trace = &SENGParserTraceHooks{
-		OnToken: func(tok *tokens.Token) {
-			if !traceTokens {
-				return
-			}
-			fmt.Fprintln(os.Stderr, formatSENGParserToken(tok))
-		},
-		OnAction: func(state int, action SENGParserAction, lookahead *tokens.Token) {
-			if !traceStates {
-				return
-			}
-			fmt.Fprintf(os.Stderr, "STATE %d %s on %s(%q)\n",
-				state, formatSENGParserAction(action), tokenTypeNameSENGParser(lookahead), tokenLexemeSENGParser(lookahead))
-		},
-		OnStack: func(stateStack []int, nodeStack []*asts.ASTNode) {
-			if !traceStack {
-				return
-			}
-			fmt.Fprintf(os.Stderr, "STACK states=%s nodes=%s\n",
-				formatSENGParserIntStack(stateStack), formatSENGParserNodeStack(nodeStack))
-		},
-	}
-}
-
-type SENGParserActionKind int
-
-const (
-	SENGParserActionShift SENGParserActionKind = iota
-	SENGParserActionReduce
-	SENGParserActionAccept
-	SENGParserActionAcceptAndYield
-)
-
-type SENGParserAction struct {
-	Kind   SENGParserActionKind
-	Target int
-}
-
-func formatSENGParserToken(tok *tokens.Token) string {
-	if tok == nil {
-		return "TOK <nil>"
-	}
-	return fmt.Sprintf("TOK type=%s lexeme=%q line=%d col=%d",
-		tok.Type, string(tok.Lexeme), tok.Location.LineNumber, tok.Location.ColumnNumber)
-}
-
-func tokenTypeNameSENGParser(tok *tokens.Token) string {
-	if tok == nil {
-		return "<nil>"
-	}
-	return string(tok.Type)
-}
-
-func tokenLexemeSENGParser(tok *tokens.Token) string {
-	if tok == nil {
-		return ""
-	}
-	return string(tok.Lexeme)
-}
-
-func formatSENGParserIntStack(stack []int) string {
-	parts := make([]string, len(stack))
-	for i, v := range stack {
-		parts[i] = fmt.Sprintf("%d", v)
-	}
-	return "[" + strings.Join(parts, " ") + "]"
-}
-
-func formatSENGParserNodeStack(stack []*asts.ASTNode) string {
-	parts := make([]string, len(stack))
-	for i, node := range stack {
-		if node == nil {
-			parts[i] = "<nil>"
-			continue
-		}
-		parts[i] = string(node.Type)
-	}
-	return "[" + strings.Join(parts, " ") + "]"
-}
-
-func formatSENGParserAction(action SENGParserAction) string {
-	switch action.Kind {
-	case SENGParserActionShift:
-		return fmt.Sprintf("shift(%d)", action.Target)
-	case SENGParserActionReduce:
-		return fmt.Sprintf("reduce(%d)", action.Target)
-	case SENGParserActionAccept:
-		return "accept"
-	case SENGParserActionAcceptAndYield:
-		return "accept_and_yield"
-	default:
-		return "unknown"
-	}
-}
-
-type SENGParserProduction struct {
-	lhs      asts.NodeType
-	rhsCount int
-}
-
-var SENGParserActions = map[int]map[tokens.TokenType]SENGParserAction{
-	0: {
-		tokens.TokenType("adjective"):                  {Kind: SENGParserActionShift, Target: 6},
-		tokens.TokenType("adverb"):                     {Kind: SENGParserActionShift, Target: 7},
-		tokens.TokenType("article"):                    {Kind: SENGParserActionShift, Target: 8},
-		tokens.TokenType("intransitiveImperativeVerb"): {Kind: SENGParserActionShift, Target: 9},
-		tokens.TokenType("noun"):                       {Kind: SENGParserActionShift, Target: 10},
-		tokens.TokenType("transitiveImperativeVerb"):   {Kind: SENGParserActionShift, Target: 11},
-	},
-	1: {
-		tokens.TokenTypeEOF: {Kind: SENGParserActionReduce, Target: 4},
-	},
-	2: {
-		tokens.TokenType("adverb"):           {Kind: SENGParserActionShift, Target: 14},
-		tokens.TokenType("intransitiveVerb"): {Kind: SENGParserActionShift, Target: 15},
-		tokens.TokenType("transitiveVerb"):   {Kind: SENGParserActionShift, Target: 16},
-	},
-	3: {
-		tokens.TokenType("adjective"):        {Kind: SENGParserActionReduce, Target: 5},
-		tokens.TokenType("adverb"):           {Kind: SENGParserActionReduce, Target: 5},
-		tokens.TokenType("intransitiveVerb"): {Kind: SENGParserActionReduce, Target: 5},
-		tokens.TokenType("noun"):             {Kind: SENGParserActionReduce, Target: 5},
-		tokens.TokenType("transitiveVerb"):   {Kind: SENGParserActionReduce, Target: 5},
-	},
-	4: {
-		tokens.TokenTypeEOF:                            {Kind: SENGParserActionAccept},
-		tokens.TokenType("adjective"):                  {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("adverb"):                     {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("article"):                    {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("intransitiveImperativeVerb"): {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("intransitiveVerb"):           {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("noun"):                       {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("preposition"):                {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("transitiveImperativeVerb"):   {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("transitiveVerb"):             {Kind: SENGParserActionAcceptAndYield},
-	},
-	5: {
-		tokens.TokenType("adjective"): {Kind: SENGParserActionShift, Target: 19},
-		tokens.TokenType("article"):   {Kind: SENGParserActionShift, Target: 20},
-		tokens.TokenType("noun"):      {Kind: SENGParserActionShift, Target: 21},
-	},
-	6: {
-		tokens.TokenType("adjective"): {Kind: SENGParserActionShift, Target: 6},
-		tokens.TokenType("noun"):      {Kind: SENGParserActionShift, Target: 10},
-	},
-	7: {
-		tokens.TokenType("adverb"):                     {Kind: SENGParserActionShift, Target: 7},
-		tokens.TokenType("intransitiveImperativeVerb"): {Kind: SENGParserActionShift, Target: 9},
-		tokens.TokenType("transitiveImperativeVerb"):   {Kind: SENGParserActionShift, Target: 11},
-	},
-	8: {
-		tokens.TokenType("adjective"): {Kind: SENGParserActionShift, Target: 6},
-		tokens.TokenType("noun"):      {Kind: SENGParserActionShift, Target: 10},
-	},
-	9: {
-		tokens.TokenTypeEOF:             {Kind: SENGParserActionReduce, Target: 16},
-		tokens.TokenType("preposition"): {Kind: SENGParserActionShift, Target: 26},
+	lr.Parser
+}
+
+func NewSENGParser() *SENGParser {
+	return &SENGParser{Parser: lr.Parser{Tables: SENGParserTables}}
+}
+
+// ParseWithSENGParser parses the input, computing values of type T with the handler in place of
+// building an AST, and returns the start symbol's value. Syntax errors may be repaired, as with
+// RepairErrors, but error productions are not used.
+func ParseWithSENGParser[T any](parser *SENGParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {
+	return lr.ParseWith(&parser.Parser, lexer, handler)
+}
+
+// SENGParserTables are the parser's LR tables, run by the lr package. The action and goto tables
+// are indexed by the IDs of Terminals and Nonterminals, and row-displaced, as lr.Tables documents.
+var SENGParserTables = &lr.Tables{
+	StartSymbol: "Root",
+	Terminals: tokens.NewKinds(
+		"EOF",
+		"adjective",
+		"adverb",
+		"article",
+		"intransitiveImperativeVerb",
+		"intransitiveVerb",
+		"noun",
+		"preposition",
+		"transitiveImperativeVerb",
+		"transitiveVerb",
+	),
+	Nonterminals: []asts.NodeType{
+		"IntransitiveImperativeVerbPhrase",
+		"IntransitiveVerbPhrase",
+		"NounPhrase",
+		"NounPhraseWithoutArticle",
+		"Root",
+		"TransitiveImperativeVerbPhrase",
+		"TransitiveVerbPhrase",
+		"__pgpg_start_1",
+	},
+	ActionBase: []int32{
+		-2, 3, 3, 5, 9, 10, 23, 13, 37, 53, 17, 17, 47, 24, 26, 57,
+		31, 62, 65, 57, 60, 67, 31, 68, 38, 40, 45, 69, 70, 49, 53, 71,
+		72, 73, 74,
+	},
+	ActionCheck: []int32{
+		0, 0, 0, 0, 1, 0, 2, 0, 3, 2, 4, 3, 5, 2, 5, 3,
+		7, 5, 7, 11, 10, 11, 7, 10, 11, 6, 13, 10, 13, 14, 6, 13,
+		14, 16, 22, 16, 14, 22, 16, 8, 24, 22, 24, 25, 8, 24, 25, 26,
+		12, 26, 25, 29, 26, 29, 9, 30, 29, 30, 15, 19, 30, 9, 20, 17,
+		19, 15, 18, 20, 21, 23, 27, 28, 31, 32, 33, 34,
+	},
+	ActionEntries: []int32{
+		24, 28, 32, 36, 17, 40, 56, 44, 21, 60, 2, 21, 76, 64, 80, 21,
+		28, 84, 36, 57, 29, 57, 44, 29, 57, 24, 76, 29, 80, 56, 40, 84,
+		60, 37, 33, 37, 64, 33, 37, 24, 61, 33, 61, 25, 40, 61, 25, 76,
+		9, 80, 25, 41, 84, 41, 65, 76, 41, 80, 45, 76, 84, 104, 76, 13,
+		84, 120, 21, 84, 29, 69, 5, 49, 33, 25, 73, 53,
+	},
+	GotoBase: []int32{
+		0, 0, 0, 0, 0, 5, 10, 9, 17, 0, 0, 0, 0, 8, 11, 0,
+		0, 0, 0, 18, 19, 0, 0, 0, 0, 0, 13, 0, 0, 0, 16, 0,
+		0, 0, 0,
+	},
+	GotoCheck: []int32{
+		0, 2, 0, 0, 0, 0, 2, 5, 5, 7, 13, 13, 14, 6, 7, 26,
+		26, 14, 30, 30, 8, 19, 20,
+	},
+	GotoTargets: []int32{
+		1, 12, 2, 3, 4, 5, 13, 17, 18, 23, 27, 18, 28, 22, 24, 33,
+		18, 29, 34, 18, 25, 31, 32,
+	},
+	Productions: []lr.Production{
+		{LHS: asts.NodeType("__pgpg_start_1"), LHSID: 7, RHSCount: 1},
+		{LHS: asts.NodeType("Root"), LHSID: 4, RHSCount: 3},
+		{LHS: asts.NodeType("Root"), LHSID: 4, RHSCount: 2},
+		{LHS: asts.NodeType("Root"), LHSID: 4, RHSCount: 2},
+		{LHS: asts.NodeType("Root"), LHSID: 4, RHSCount: 1},
+		{LHS: asts.NodeType("NounPhrase"), LHSID: 2, RHSCount: 1},
+		{LHS: asts.NodeType("NounPhrase"), LHSID: 2, RHSCount: 2},
+		{LHS: asts.NodeType("NounPhraseWithoutArticle"), LHSID: 3, RHSCount: 1},
+		{LHS: asts.NodeType("NounPhraseWithoutArticle"), LHSID: 3, RHSCount: 2},
+		{LHS: asts.NodeType("TransitiveVerbPhrase"), LHSID: 6, RHSCount: 1},
+		{LHS: asts.NodeType("TransitiveVerbPhrase"), LHSID: 6, RHSCount: 2},
+		{LHS: asts.NodeType("IntransitiveVerbPhrase"), LHSID: 1, RHSCount: 1},
+		{LHS: asts.NodeType("IntransitiveVerbPhrase"), LHSID: 1, RHSCount: 2},
+		{LHS: asts.NodeType("IntransitiveVerbPhrase"), LHSID: 1, RHSCount: 3},
+		{LHS: asts.NodeType("TransitiveImperativeVerbPhrase"), LHSID: 5, RHSCount: 1},
+		{LHS: asts.NodeType("TransitiveImperativeVerbPhrase"), LHSID: 5, RHSCount: 2},
+		{LHS: asts.NodeType("IntransitiveImperativeVerbPhrase"), LHSID: 0, RHSCount: 1},
+		{LHS: asts.NodeType("IntransitiveImperativeVerbPhrase"), LHSID: 0, RHSCount: 2},
+		{LHS: asts.NodeType("IntransitiveImperativeVerbPhrase"), LHSID: 0, RHSCount: 3},
 	},
-	10: {
-		tokens.TokenType("adverb"):           {Kind: SENGParserActionReduce, Target: 7},
-		tokens.TokenType("intransitiveVerb"): {Kind: SENGParserActionReduce, Target: 7},
-		tokens.TokenType("noun"):             {Kind: SENGParserActionReduce, Target: 7},
-		tokens.TokenType("transitiveVerb"):   {Kind: SENGParserActionReduce, Target: 7},
-	},
-	11: {
-		tokens.TokenType("adjective"): {Kind: SENGParserActionReduce, Target: 14},
-		tokens.TokenType("article"):   {Kind: SENGParserActionReduce, Target: 14},
-		tokens.TokenType("noun"):      {Kind: SENGParserActionReduce, Target: 14},
-	},
-	12: {
-		tokens.TokenTypeEOF: {Kind: SENGParserActionReduce, Target: 2},
-	},
-	13: {
-		tokens.TokenType("adjective"): {Kind: SENGParserActionShift, Target: 19},
-		tokens.TokenType("article"):   {Kind: SENGParserActionShift, Target: 20},
-		tokens.TokenType("noun"):      {Kind: SENGParserActionShift, Target: 21},
-	},
-	14: {
-		tokens.TokenType("adverb"):           {Kind: SENGParserActionShift, Target: 14},
-		tokens.TokenType("intransitiveVerb"): {Kind: SENGParserActionShift, Target: 15},
-		tokens.TokenType("transitiveVerb"):   {Kind: SENGParserActionShift, Target: 16},
-	},
-	15: {
-		tokens.TokenTypeEOF:             {Kind: SENGParserActionReduce, Target: 11},
-		tokens.TokenType("preposition"): {Kind: SENGParserActionShift, Target: 30},
-	},
-	16: {
-		tokens.TokenType("adjective"): {Kind: SENGParserActionReduce, Target: 9},
-		tokens.TokenType("article"):   {Kind: SENGParserActionReduce, Target: 9},
-		tokens.TokenType("noun"):      {Kind: SENGParserActionReduce, Target: 9},
-	},
-	17: {
-		tokens.TokenTypeEOF: {Kind: SENGParserActionReduce, Target: 3},
-	},
-	18: {
-		tokens.TokenTypeEOF:           {Kind: SENGParserActionReduce, Target: 5},
-		tokens.TokenType("adjective"): {Kind: SENGParserActionReduce, Target: 5},
-		tokens.TokenType("noun"):      {Kind: SENGParserActionReduce, Target: 5},
-	},
-	19: {
-		tokens.TokenType("adjective"): {Kind: SENGParserActionShift, Target: 19},
-		tokens.TokenType("noun"):      {Kind: SENGParserActionShift, Target: 21},
-	},
-	20: {
-		tokens.TokenType("adjective"): {Kind: SENGParserActionShift, Target: 19},
-		tokens.TokenType("noun"):      {Kind: SENGParserActionShift, Target: 21},
-	},
-	21: {
-		tokens.TokenTypeEOF:      {Kind: SENGParserActionReduce, Target: 7},
-		tokens.TokenType("noun"): {Kind: SENGParserActionReduce, Target: 7},
-	},
-	22: {
-		tokens.TokenType("adjective"):        {Kind: SENGParserActionReduce, Target: 8},
-		tokens.TokenType("adverb"):           {Kind: SENGParserActionReduce, Target: 8},
-		tokens.TokenType("intransitiveVerb"): {Kind: SENGParserActionReduce, Target: 8},
-		tokens.TokenType("transitiveVerb"):   {Kind: SENGParserActionReduce, Target: 8},
-	},
-	23: {
-		tokens.TokenTypeEOF: {Kind: SENGParserActionReduce, Target: 17},
-	},
-	24: {
-		tokens.TokenType("adjective"): {Kind: SENGParserActionReduce, Target: 15},
-		tokens.TokenType("article"):   {Kind: SENGParserActionReduce, Target: 15},
-		tokens.TokenType("noun"):      {Kind: SENGParserActionReduce, Target: 15},
-	},
-	25: {
-		tokens.TokenType("adverb"):           {Kind: SENGParserActionReduce, Target: 6},
-		tokens.TokenType("article"):          {Kind: SENGParserActionReduce, Target: 6},
-		tokens.TokenType("intransitiveVerb"): {Kind: SENGParserActionReduce, Target: 6},
-		tokens.TokenType("transitiveVerb"):   {Kind: SENGParserActionReduce, Target: 6},
-	},
-	26: {
-		tokens.TokenType("adjective"): {Kind: SENGParserActionShift, Target: 19},
-		tokens.TokenType("article"):   {Kind: SENGParserActionShift, Target: 20},
-		tokens.TokenType("noun"):      {Kind: SENGParserActionShift, Target: 21},
-	},
-	27: {
-		tokens.TokenTypeEOF:                            {Kind: SENGParserActionReduce, Target: 1},
-		tokens.TokenType("adjective"):                  {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("adverb"):                     {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("article"):                    {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("intransitiveImperativeVerb"): {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("intransitiveVerb"):           {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("noun"):                       {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("preposition"):                {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("transitiveImperativeVerb"):   {Kind: SENGParserActionAcceptAndYield},
-		tokens.TokenType("transitiveVerb"):             {Kind: SENGParserActionAcceptAndYield},
-	},
-	28: {
-		tokens.TokenTypeEOF: {Kind: SENGParserActionReduce, Target: 12},
-	},
-	29: {
-		tokens.TokenType("adjective"): {Kind: SENGParserActionReduce, Target: 10},
-		tokens.TokenType("article"):   {Kind: SENGParserActionReduce, Target: 10},
-		tokens.TokenType("noun"):      {Kind: SENGParserActionReduce, Target: 10},
-	},
-	30: {
-		tokens.TokenType("adjective"): {Kind: SENGParserActionShift, Target: 19},
-		tokens.TokenType("article"):   {Kind: SENGParserActionShift, Target: 20},
-		tokens.TokenType("noun"):      {Kind: SENGParserActionShift, Target: 21},
-	},
-	31: {
-		tokens.TokenTypeEOF:           {Kind: SENGParserActionReduce, Target: 8},
-		tokens.TokenType("adjective"): {Kind: SENGParserActionReduce, Target: 8},
-	},
-	32: {
-		tokens.TokenTypeEOF:         {Kind: SENGParserActionReduce, Target: 6},
-		tokens.TokenType("article"): {Kind: SENGParserActionReduce, Target: 6},
-	},
-	33: {
-		tokens.TokenTypeEOF: {Kind: SENGParserActionReduce, Target: 18},
-	},
-	34: {
-		tokens.TokenTypeEOF: {Kind: SENGParserActionReduce, Target: 13},
-	},
-}
-
-var SENGParserGotos = map[int]map[asts.NodeType]int{
-	0: {
-		asts.NodeType("IntransitiveImperativeVerbPhrase"): 1,
-		asts.NodeType("NounPhrase"):                       2,
-		asts.NodeType("NounPhraseWithoutArticle"):         3,
-		asts.NodeType("Root"):                             4,
-		asts.NodeType("TransitiveImperativeVerbPhrase"):   5,
-	},
-	2: {
-		asts.NodeType("IntransitiveVerbPhrase"): 12,
-		asts.NodeType("TransitiveVerbPhrase"):   13,
-	},
-	5: {
-		asts.NodeType("NounPhrase"):               17,
-		asts.NodeType("NounPhraseWithoutArticle"): 18,
-	},
-	6: {
-		asts.NodeType("NounPhraseWithoutArticle"): 22,
-	},
-	7: {
-		asts.NodeType("IntransitiveImperativeVerbPhrase"): 23,
-		asts.NodeType("TransitiveImperativeVerbPhrase"):   24,
-	},
-	8: {
-		asts.NodeType("NounPhraseWithoutArticle"): 25,
-	},
-	13: {
-		asts.NodeType("NounPhrase"):               27,
-		asts.NodeType("NounPhraseWithoutArticle"): 18,
-	},
-	14: {
-		asts.NodeType("IntransitiveVerbPhrase"): 28,
-		asts.NodeType("TransitiveVerbPhrase"):   29,
-	},
-	19: {
-		asts.NodeType("NounPhraseWithoutArticle"): 31,
-	},
-	20: {
-		asts.NodeType("NounPhraseWithoutArticle"): 32,
-	},
-	26: {
-		asts.NodeType("NounPhrase"):               33,
-		asts.NodeType("NounPhraseWithoutArticle"): 18,
-	},
-	30: {
-		asts.NodeType("NounPhrase"):               34,
-		asts.NodeType("NounPhraseWithoutArticle"): 18,
-	},
-}
-
-var SENGParserProductions = []SENGParserProduction{
-	{lhs: asts.NodeType("__pgpg_start_1"), rhsCount: 1},
-	{lhs: asts.NodeType("Root"), rhsCount: 3},
-	{lhs: asts.NodeType("Root"), rhsCount: 2},
-	{lhs: asts.NodeType("Root"), rhsCount: 2},
-	{lhs: asts.NodeType("Root"), rhsCount: 1},
-	{lhs: asts.NodeType("NounPhrase"), rhsCount: 1},
-	{lhs: asts.NodeType("NounPhrase"), rhsCount: 2},
-	{lhs: asts.NodeType("NounPhraseWithoutArticle"), rhsCount: 1},
-	{lhs: asts.NodeType("NounPhraseWithoutArticle"), rhsCount: 2},
-	{lhs: asts.NodeType("TransitiveVerbPhrase"), rhsCount: 1},
-	{lhs: asts.NodeType("TransitiveVerbPhrase"), rhsCount: 2},
-	{lhs: asts.NodeType("IntransitiveVerbPhrase"), rhsCount: 1},
-	{lhs: asts.NodeType("IntransitiveVerbPhrase"), rhsCount: 2},
-	{lhs: asts.NodeType("IntransitiveVerbPhrase"), rhsCount: 3},
-	{lhs: asts.NodeType("TransitiveImperativeVerbPhrase"), rhsCount: 1},
-	{lhs: asts.NodeType("TransitiveImperativeVerbPhrase"), rhsCount: 2},
-	{lhs: asts.NodeType("IntransitiveImperativeVerbPhrase"), rhsCount: 1},
-	{lhs: asts.NodeType("IntransitiveImperativeVerbPhrase"), rhsCount: 2},
-	{lhs: asts.NodeType("IntransitiveImperativeVerbPhrase"), rhsCount: 3},
 }
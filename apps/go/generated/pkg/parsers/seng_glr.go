@@ -0,0 +1,129 @@
+package parsers
+
+import (
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+// SENGGLRParser parses with SENGGLRParserTables. Parsing, error handling, and the parser's
+// options are those of lr.Parser.
+type SENGGLRParser struct {
+	lr.Parser
+}
+
+func NewSENGGLRParser() *SENGGLRParser {
+	return &SENGGLRParser{Parser: lr.Parser{Tables: SENGGLRParserTables}}
+}
+
+// ParseWithSENGGLRParser parses the input, computing values of type T with the handler in place of
+// building an AST, and returns the start symbol's value. Syntax errors may be repaired, as with
+// RepairErrors, but error productions are not used.
+func ParseWithSENGGLRParser[T any](parser *SENGGLRParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {
+	return lr.ParseWith(&parser.Parser, lexer, handler)
+}
+
+// SENGGLRParserTables are the parser's LR tables, run by the lr package. The action and goto tables
+// are indexed by the IDs of Terminals and Nonterminals, and row-displaced, as lr.Tables documents.
+var SENGGLRParserTables = &lr.Tables{
+	StartSymbol: "Root",
+	Terminals: tokens.NewKinds(
+		"EOF",
+		"adjective",
+		"adverb",
+		"article",
+		"intransitiveImperativeVerb",
+		"intransitiveVerb",
+		"noun",
+		"preposition",
+		"transitiveImperativeVerb",
+		"transitiveVerb",
+	),
+	Nonterminals: []asts.NodeType{
+		"IntransitiveImperativeVerbPhrase",
+		"IntransitiveVerbPhrase",
+		"NounPhrase",
+		"NounPhraseWithoutArticle",
+		"Root",
+		"TransitiveImperativeVerbPhrase",
+		"TransitiveVerbPhrase",
+		"__pgpg_start_1",
+	},
+	ActionBase: []int32{
+		-2, 7, 9, 23, 21, 2, 101, 79, 105, 13, 37, 9, 35, 16, 51, 27,
+		23, 41, 48, 108, 109, 49, 87, 65, 55, 30, 87, 94, 62, 98, 63, 37,
+		102, 69, 76, 44, 51, 111, 112, 58, 77, 65, 84, 72, 79,
+	},
+	ActionCheck: []int32{
+		0, 0, 0, 0, 5, 0, 5, 0, 1, 5, 5, 11, 2, 11, 9, 2,
+		11, 11, 13, 2, 13, 9, 4, 13, 13, 16, 3, 16, 15, 3, 16, 16,
+		25, 3, 25, 15, 12, 25, 25, 31, 10, 31, 17, 10, 31, 31, 35, 10,
+		35, 18, 21, 35, 35, 36, 14, 36, 24, 14, 36, 36, 39, 14, 39, 28,
+		30, 39, 39, 41, 23, 41, 33, 23, 41, 41, 43, 23, 43, 34, 40, 43,
+		43, 44, 7, 44, 7, 42, 44, 44, 7, 22, 26, 22, -1, 26, 22, -1,
+		27, 26, 27, -1, 29, 27, 29, 6, 32, 29, 32, 8, 6, 32, 19, 20,
+		8, 37, 38, 19, 20, -1, 37, 38,
+	},
+	ActionEntries: []int32{
+		24, 28, 32, 36, 76, 40, 80, 44, 17, 84, 88, 61, 56, 61, 73, 60,
+		61, 61, 76, 64, 80, 108, 2, 84, 116, 37, 21, 37, 49, 21, 37, 37,
+		65, 21, 65, 128, 9, 65, 88, 41, 29, 41, 13, 29, 41, 116, 69, 29,
+		69, 21, 29, 69, 69, 21, 56, 21, 77, 60, 21, 21, 29, 64, 29, 5,
+		53, 29, 29, 45, 33, 45, 33, 33, 45, 45, 33, 33, 33, 25, 81, 33,
+		33, 25, 28, 25, 36, 57, 25, 25, 44, 148, 25, 152, 0, 25, 156, 0,
+		76, 25, 80, 0, 148, 84, 152, 24, 76, 156, 80, 24, 40, 84, 76, 76,
+		40, 148, 148, 84, 84, 0, 156, 156,
+	},
+	GotoBase: []int32{
+		0, 0, 0, 0, 0, 5, 10, 9, 21, 0, 0, 0, 0, 8, 11, 0,
+		0, 0, 0, 22, 23, 0, 13, 0, 0, 0, 0, 16, 0, 18, 0, 0,
+		20, 0, 0, 0, 0, 24, 25, 0, 0, 0, 0, 0, 0,
+	},
+	GotoCheck: []int32{
+		0, 2, 0, 0, 0, 0, 2, 5, 5, 7, 13, 13, 14, 6, 7, 22,
+		22, 14, 27, 27, 29, 29, 32, 32, 8, 19, 20, 37, 38,
+	},
+	GotoTargets: []int32{
+		1, 12, 2, 3, 4, 5, 13, 17, 18, 24, 28, 18, 30, 23, 25, 35,
+		36, 31, 40, 18, 41, 36, 42, 18, 26, 33, 34, 43, 44,
+	},
+	Productions: []lr.Production{
+		{LHS: asts.NodeType("__pgpg_start_1"), LHSID: 7, RHSCount: 1},
+		{LHS: asts.NodeType("Root"), LHSID: 4, RHSCount: 3},
+		{LHS: asts.NodeType("Root"), LHSID: 4, RHSCount: 2},
+		{LHS: asts.NodeType("Root"), LHSID: 4, RHSCount: 2},
+		{LHS: asts.NodeType("Root"), LHSID: 4, RHSCount: 1},
+		{LHS: asts.NodeType("NounPhrase"), LHSID: 2, RHSCount: 1},
+		{LHS: asts.NodeType("NounPhrase"), LHSID: 2, RHSCount: 2},
+		{LHS: asts.NodeType("NounPhraseWithoutArticle"), LHSID: 3, RHSCount: 1},
+		{LHS: asts.NodeType("NounPhraseWithoutArticle"), LHSID: 3, RHSCount: 2},
+		{LHS: asts.NodeType("TransitiveVerbPhrase"), LHSID: 6, RHSCount: 1},
+		{LHS: asts.NodeType("TransitiveVerbPhrase"), LHSID: 6, RHSCount: 2},
+		{LHS: asts.NodeType("TransitiveVerbPhrase"), LHSID: 6, RHSCount: 3},
+		{LHS: asts.NodeType("IntransitiveVerbPhrase"), LHSID: 1, RHSCount: 1},
+		{LHS: asts.NodeType("IntransitiveVerbPhrase"), LHSID: 1, RHSCount: 2},
+		{LHS: asts.NodeType("IntransitiveVerbPhrase"), LHSID: 1, RHSCount: 3},
+		{LHS: asts.NodeType("TransitiveImperativeVerbPhrase"), LHSID: 5, RHSCount: 1},
+		{LHS: asts.NodeType("TransitiveImperativeVerbPhrase"), LHSID: 5, RHSCount: 2},
+		{LHS: asts.NodeType("TransitiveImperativeVerbPhrase"), LHSID: 5, RHSCount: 3},
+		{LHS: asts.NodeType("IntransitiveImperativeVerbPhrase"), LHSID: 0, RHSCount: 1},
+		{LHS: asts.NodeType("IntransitiveImperativeVerbPhrase"), LHSID: 0, RHSCount: 2},
+		{LHS: asts.NodeType("IntransitiveImperativeVerbPhrase"), LHSID: 0, RHSCount: 3},
+	},
+	ConflictActions: map[int]map[tokens.TokenType][]lr.Action{
+		25: {
+			tokens.TokenType("preposition"): {
+				{Kind: lr.ActionShift, Target: 22},
+				{Kind: lr.ActionReduce, Target: 16},
+			},
+		},
+		31: {
+			tokens.TokenType("preposition"): {
+				{Kind: lr.ActionShift, Target: 29},
+				{Kind: lr.ActionReduce, Target: 10},
+			},
+		},
+	},
+}
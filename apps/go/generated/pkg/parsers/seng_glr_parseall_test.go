@@ -0,0 +1,51 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/apps/go/generated/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+)
+
+// TestSENGGLRParseAll verifies that the GLR driver returns every parse of an ambiguous
+// sentence, and that Select can choose among the alternatives.
+func TestSENGGLRParseAll(t *testing.T) {
+	tests := []struct {
+		input string
+		wantN int
+	}{
+		{"the dog runs", 1},
+		{"put under the cat a book", 1},
+		{"quickly put under the cat a book", 2},
+		{"quickly slowly put under the cat a book", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			parser := NewSENGGLRParser()
+			parses, err := parser.ParseAll(lexers.NewSENGGLRLexer(strings.NewReader(tt.input)), "")
+			if err != nil {
+				t.Fatalf("ParseAll error: %v", err)
+			}
+			if len(parses) != tt.wantN {
+				t.Errorf("got %d parses, want %d", len(parses), tt.wantN)
+			}
+		})
+	}
+
+	parser := NewSENGGLRParser()
+	parser.Select = func(lhs asts.NodeType, alternatives []*asts.ASTNode) []*asts.ASTNode {
+		return alternatives[:1]
+	}
+	parses, err := parser.ParseAll(lexers.NewSENGGLRLexer(strings.NewReader("quickly put under the cat a book")), "")
+	if err != nil {
+		t.Fatalf("ParseAll error: %v", err)
+	}
+	if len(parses) != 1 {
+		t.Errorf("got %d parses with Select, want 1", len(parses))
+	}
+
+	if _, err := parser.ParseAll(lexers.NewSENGGLRLexer(strings.NewReader("quickly under")), ""); err == nil {
+		t.Errorf("expected parse error")
+	}
+}
@@ -1,506 +1,169 @@
 package parsers
 
 import (
-	"fmt"
-	"os"
-	"strings"
-
 	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
 	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
 	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
 )
 
+// StatementsParser parses with StatementsParserTables. Parsing, error handling, and the parser's
+// options are those of lr.Parser.
 type StatementsParser struct {
-	Trace            *StatementsParserTraceHooks
-	stashedLookahead *tokens.Token
-}
-
-type StatementsParserTraceHooks struct {
-	OnToken  func(tok *tokens.Token)
-	OnAction func(state int, action StatementsParserAction, lookahead *tokens.Token)
-	OnStack  func(stateStack []int, nodeStack []*asts.ASTNode)
-}
-
-func NewStatementsParser() *StatementsParser { return &StatementsParser{} }
-
-// noASTSentinel is used as a placeholder on the node stack when astMode == "noast".
-var StatementsParserNoASTSentinel = &asts.ASTNode{}
-
-func (parser *StatementsParser) Parse(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {
-	if lexer == nil {
-		return nil, fmt.Errorf("parser: nil lexer")
-	}
-	stateStack := []int{0}
-	nodeStack := []*asts.ASTNode{}
-	lookahead := lexer.Scan()
-	if parser.Trace != nil && parser.Trace.OnToken != nil {
-		parser.Trace.OnToken(lookahead)
-	}
-	for {
-		if lookahead == nil {
-			return nil, fmt.Errorf("parser: lexer returned nil token")
-		}
-		if lookahead.Type == tokens.TokenTypeError {
-			return nil, fmt.Errorf("lexer error: %s", string(lookahead.Lexeme))
-		}
-		state := stateStack[len(stateStack)-1]
-		action, ok := StatementsParserActions[state][lookahead.Type]
-		if !ok {
-			return nil, fmt.Errorf("parse error: unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme))
-		}
-		if parser.Trace != nil && parser.Trace.OnAction != nil {
-			parser.Trace.OnAction(state, action, lookahead)
-		}
-		switch action.Kind {
-		case StatementsParserActionShift:
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, StatementsParserNoASTSentinel)
-			} else {
-				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
-			}
-			stateStack = append(stateStack, action.Target)
-			lookahead = lexer.Scan()
-			if parser.Trace != nil && parser.Trace.OnToken != nil {
-				parser.Trace.OnToken(lookahead)
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case StatementsParserActionReduce:
-			prod := StatementsParserProductions[action.Target]
-			rhsNodes := make([]*asts.ASTNode, prod.rhsCount)
-			for i := prod.rhsCount - 1; i >= 0; i-- {
-				stateStack = stateStack[:len(stateStack)-1]
-				rhsNodes[i] = nodeStack[len(nodeStack)-1]
-				nodeStack = nodeStack[:len(nodeStack)-1]
-			}
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, StatementsParserNoASTSentinel)
-			} else {
-				if prod.rhsCount == 0 {
-					rhsNodes = []*asts.ASTNode{}
-				}
-				node := asts.NewASTNode(nil, prod.lhs, rhsNodes)
-				nodeStack = append(nodeStack, node)
-			}
-			state = stateStack[len(stateStack)-1]
-			nextState, ok := StatementsParserGotos[state][prod.lhs]
-			if !ok {
-				return nil, fmt.Errorf("parse error: missing goto for %s", prod.lhs)
-			}
-			stateStack = append(stateStack, nextState)
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case StatementsParserActionAccept:
-			if len(nodeStack) != 1 {
-				return nil, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			if astMode == "noast" {
-				return nil, nil
-			}
-			return asts.NewAST(nodeStack[0]), nil
-		case StatementsParserActionAcceptAndYield:
-			return nil, fmt.Errorf("parse error: multiple objects; use ParseOne for multi-object input")
-		default:
-			return nil, fmt.Errorf("parse error: no action")
-		}
-	}
-}
-
-// ParseOne parses one record from the lexer. It is for multi-object input: call in a loop until done.
-// Returns (ast, true, nil) on EOF after a record, (ast, false, nil) when more input follows, or (nil, false, err) on error.
-func (parser *StatementsParser) ParseOne(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, bool, error) {
-	if lexer == nil {
-		return nil, false, fmt.Errorf("parser: nil lexer")
-	}
-	stateStack := []int{0}
-	nodeStack := []*asts.ASTNode{}
-	var lookahead *tokens.Token
-	if parser.stashedLookahead != nil {
-		lookahead = parser.stashedLookahead
-		parser.stashedLookahead = nil
-	} else {
-		lookahead = lexer.Scan()
-	}
-	if parser.Trace != nil && parser.Trace.OnToken != nil {
-		parser.Trace.OnToken(lookahead)
-	}
-	for {
-		if lookahead == nil {
-			return nil, false, fmt.Errorf("parser: lexer returned nil token")
-		}
-		if lookahead.Type == tokens.TokenTypeError {
-			return nil, false, fmt.Errorf("lexer error: %s", string(lookahead.Lexeme))
-		}
-		state := stateStack[len(stateStack)-1]
-		action, ok := StatementsParserActions[state][lookahead.Type]
-		if !ok {
-			return nil, false, fmt.Errorf("parse error: unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme))
-		}
-		if parser.Trace != nil && parser.Trace.OnAction != nil {
-			parser.Trace.OnAction(state, action, lookahead)
-		}
-		switch action.Kind {
-		case StatementsParserActionShift:
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, StatementsParserNoASTSentinel)
-			} else {
-				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
-			}
-			stateStack = append(stateStack, action.Target)
-			lookahead = lexer.Scan()
-			if parser.Trace != nil && parser.Trace.OnToken != nil {
-				parser.Trace.OnToken(lookahead)
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case StatementsParserActionReduce:
-			prod := StatementsParserProductions[action.Target]
-			rhsNodes := make([]*asts.ASTNode, prod.rhsCount)
-			for i := prod.rhsCount - 1; i >= 0; i-- {
-				stateStack = stateStack[:len(stateStack)-1]
-				rhsNodes[i] = nodeStack[len(nodeStack)-1]
-				nodeStack = nodeStack[:len(nodeStack)-1]
-			}
-			if astMode == "noast" {
-				nodeStack = append(nodeStack, StatementsParserNoASTSentinel)
-			} else {
-				if prod.rhsCount == 0 {
-					rhsNodes = []*asts.ASTNode{}
-				}
-				node := asts.NewASTNode(nil, prod.lhs, rhsNodes)
-				nodeStack = append(nodeStack, node)
-			}
-			state = stateStack[len(stateStack)-1]
-			nextState, ok := StatementsParserGotos[state][prod.lhs]
-			if !ok {
-				return nil, false, fmt.Errorf("parse error: missing goto for %s", prod.lhs)
-			}
-			stateStack = append(stateStack, nextState)
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-		case StatementsParserActionAccept:
-			if len(nodeStack) != 1 {
-				return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			if astMode == "noast" {
-				return nil, true, nil
-			}
-			return asts.NewAST(nodeStack[0]), true, nil
-		case StatementsParserActionAcceptAndYield:
-			if len(nodeStack) != 1 {
-				return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
-			}
-			if parser.Trace != nil && parser.Trace.OnStack != nil {
-				parser.Trace.OnStack(stateStack, nodeStack)
-			}
-			parser.stashedLookahead = lookahead
-			if astMode == "noast" {
-				return nil, false, nil
-			}
-			return asts.NewAST(nodeStack[0]), false, nil
-		default:
-			return nil, false, fmt.Errorf("parse error: no action")
-		}
-	}
-}
-
-// AttachCLITrace installs tracing hooks for CLI debugging.
-func (parser *StatementsParser) AttachCLITrace(traceTokens bool, traceStates bool, traceStack bool) {
-	if !traceTokens && !traceStates && !traceStack {
-		return
-	}
-	parser.Trace = &StatementsParserTraceHooks{
-		OnToken: func(tok *tokens.Token) {
-			if !traceTokens {
-				return
-			}
-			fmt.Fprintln(os.Stderr, formatStatementsParserToken(tok))
-		},
-		OnAction: func(state int, action StatementsParserAction, lookahead *tokens.Token) {
-			if !traceStates {
-				return
-			}
-			fmt.Fprintf(os.Stderr, "STATE %d %s on %s(%q)\n",
-				state, formatStatementsParserAction(action), tokenTypeNameStatementsParser(lookahead), tokenLexemeStatementsParser(lookahead))
-		},
-		OnStack: func(stateStack []int, nodeStack []*asts.ASTNode) {
-			if !traceStack {
-				return
-			}
-			fmt.Fprintf(os.Stderr, "STACK states=%s nodes=%s\n",
-				formatStatementsParserIntStack(stateStack), formatStatementsParserNodeStack(nodeStack))
-		},
-	}
-}
-
-type StatementsParserActionKind int
-
-const (
-	StatementsParserActionShift StatementsParserActionKind = iota
-	StatementsParserActionReduce
-	StatementsParserActionAccept
-	StatementsParserActionAcceptAndYield
-)
-
-type StatementsParserAction struct {
-	Kind   StatementsParserActionKind
-	Target int
-}
-
-func formatStatementsParserToken(tok *tokens.Token) string {
-	if tok == nil {
-		return "TOK <nil>"
-	}
-	return fmt.Sprintf("TOK type=%s lexeme=%q line=%d col=%d",
-		tok.Type, string(tok.Lexeme), tok.Location.LineNumber, tok.Location.ColumnNumber)
-}
-
-func tokenTypeNameStatementsParser(tok *tokens.Token) string {
-	if tok == nil {
-		return "<nil>"
-	}
-	return string(tok.Type)
-}
-
-func tokenLexemeStatementsParser(tok *tokens.Token) string {
-	if tok == nil {
-		return ""
-	}
-	return string(tok.Lexeme)
-}
-
-func formatStatementsParserIntStack(stack []int) string {
-	parts := make([]string, len(stack))
-	for i, v := range stack {
-		parts[i] = fmt.Sprintf("%d", v)
-	}
-	return "[" + strings.Join(parts, " ") + "]"
-}
-
-func formatStatementsParserNodeStack(stack []*asts.ASTNode) string {
-	parts := make([]string, len(stack))
-	for i, node := range stack {
-		if node == nil {
-			parts[i] = "<nil>"
-			continue
-		}
-		parts[i] = string(node.Type)
-	}
-	return "[" + strings.Join(parts, " ") + "]"
-}
-
-func formatStatementsParserAction(action StatementsParserAction) string {
-	switch action.Kind {
-	case StatementsParserActionShift:
-		return fmt.Sprintf("shift(%d)", action.Target)
-	case StatementsParserActionReduce:
-		return fmt.Sprintf("reduce(%d)", action.Target)
-	case StatementsParserActionAccept:
-		return "accept"
-	case StatementsParserActionAcceptAndYield:
-		return "accept_and_yield"
-	default:
-		return "unknown"
-	}
-}
-
-type StatementsParserProduction struct {
-	lhs      asts.NodeType
-	rhsCount int
-}
-
-var StatementsParserActions = map[int]map[tokens.TokenType]StatementsParserAction{
-	0: {
-		tokens.TokenTypeEOF:             {Kind: StatementsParserActionReduce, Target: 1},
-		tokens.TokenType("id"):          {Kind: StatementsParserActionShift, Target: 7},
-		tokens.TokenType("if"):          {Kind: StatementsParserActionShift, Target: 8},
-		tokens.TokenType("int_literal"): {Kind: StatementsParserActionShift, Target: 9},
-		tokens.TokenType("print"):       {Kind: StatementsParserActionShift, Target: 10},
-		tokens.TokenType("semicolon"):   {Kind: StatementsParserActionShift, Target: 11},
-	},
-	1: {
-		tokens.TokenType("semicolon"): {Kind: StatementsParserActionShift, Target: 12},
-	},
-	2: {
-		tokens.TokenTypeEOF:             {Kind: StatementsParserActionReduce, Target: 6},
-		tokens.TokenType("id"):          {Kind: StatementsParserActionReduce, Target: 6},
-		tokens.TokenType("if"):          {Kind: StatementsParserActionReduce, Target: 6},
-		tokens.TokenType("int_literal"): {Kind: StatementsParserActionReduce, Target: 6},
-		tokens.TokenType("print"):       {Kind: StatementsParserActionReduce, Target: 6},
-		tokens.TokenType("semicolon"):   {Kind: StatementsParserActionReduce, Target: 6},
+	lr.Parser
+}
+
+func NewStatementsParser() *StatementsParser {
+	return &StatementsParser{Parser: lr.Parser{Tables: StatementsParserTables}}
+}
+
+// ParseProgram parses input derived from Program, one of the grammar's start symbols.
+func (parser *StatementsParser) ParseProgram(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {
+	return parser.Parser.ParseFrom("Program", lexer, astMode)
+}
+
+// ParseStatement parses input derived from Statement, one of the grammar's start symbols.
+func (parser *StatementsParser) ParseStatement(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {
+	return parser.Parser.ParseFrom("Statement", lexer, astMode)
+}
+
+// ParseExpression parses input derived from Expression, one of the grammar's start symbols.
+func (parser *StatementsParser) ParseExpression(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {
+	return parser.Parser.ParseFrom("Expression", lexer, astMode)
+}
+
+// ParseWithStatementsParser parses the input, computing values of type T with the handler in place of
+// building an AST, and returns the start symbol's value. Syntax errors may be repaired, as with
+// RepairErrors, but error productions are not used.
+func ParseWithStatementsParser[T any](parser *StatementsParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {
+	return lr.ParseWith(&parser.Parser, lexer, handler)
+}
+
+// ParseProgramWithStatementsParser parses input derived from Program, as ParseWithStatementsParser does.
+func ParseProgramWithStatementsParser[T any](parser *StatementsParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {
+	return lr.ParseFromWith(&parser.Parser, "Program", lexer, handler)
+}
+
+// ParseStatementWithStatementsParser parses input derived from Statement, as ParseWithStatementsParser does.
+func ParseStatementWithStatementsParser[T any](parser *StatementsParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {
+	return lr.ParseFromWith(&parser.Parser, "Statement", lexer, handler)
+}
+
+// ParseExpressionWithStatementsParser parses input derived from Expression, as ParseWithStatementsParser does.
+func ParseExpressionWithStatementsParser[T any](parser *StatementsParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {
+	return lr.ParseFromWith(&parser.Parser, "Expression", lexer, handler)
+}
+
+// StatementsParserTables are the parser's LR tables, run by the lr package. The action and goto tables
+// are indexed by the IDs of Terminals and Nonterminals, and row-displaced, as lr.Tables documents.
+var StatementsParserTables = &lr.Tables{
+	StartSymbol: "Program",
+	EntryPoints: []lr.EntryPoint{
+		{Symbol: "Program", State: 0},
+		{Symbol: "Statement", State: 1},
+		{Symbol: "Expression", State: 2},
+	},
+	Terminals: tokens.NewKinds(
+		"EOF",
+		"equals",
+		"error",
+		"id",
+		"if",
+		"int_literal",
+		"lparen",
+		"print",
+		"rparen",
+		"semicolon",
+	),
+	Nonterminals: []asts.NodeType{
+		"Expression",
+		"IfStatement",
+		"PrintStatement",
+		"Program",
+		"Statement",
+		"__pgpg_repeat_1",
+		"__pgpg_start_2",
+		"__pgpg_start_3",
+		"__pgpg_start_4",
+	},
+	ActionBase: []int32{
+		-1, 71, 66, -9, 7, 15, 5, 23, 13, 12, 28, 31, 36, 47, 31, 52,
+		98, 100, 101, 93, 97, 98, 105, 106, 106, 108, 39, 109, 47, 105, 74, 82,
+		111, 112, 90, 94, 108, 105, 107, 115, 109, 110, 111, 112, 121, 79, 117, 114,
+		87, 115, 55, 117, 63, 126, 127,
+	},
+	ActionCheck: []int32{
+		0, 3, 0, 0, 0, 0, 6, 0, 4, 0, 4, 4, 4, 4, 8, 4,
+		5, 4, 5, 5, 5, 5, 9, 5, 7, 5, 7, 7, 7, 7, 10, 7,
+		14, 7, 14, 14, 14, 14, 11, 14, 26, 14, 26, 26, 26, 26, 12, 26,
+		28, 26, 28, 28, 28, 28, 13, 28, 50, 28, 50, 50, 50, 50, 15, 50,
+		52, 50, 52, 52, 52, 52, 2, 52, 2, 52, 1, 1, 1, 1, 30, 1,
+		30, 1, 45, 45, 45, 45, 31, 45, 31, 45, 48, 48, 48, 48, 34, 48,
+		34, 48, 35, 16, 35, 17, 18, 19, 20, 21, 22, 23, 24, 25, 27, 29,
+		32, 33, 36, 37, 38, 39, 40, 41, 42, 43, 44, 46, 47, 49, 51, 53,
+		54,
+	},
+	ActionEntries: []int32{
+		13, 104, 36, 40, 44, 48, 2, 52, 33, 56, 33, 33, 33, 33, 21, 33,
+		37, 33, 37, 37, 37, 37, 112, 37, 13, 37, 36, 40, 44, 48, 116, 52,
+		25, 56, 25, 25, 25, 25, 120, 25, 29, 25, 29, 29, 29, 29, 49, 29,
+		41, 29, 41, 41, 41, 41, 124, 41, 53, 41, 53, 53, 53, 53, 128, 53,
+		57, 53, 57, 57, 57, 57, 96, 57, 100, 57, 76, 40, 80, 48, 156, 84,
+		160, 88, 36, 40, 44, 48, 156, 52, 160, 56, 76, 40, 80, 48, 156, 84,
+		160, 88, 156, 33, 160, 37, 2, 132, 136, 140, 25, 2, 144, 49, 17, 148,
+		29, 41, 176, 45, 180, 184, 49, 188, 192, 196, 45, 204, 208, 216, 45, 53,
+		57,
+	},
+	GotoBase: []int32{
+		0, 12, 9, 0, 0, 0, 0, 6, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 15, 20,
+		0, 0, 25, 27, 0, 0, 0, 0, 0, 0, 0, 0, 0, 17, 0, 0,
+		22, 0, 0, 0, 0, 0, 0,
+	},
+	GotoCheck: []int32{
+		0, 0, 0, 0, 0, 0, 7, 7, 7, 2, 7, 7, 1, 1, 1, 30,
+		1, 45, 45, 45, 31, 45, 48, 48, 48, 34, 48, 35,
+	},
+	GotoTargets: []int32{
+		3, 4, 5, 6, 7, 8, 3, 4, 5, 23, 7, 27, 15, 16, 17, 38,
+		18, 3, 4, 5, 41, 50, 15, 16, 17, 42, 53, 43,
+	},
+	Productions: []lr.Production{
+		{LHS: asts.NodeType("__pgpg_start_2"), LHSID: 6, RHSCount: 1},
+		{LHS: asts.NodeType("__pgpg_start_3"), LHSID: 7, RHSCount: 1},
+		{LHS: asts.NodeType("__pgpg_start_4"), LHSID: 8, RHSCount: 1},
+		{LHS: asts.NodeType("__pgpg_repeat_1"), LHSID: 5, RHSCount: 0},
+		{LHS: asts.NodeType("__pgpg_repeat_1"), LHSID: 5, RHSCount: 2},
+		{LHS: asts.NodeType("Program"), LHSID: 3, RHSCount: 1},
+		{LHS: asts.NodeType("Statement"), LHSID: 4, RHSCount: 1},
+		{LHS: asts.NodeType("Statement"), LHSID: 4, RHSCount: 2},
+		{LHS: asts.NodeType("Statement"), LHSID: 4, RHSCount: 1},
+		{LHS: asts.NodeType("Statement"), LHSID: 4, RHSCount: 1},
+		{LHS: asts.NodeType("Statement"), LHSID: 4, RHSCount: 2},
+		{LHS: asts.NodeType("Expression"), LHSID: 0, RHSCount: 3},
+		{LHS: asts.NodeType("Expression"), LHSID: 0, RHSCount: 1},
+		{LHS: asts.NodeType("IfStatement"), LHSID: 1, RHSCount: 5},
+		{LHS: asts.NodeType("PrintStatement"), LHSID: 2, RHSCount: 5},
+	},
+	// DisplayNames are from the grammar's %display declarations.
+	DisplayNames: map[tokens.TokenType]string{
+		tokens.TokenType("equals"):      "'='",
+		tokens.TokenType("id"):          "identifier",
+		tokens.TokenType("int_literal"): "integer",
+		tokens.TokenType("lparen"):      "'('",
+		tokens.TokenType("rparen"):      "')'",
+		tokens.TokenType("semicolon"):   "';'",
+	},
+	// ErrorMessages are from the grammar's messages file.
+	ErrorMessages: map[int]string{
+		10: "expected '=' after identifier",
+		11: "expected '(' after if",
+		12: "missing ';' after expression",
+		13: "expected '(' after print",
+		29: "expected an integer after '='",
+		37: "missing ';' after expression",
+		46: "expected an integer after '='",
+		47: "missing ';' after print statement",
 	},
-	3: {
-		tokens.TokenTypeEOF:             {Kind: StatementsParserActionReduce, Target: 7},
-		tokens.TokenType("id"):          {Kind: StatementsParserActionReduce, Target: 7},
-		tokens.TokenType("if"):          {Kind: StatementsParserActionReduce, Target: 7},
-		tokens.TokenType("int_literal"): {Kind: StatementsParserActionReduce, Target: 7},
-		tokens.TokenType("print"):       {Kind: StatementsParserActionReduce, Target: 7},
-		tokens.TokenType("semicolon"):   {Kind: StatementsParserActionReduce, Target: 7},
-	},
-	4: {
-		tokens.TokenTypeEOF:             {Kind: StatementsParserActionAccept},
-		tokens.TokenType("equals"):      {Kind: StatementsParserActionAcceptAndYield},
-		tokens.TokenType("id"):          {Kind: StatementsParserActionAcceptAndYield},
-		tokens.TokenType("if"):          {Kind: StatementsParserActionAcceptAndYield},
-		tokens.TokenType("int_literal"): {Kind: StatementsParserActionAcceptAndYield},
-		tokens.TokenType("lparen"):      {Kind: StatementsParserActionAcceptAndYield},
-		tokens.TokenType("print"):       {Kind: StatementsParserActionAcceptAndYield},
-		tokens.TokenType("rparen"):      {Kind: StatementsParserActionAcceptAndYield},
-		tokens.TokenType("semicolon"):   {Kind: StatementsParserActionAcceptAndYield},
-	},
-	5: {
-		tokens.TokenTypeEOF:             {Kind: StatementsParserActionReduce, Target: 1},
-		tokens.TokenType("id"):          {Kind: StatementsParserActionShift, Target: 7},
-		tokens.TokenType("if"):          {Kind: StatementsParserActionShift, Target: 8},
-		tokens.TokenType("int_literal"): {Kind: StatementsParserActionShift, Target: 9},
-		tokens.TokenType("print"):       {Kind: StatementsParserActionShift, Target: 10},
-		tokens.TokenType("semicolon"):   {Kind: StatementsParserActionShift, Target: 11},
-	},
-	6: {
-		tokens.TokenTypeEOF: {Kind: StatementsParserActionReduce, Target: 3},
-	},
-	7: {
-		tokens.TokenType("equals"): {Kind: StatementsParserActionShift, Target: 14},
-	},
-	8: {
-		tokens.TokenType("lparen"): {Kind: StatementsParserActionShift, Target: 15},
-	},
-	9: {
-		tokens.TokenType("semicolon"): {Kind: StatementsParserActionReduce, Target: 9},
-	},
-	10: {
-		tokens.TokenType("lparen"): {Kind: StatementsParserActionShift, Target: 16},
-	},
-	11: {
-		tokens.TokenTypeEOF:             {Kind: StatementsParserActionReduce, Target: 4},
-		tokens.TokenType("id"):          {Kind: StatementsParserActionReduce, Target: 4},
-		tokens.TokenType("if"):          {Kind: StatementsParserActionReduce, Target: 4},
-		tokens.TokenType("int_literal"): {Kind: StatementsParserActionReduce, Target: 4},
-		tokens.TokenType("print"):       {Kind: StatementsParserActionReduce, Target: 4},
-		tokens.TokenType("semicolon"):   {Kind: StatementsParserActionReduce, Target: 4},
-	},
-	12: {
-		tokens.TokenTypeEOF:             {Kind: StatementsParserActionReduce, Target: 5},
-		tokens.TokenType("id"):          {Kind: StatementsParserActionReduce, Target: 5},
-		tokens.TokenType("if"):          {Kind: StatementsParserActionReduce, Target: 5},
-		tokens.TokenType("int_literal"): {Kind: StatementsParserActionReduce, Target: 5},
-		tokens.TokenType("print"):       {Kind: StatementsParserActionReduce, Target: 5},
-		tokens.TokenType("semicolon"):   {Kind: StatementsParserActionReduce, Target: 5},
-	},
-	13: {
-		tokens.TokenTypeEOF: {Kind: StatementsParserActionReduce, Target: 2},
-	},
-	14: {
-		tokens.TokenType("int_literal"): {Kind: StatementsParserActionShift, Target: 17},
-	},
-	15: {
-		tokens.TokenType("id"):          {Kind: StatementsParserActionShift, Target: 19},
-		tokens.TokenType("int_literal"): {Kind: StatementsParserActionShift, Target: 20},
-	},
-	16: {
-		tokens.TokenType("id"):          {Kind: StatementsParserActionShift, Target: 19},
-		tokens.TokenType("int_literal"): {Kind: StatementsParserActionShift, Target: 20},
-	},
-	17: {
-		tokens.TokenType("semicolon"): {Kind: StatementsParserActionReduce, Target: 8},
-	},
-	18: {
-		tokens.TokenType("rparen"): {Kind: StatementsParserActionShift, Target: 22},
-	},
-	19: {
-		tokens.TokenType("equals"): {Kind: StatementsParserActionShift, Target: 23},
-	},
-	20: {
-		tokens.TokenType("rparen"): {Kind: StatementsParserActionReduce, Target: 9},
-	},
-	21: {
-		tokens.TokenType("rparen"): {Kind: StatementsParserActionShift, Target: 24},
-	},
-	22: {
-		tokens.TokenType("id"):          {Kind: StatementsParserActionShift, Target: 7},
-		tokens.TokenType("if"):          {Kind: StatementsParserActionShift, Target: 8},
-		tokens.TokenType("int_literal"): {Kind: StatementsParserActionShift, Target: 9},
-		tokens.TokenType("print"):       {Kind: StatementsParserActionShift, Target: 10},
-		tokens.TokenType("semicolon"):   {Kind: StatementsParserActionShift, Target: 11},
-	},
-	23: {
-		tokens.TokenType("int_literal"): {Kind: StatementsParserActionShift, Target: 26},
-	},
-	24: {
-		tokens.TokenType("semicolon"): {Kind: StatementsParserActionShift, Target: 27},
-	},
-	25: {
-		tokens.TokenTypeEOF:             {Kind: StatementsParserActionReduce, Target: 10},
-		tokens.TokenType("id"):          {Kind: StatementsParserActionReduce, Target: 10},
-		tokens.TokenType("if"):          {Kind: StatementsParserActionReduce, Target: 10},
-		tokens.TokenType("int_literal"): {Kind: StatementsParserActionReduce, Target: 10},
-		tokens.TokenType("print"):       {Kind: StatementsParserActionReduce, Target: 10},
-		tokens.TokenType("semicolon"):   {Kind: StatementsParserActionReduce, Target: 10},
-	},
-	26: {
-		tokens.TokenType("rparen"): {Kind: StatementsParserActionReduce, Target: 8},
-	},
-	27: {
-		tokens.TokenTypeEOF:             {Kind: StatementsParserActionReduce, Target: 11},
-		tokens.TokenType("id"):          {Kind: StatementsParserActionReduce, Target: 11},
-		tokens.TokenType("if"):          {Kind: StatementsParserActionReduce, Target: 11},
-		tokens.TokenType("int_literal"): {Kind: StatementsParserActionReduce, Target: 11},
-		tokens.TokenType("print"):       {Kind: StatementsParserActionReduce, Target: 11},
-		tokens.TokenType("semicolon"):   {Kind: StatementsParserActionReduce, Target: 11},
-	},
-}
-
-var StatementsParserGotos = map[int]map[asts.NodeType]int{
-	0: {
-		asts.NodeType("Expression"):      1,
-		asts.NodeType("IfStatement"):     2,
-		asts.NodeType("PrintStatement"):  3,
-		asts.NodeType("Program"):         4,
-		asts.NodeType("Statement"):       5,
-		asts.NodeType("__pgpg_repeat_1"): 6,
-	},
-	5: {
-		asts.NodeType("Expression"):      1,
-		asts.NodeType("IfStatement"):     2,
-		asts.NodeType("PrintStatement"):  3,
-		asts.NodeType("Statement"):       5,
-		asts.NodeType("__pgpg_repeat_1"): 13,
-	},
-	15: {
-		asts.NodeType("Expression"): 18,
-	},
-	16: {
-		asts.NodeType("Expression"): 21,
-	},
-	22: {
-		asts.NodeType("Expression"):     1,
-		asts.NodeType("IfStatement"):    2,
-		asts.NodeType("PrintStatement"): 3,
-		asts.NodeType("Statement"):      25,
-	},
-}
-
-var StatementsParserProductions = []StatementsParserProduction{
-	{lhs: asts.NodeType("__pgpg_start_2"), rhsCount: 1},
-	{lhs: asts.NodeType("__pgpg_repeat_1"), rhsCount: 0},
-	{lhs: asts.NodeType("__pgpg_repeat_1"), rhsCount: 2},
-	{lhs: asts.NodeType("Program"), rhsCount: 1},
-	{lhs: asts.NodeType("Statement"), rhsCount: 1},
-	{lhs: asts.NodeType("Statement"), rhsCount: 2},
-	{lhs: asts.NodeType("Statement"), rhsCount: 1},
-	{lhs: asts.NodeType("Statement"), rhsCount: 1},
-	{lhs: asts.NodeType("Expression"), rhsCount: 3},
-	{lhs: asts.NodeType("Expression"), rhsCount: 1},
-	{lhs: asts.NodeType("IfStatement"), rhsCount: 5},
-	{lhs: asts.NodeType("PrintStatement"), rhsCount: 5},
 }
@@ -0,0 +1,46 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/apps/go/generated/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+)
+
+// TestStatementsEntryPoints verifies the parse methods for the %start symbols of statements.bnf,
+// which share one set of tables.
+func TestStatementsEntryPoints(t *testing.T) {
+	parser := NewStatementsParser()
+	type parseFunc func(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error)
+	tests := []struct {
+		parse    parseFunc
+		input    string
+		wantType asts.NodeType // empty if a parse error is expected
+	}{
+		{parser.Parse, "x = 1; print(2);", "Program"},
+		{parser.ParseProgram, "x = 1; print(2);", "Program"},
+		{parser.ParseStatement, "if (1) print(x = 2);", "Statement"},
+		{parser.ParseStatement, "1; 2;", ""},
+		{parser.ParseExpression, "x = 1", "Expression"},
+		{parser.ParseExpression, "x = 1;", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ast, err := tt.parse(lexers.NewStatementsLexer(strings.NewReader(tt.input)), "")
+			if tt.wantType == "" {
+				if err == nil {
+					t.Errorf("expected a parse error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if ast.RootNode.Type != tt.wantType {
+				t.Errorf("root type: got %q, want %q", ast.RootNode.Type, tt.wantType)
+			}
+		})
+	}
+}
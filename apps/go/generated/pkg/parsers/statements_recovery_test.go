@@ -0,0 +1,69 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/apps/go/generated/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+)
+
+// TestStatementsErrorRecovery verifies recovery via statements.bnf's "error semicolon" production:
+// each bad statement becomes an error node, and every error is reported.
+func TestStatementsErrorRecovery(t *testing.T) {
+	tests := []struct {
+		input      string
+		wantErrors []string
+		wantNodes  int // error nodes in the AST, or -1 if no AST is expected
+	}{
+		{"x = 1; print(2);", nil, 0},
+		{"x = 1; print(2 3); print(4);", []string{`line 1, column 16: unexpected integer "3"; expected ')'`}, 1},
+		{"print(2 3); y = ; print(4);", []string{
+			`line 1, column 9: unexpected integer "3"; expected ')'`,
+			`line 1, column 17: expected an integer after '='`,
+		}, 2},
+		// Errors within three tokens of the last are not reported separately.
+		{"print(2 3); ) ; print(4);", []string{`line 1, column 9: unexpected integer "3"; expected ')'`}, 1},
+		// At end of input there is no semicolon to resynchronize on.
+		{"x = 1; print(2", []string{`line 1, column 15: unexpected end of input; expected ')'`}, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			parser := NewStatementsParser()
+			ast, err := parser.Parse(lexers.NewStatementsLexer(strings.NewReader(tt.input)), "")
+			if len(tt.wantErrors) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			} else if err == nil || err.Error() != strings.Join(tt.wantErrors, "\n") {
+				t.Errorf("error: got %v, want %q", err, tt.wantErrors)
+			}
+			if len(parser.Errors) != len(tt.wantErrors) {
+				t.Errorf("parser.Errors: got %d, want %d", len(parser.Errors), len(tt.wantErrors))
+			}
+			if tt.wantNodes < 0 {
+				if ast != nil {
+					t.Errorf("expected no AST")
+				}
+				return
+			}
+			if ast == nil {
+				t.Fatalf("expected an AST")
+			}
+			if got := countErrorNodes(ast.RootNode); got != tt.wantNodes {
+				t.Errorf("error nodes: got %d, want %d", got, tt.wantNodes)
+			}
+		})
+	}
+}
+
+func countErrorNodes(node *asts.ASTNode) int {
+	n := 0
+	if node.Type == "error" {
+		n++
+	}
+	for _, child := range node.Children {
+		n += countErrorNodes(child)
+	}
+	return n
+}
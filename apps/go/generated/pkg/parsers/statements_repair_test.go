@@ -0,0 +1,68 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/apps/go/generated/pkg/lexers"
+)
+
+// TestStatementsErrorRepair verifies single-token repairs of syntax errors: each is reported with
+// the error, and parsing continues with the repaired input.
+func TestStatementsErrorRepair(t *testing.T) {
+	tests := []struct {
+		input      string
+		maxErrors  int
+		wantErrors []string
+		wantNodes  int // error nodes in the AST, or -1 if no AST is expected
+	}{
+		{"x = 1; print(2);", 0, nil, 0},
+		{"x = 1 print(2);", 0, []string{
+			`line 1, column 7: missing ';' after expression; inserted ';'`,
+		}, 0},
+		{"x = 1 y = 2 z = 3;", 0, []string{
+			`line 1, column 7: missing ';' after expression; inserted ';'`,
+			`line 1, column 13: missing ';' after expression; inserted ';'`,
+		}, 0},
+		{"print(2 3); y = ; print(4);", 0, []string{
+			`line 1, column 9: unexpected integer "3"; expected ')'; deleted integer "3"`,
+			`line 1, column 17: expected an integer after '='; inserted integer`,
+		}, 0},
+		{"x = 1; y = 2", 0, []string{
+			`line 1, column 13: missing ';' after expression; inserted ';'`,
+		}, 0},
+		// Parsing stops at the first error, with its repair.
+		{"x = 1 y = 2 z = 3;", 1, []string{
+			`line 1, column 7: missing ';' after expression; inserted ';'`,
+		}, -1},
+		// No single-token edit fixes this, so the error production is used instead.
+		{"print(2 3 4); x = 1;", 0, []string{`line 1, column 9: unexpected integer "3"; expected ')'`}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			parser := NewStatementsParser()
+			parser.RepairErrors = true
+			parser.MaxErrors = tt.maxErrors
+			ast, err := parser.Parse(lexers.NewStatementsLexer(strings.NewReader(tt.input)), "")
+			if len(tt.wantErrors) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			} else if err == nil || err.Error() != strings.Join(tt.wantErrors, "\n") {
+				t.Errorf("error: got %v, want %q", err, tt.wantErrors)
+			}
+			if tt.wantNodes < 0 {
+				if ast != nil {
+					t.Errorf("expected no AST")
+				}
+				return
+			}
+			if ast == nil {
+				t.Fatalf("expected an AST")
+			}
+			if got := countErrorNodes(ast.RootNode); got != tt.wantNodes {
+				t.Errorf("error nodes: got %d, want %d", got, tt.wantNodes)
+			}
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package parsers
 
 import (
-	"errors"
 	"fmt"
 	"io"
 
@@ -49,18 +48,20 @@ func (parser *AMEParser) parseSumOrProduct() (*asts.ASTNode, error) {
 	lookaheadToken := parser.lexer.LookAhead()
 
 	if lookaheadToken.IsError() {
-		return nil, errors.New(string(lookaheadToken.Lexeme))
+		return nil, liblexers.NewLexError(lookaheadToken, "")
 	}
 	if lookaheadToken.IsEOF() {
-		return nil, errors.New("AMEParser: no token found in input")
+		return nil, parsers.NewParseError(lookaheadToken, "", "AMEParser: no token found in input")
 	}
 
 	if lookaheadToken.Type != lexers.AMLexerTypeNumber {
-		return nil, fmt.Errorf(
+		err := parsers.NewParseError(lookaheadToken, "", fmt.Sprintf(
 			"AMEParser: initial token was of type %s; expected %s",
 			lookaheadToken.Type,
 			lexers.AMLexerTypeNumber,
-		)
+		))
+		err.Expected = []string{string(lexers.AMLexerTypeNumber)}
+		return nil, err
 	}
 
 	accepted, acceptedToken, err := parser.accept(lexers.AMLexerTypeNumber)
@@ -68,12 +69,12 @@ func (parser *AMEParser) parseSumOrProduct() (*asts.ASTNode, error) {
 		return nil, err
 	}
 	if !accepted {
-		return nil, errors.New("AMEParser: expected int literal")
+		return nil, parsers.NewParseError(parser.lexer.LookAhead(), "", "AMEParser: expected int literal")
 	}
 
 	lookaheadToken = parser.lexer.LookAhead()
 	if lookaheadToken.IsError() {
-		return nil, errors.New(string(lookaheadToken.Lexeme))
+		return nil, liblexers.NewLexError(lookaheadToken, "")
 	}
 
 	if lookaheadToken.IsEOF() {
@@ -82,12 +83,14 @@ func (parser *AMEParser) parseSumOrProduct() (*asts.ASTNode, error) {
 	}
 
 	if lookaheadToken.Type != lexers.AMLexerTypePlus && lookaheadToken.Type != lexers.AMLexerTypeTimes {
-		return nil, fmt.Errorf(
+		err := parsers.NewParseError(lookaheadToken, "", fmt.Sprintf(
 			"AMEParser: expected %s or %s; got %s",
 			lexers.AMLexerTypePlus,
 			lexers.AMLexerTypeTimes,
 			lookaheadToken.Type,
-		)
+		))
+		err.Expected = []string{string(lexers.AMLexerTypePlus), string(lexers.AMLexerTypeTimes)}
+		return nil, err
 	}
 
 	opToken := lookaheadToken
@@ -121,12 +124,14 @@ func (parser *AMEParser) expect(tokenType tokens.TokenType) error {
 	}
 	if !accepted {
 		lookaheadToken := parser.lexer.LookAhead()
-		return fmt.Errorf(
+		err := parsers.NewParseError(lookaheadToken, "", fmt.Sprintf(
 			"expect: expected %s; got %s (%q)",
 			tokenType,
 			lookaheadToken.Type,
 			string(lookaheadToken.Lexeme),
-		)
+		))
+		err.Expected = []string{string(tokenType)}
+		return err
 	}
 	return nil
 }
@@ -135,7 +140,7 @@ func (parser *AMEParser) getAndValidateLookaheadToken() error {
 	parser.lexer.Advance()
 	lookaheadToken := parser.lexer.LookAhead()
 	if lookaheadToken.IsError() {
-		return errors.New(string(lookaheadToken.Lexeme))
+		return liblexers.NewLexError(lookaheadToken, "")
 	}
 	return nil
 }
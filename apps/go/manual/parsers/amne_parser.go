@@ -1,7 +1,6 @@
 package parsers
 
 import (
-	"errors"
 	"io"
 
 	"github.com/johnkerl/pgpg/apps/go/manual/lexers"
@@ -94,10 +93,19 @@ func (parser *AMNEParser) parseRestOfProduct(left *asts.ASTNode) (*asts.ASTNode,
 
 func (parser *AMNEParser) parseIntLiteral() (*asts.ASTNode, error) {
 	accepted, token, err := parser.accept(lexers.AMLexerTypeNumber)
-	if accepted && err == nil {
+	if err != nil {
+		return nil, err
+	}
+	if accepted {
 		return asts.NewASTNode(token, AMNEParserNodeTypeNumber, nil), nil
 	}
-	return nil, errors.New("syntax error: expected int literal; got " + token.String())
+	lookaheadToken := parser.lexer.LookAhead()
+	if lookaheadToken.IsError() {
+		return nil, liblexers.NewLexError(lookaheadToken, "")
+	}
+	parseError := parsers.NewParseError(lookaheadToken, "", "syntax error: expected int literal; got "+lookaheadToken.String())
+	parseError.Expected = []string{string(lexers.AMLexerTypeNumber)}
+	return nil, parseError
 }
 
 func (parser *AMNEParser) accept(tokenType tokens.TokenType) (bool, *tokens.Token, error) {
@@ -115,7 +123,9 @@ func (parser *AMNEParser) expect(tokenType tokens.TokenType) error {
 		return lexerr
 	}
 	if !accepted {
-		return errors.New("expect: unexpected symbol")
+		err := parsers.NewParseError(parser.lexer.LookAhead(), "", "expect: unexpected symbol")
+		err.Expected = []string{string(tokenType)}
+		return err
 	}
 	return nil
 }
@@ -124,7 +134,7 @@ func (parser *AMNEParser) getAndValidateLookaheadToken() error {
 	parser.lexer.Advance()
 	lookaheadToken := parser.lexer.LookAhead()
 	if lookaheadToken.IsError() {
-		return errors.New(string(lookaheadToken.Lexeme))
+		return liblexers.NewLexError(lookaheadToken, "")
 	}
 	return nil
 }
@@ -1,7 +1,6 @@
 package parsers
 
 import (
-	"errors"
 	"fmt"
 	"io"
 
@@ -173,7 +172,7 @@ func (parser *PEMDASParser) parsePrimary() (*asts.ASTNode, error) {
 		return expr, nil
 	}
 
-	return nil, errors.New("syntax error: expected int literal or '('")
+	return nil, parsers.NewParseError(parser.lexer.LookAhead(), "", "syntax error: expected int literal or '('")
 }
 
 func (parser *PEMDASParser) accept(tokenType tokens.TokenType) (bool, *tokens.Token, error) {
@@ -192,12 +191,14 @@ func (parser *PEMDASParser) expect(tokenType tokens.TokenType) error {
 	}
 	if !accepted {
 		lookaheadToken := parser.lexer.LookAhead()
-		return fmt.Errorf(
+		err := parsers.NewParseError(lookaheadToken, "", fmt.Sprintf(
 			"expect: expected %s; got %s (%q)",
 			tokenType,
 			lookaheadToken.Type,
 			string(lookaheadToken.Lexeme),
-		)
+		))
+		err.Expected = []string{string(tokenType)}
+		return err
 	}
 	return nil
 }
@@ -206,7 +207,7 @@ func (parser *PEMDASParser) getAndValidateLookaheadToken() error {
 	parser.lexer.Advance()
 	lookaheadToken := parser.lexer.LookAhead()
 	if lookaheadToken.IsError() {
-		return errors.New(string(lookaheadToken.Lexeme))
+		return liblexers.NewLexError(lookaheadToken, "")
 	}
 	return nil
 }
@@ -1,7 +1,6 @@
 package parsers
 
 import (
-	"errors"
 	"fmt"
 	"io"
 
@@ -130,7 +129,7 @@ func (parser *VBCParser) parsePrimary() (*asts.ASTNode, error) {
 		return expr, nil
 	}
 
-	return nil, errors.New("syntax error: expected identifier or '('")
+	return nil, parsers.NewParseError(parser.lexer.LookAhead(), "", "syntax error: expected identifier or '('")
 }
 
 func (parser *VBCParser) accept(tokenType tokens.TokenType) (bool, *tokens.Token, error) {
@@ -149,12 +148,14 @@ func (parser *VBCParser) expect(tokenType tokens.TokenType) error {
 	}
 	if !accepted {
 		lookaheadToken := parser.lexer.LookAhead()
-		return fmt.Errorf(
+		err := parsers.NewParseError(lookaheadToken, "", fmt.Sprintf(
 			"expect: expected %s; got %s (%q)",
 			tokenType,
 			lookaheadToken.Type,
 			string(lookaheadToken.Lexeme),
-		)
+		))
+		err.Expected = []string{string(tokenType)}
+		return err
 	}
 	return nil
 }
@@ -163,7 +164,7 @@ func (parser *VBCParser) getAndValidateLookaheadToken() error {
 	parser.lexer.Advance()
 	lookaheadToken := parser.lexer.LookAhead()
 	if lookaheadToken.IsError() {
-		return errors.New(string(lookaheadToken.Lexeme))
+		return liblexers.NewLexError(lookaheadToken, "")
 	}
 	return nil
 }
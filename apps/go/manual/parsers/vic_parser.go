@@ -1,7 +1,6 @@
 package parsers
 
 import (
-	"errors"
 	"fmt"
 	"io"
 
@@ -56,7 +55,7 @@ func (parser *VICParser) parseStatement() (*asts.ASTNode, error) {
 		return left, nil
 	}
 	if left.Type != VICParserNodeTypeIdentifier {
-		return nil, errors.New("syntax error: assignment requires identifier on left-hand side")
+		return nil, parsers.NewParseError(assignToken, "", "syntax error: assignment requires identifier on left-hand side")
 	}
 
 	right, err := parser.parseSum()
@@ -208,7 +207,7 @@ func (parser *VICParser) parsePrimary() (*asts.ASTNode, error) {
 		return expr, nil
 	}
 
-	return nil, errors.New("syntax error: expected int literal, identifier, or '('")
+	return nil, parsers.NewParseError(parser.lexer.LookAhead(), "", "syntax error: expected int literal, identifier, or '('")
 }
 
 func (parser *VICParser) accept(tokenType tokens.TokenType) (bool, *tokens.Token, error) {
@@ -227,12 +226,14 @@ func (parser *VICParser) expect(tokenType tokens.TokenType) error {
 	}
 	if !accepted {
 		lookaheadToken := parser.lexer.LookAhead()
-		return fmt.Errorf(
+		err := parsers.NewParseError(lookaheadToken, "", fmt.Sprintf(
 			"expect: expected %s; got %s (%q)",
 			tokenType,
 			lookaheadToken.Type,
 			string(lookaheadToken.Lexeme),
-		)
+		))
+		err.Expected = []string{string(tokenType)}
+		return err
 	}
 	return nil
 }
@@ -241,7 +242,7 @@ func (parser *VICParser) getAndValidateLookaheadToken() error {
 	parser.lexer.Advance()
 	lookaheadToken := parser.lexer.LookAhead()
 	if lookaheadToken.IsError() {
-		return errors.New(string(lookaheadToken.Lexeme))
+		return liblexers.NewLexError(lookaheadToken, "")
 	}
 	return nil
 }
@@ -10,14 +10,23 @@ import (
 )
 
 func usage() {
-	fmt.Fprintf(os.Stderr, "Usage: %s [-o output.json] input.bnf\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "Usage: %s [-o output.json] [-minimize] [-overlaps] [-shadow-errors] input.bnf\n", os.Args[0])
 	flag.PrintDefaults()
 	os.Exit(1)
 }
 
 func main() {
 	var outputPath string
+	var minimize bool
+	var overlaps bool
+	var shadowErrors bool
 	flag.StringVar(&outputPath, "o", "", "Output JSON file (default stdout)")
+	flag.BoolVar(&minimize, "minimize", false,
+		"Merge equivalent DFA states, and report the state counts before and after")
+	flag.BoolVar(&overlaps, "overlaps", false,
+		"Also warn about token rules which lose to earlier rules on only some lexemes")
+	flag.BoolVar(&shadowErrors, "shadow-errors", false,
+		"Fail on token rules entirely shadowed by earlier rules, rather than warning")
 	flag.Usage = usage
 	flag.Parse()
 
@@ -38,11 +47,24 @@ func main() {
 		os.Exit(1)
 	}
 
-	tables, err := lexgen.GenerateTables(string(inputBytes), &lexgen.LexTableOptions{SourceName: absPath})
+	tables, err := lexgen.GenerateTables(string(inputBytes), &lexgen.LexTableOptions{
+		SourceName:     absPath,
+		Minimize:       minimize,
+		RejectShadowed: shadowErrors,
+	})
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
+	for _, shadow := range tables.Shadows {
+		if shadow.Full || overlaps {
+			fmt.Fprintf(os.Stderr, "%s: warning: %s\n", os.Args[0], shadow.Summary())
+		}
+	}
+	if minimize {
+		fmt.Fprintf(os.Stderr, "%s: %s: %d DFA states, %d after minimization\n",
+			os.Args[0], inputPath, tables.DFAStates, tables.MinimizedStates)
+	}
 
 	jsonBytes, err := lexgen.EncodeTables(tables, nil)
 	if err != nil {
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
@@ -10,10 +11,11 @@ import (
 	"runtime/trace"
 
 	"github.com/johnkerl/pgpg/go/generators/pkg/parsegen"
+	"github.com/johnkerl/pgpg/go/generators/pkg/run"
 )
 
 func usage() {
-	fmt.Fprintf(os.Stderr, "Usage: %s [-o output.json] input.bnf\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "Usage: %s [-o output.json] [-lalr] [-resolve-conflicts] [-glr] [-messages file.messages] input.bnf\n", os.Args[0])
 	flag.PrintDefaults()
 	os.Exit(1)
 }
@@ -24,11 +26,22 @@ func main() {
 	var memProfilePath string
 	var tracePath string
 	var nosort bool
+	var lalr bool
+	var resolveConflicts bool
+	var glr bool
+	var messagesPath string
 	flag.StringVar(&outputPath, "o", "", "Output JSON file (default stdout)")
 	flag.StringVar(&cpuProfilePath, "cpuprofile", "", "Write CPU profile to file")
 	flag.StringVar(&memProfilePath, "memprofile", "", "Write memory profile to file")
 	flag.StringVar(&tracePath, "trace", "", "Write execution trace to file")
 	flag.BoolVar(&nosort, "nosort", false, "Skip sorting for faster output with nondeterministic ordering")
+	flag.BoolVar(&lalr, "lalr", false, "Build LALR(1) tables (merge LR(1) states with identical cores)")
+	flag.BoolVar(&resolveConflicts, "resolve-conflicts", false,
+		"Resolve conflicts by default (prefer shift, then earliest production) and warn, rather than failing")
+	flag.BoolVar(&glr, "glr", false,
+		"Keep all actions of conflicting entries, for GLR parsing (implies -resolve-conflicts)")
+	flag.StringVar(&messagesPath, "messages", "",
+		"Messages file giving syntax error messages for the states its example sentences fail in")
 	flag.Usage = usage
 	flag.Parse()
 
@@ -42,12 +55,6 @@ func main() {
 	}
 	inputPath := flag.Arg(0)
 
-	inputBytes, err := os.ReadFile(inputPath)
-	if err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
-	}
-
 	absPath, err := filepath.Abs(inputPath)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -61,11 +68,29 @@ func main() {
 	}
 	defer stopProfile()
 
-	tables, err := parsegen.GenerateTables(string(inputBytes), &parsegen.ParseTableOptions{SourceName: absPath})
+	tables, err := run.ParsegenGenerateTables(context.Background(), inputPath, &run.ParsegenTablesOptions{
+		SourceName:       absPath,
+		LALR:             lalr,
+		ResolveConflicts: resolveConflicts,
+		GLR:              glr,
+		Messages:         messagesPath,
+	})
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
+	if resolveConflicts || glr {
+		for _, conflict := range tables.Conflicts {
+			fmt.Fprintf(os.Stderr, "%s: warning: %s\n", os.Args[0], conflict.Summary())
+		}
+	}
+
+	if messagesPath != "" {
+		for _, uncovered := range parsegen.UncoveredErrorStates(tables) {
+			fmt.Fprintf(os.Stderr, "%s: warning: state %d has no error message; e.g. %s\n",
+				os.Args[0], uncovered.State, uncovered.Sentence)
+		}
+	}
 
 	jsonBytes, err := parsegen.EncodeTables(tables, encodeOpts)
 	if err != nil {
@@ -0,0 +1,110 @@
+package lexgen
+
+import (
+	"fmt"
+)
+
+// TrailingContext gives, for an accepting state of a rule alternative with trailing context
+// ("r / s"), how much of the match is the token: its first Head runes, when r has a fixed
+// length, or else all but its last Tail runes, s having a fixed length. Exactly one is set.
+type TrailingContext struct {
+	Head int `json:"head,omitempty"`
+	Tail int `json:"tail,omitempty"`
+}
+
+// tokenAlternative is an alternative of a token rule: a regex, matched only at the start of a
+// line if lineStart, and only when followed by context if context is non-nil.
+type tokenAlternative struct {
+	regex     *regexNode
+	context   *regexNode
+	lineStart bool
+	trailing  TrailingContext
+}
+
+// splitTokenAlternatives returns a token rule's alternatives. A rule without line anchors or
+// trailing context is a single alternative, its whole regex; otherwise these may only appear at
+// the top level of each of its alternatives.
+func splitTokenAlternatives(node *regexNode) ([]tokenAlternative, error) {
+	if !hasAnchors(node) {
+		return []tokenAlternative{{regex: node}}, nil
+	}
+	children := []*regexNode{node}
+	if node.kind == regexAlternate {
+		children = node.children
+	}
+	var alternatives []tokenAlternative
+	for _, child := range children {
+		var alternative tokenAlternative
+		if child.kind == regexLineStart {
+			alternative.lineStart = true
+			child = child.children[0]
+		}
+		if child.kind == regexTrailingContext {
+			alternative.context = child.children[1]
+			child = child.children[0]
+		}
+		alternative.regex = child
+		if hasAnchors(alternative.regex) || (alternative.context != nil && hasAnchors(alternative.context)) {
+			return nil, fmt.Errorf("\"^\" and \"/\" are only allowed at the start and middle of a token rule's alternatives")
+		}
+		if alternative.context != nil {
+			if canBeEmpty(alternative.context) {
+				return nil, fmt.Errorf("trailing context can match empty input")
+			}
+			if length, ok := fixedLength(alternative.regex); ok {
+				alternative.trailing.Head = length
+			} else if length, ok := fixedLength(alternative.context); ok {
+				alternative.trailing.Tail = length
+			} else {
+				return nil, fmt.Errorf("trailing context needs it or what precedes it to have a fixed length")
+			}
+		}
+		alternatives = append(alternatives, alternative)
+	}
+	return alternatives, nil
+}
+
+// hasAnchors tells whether node has line anchors or trailing context anywhere within it.
+func hasAnchors(node *regexNode) bool {
+	if node.kind == regexLineStart || node.kind == regexTrailingContext {
+		return true
+	}
+	for _, child := range node.children {
+		if hasAnchors(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// fixedLength returns the number of runes node matches, if all its matches have the same length.
+func fixedLength(node *regexNode) (int, bool) {
+	switch node.kind {
+	case regexLiteral:
+		return len([]rune(node.literal)), true
+	case regexRange:
+		return 1, true
+	case regexConcat:
+		total := 0
+		for _, child := range node.children {
+			length, ok := fixedLength(child)
+			if !ok {
+				return 0, false
+			}
+			total += length
+		}
+		return total, true
+	case regexAlternate:
+		length := -1
+		for _, child := range node.children {
+			childLength, ok := fixedLength(child)
+			if !ok || (length >= 0 && childLength != length) {
+				return 0, false
+			}
+			length = childLength
+		}
+		return length, length >= 0
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,94 @@
+package lexgen
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
+)
+
+// anchorGrammar lexes ranges like "1..2" as int, dotdot, int, where the longest match alone
+// would find a float "1." and fail at ".2"; comments starting lines; and labels, words followed
+// by colons.
+const anchorGrammar = `
+!ws ::= " " | "\n" ;
+_digits ::= "0"-"9" { "0"-"9" } ;
+int ::= _digits / ".." | _digits ;
+float ::= _digits "." { "0"-"9" } ;
+dotdot ::= ".." ;
+comment ::= ^ "#" { "a"-"z" | " " } ;
+hash ::= "#" ;
+label ::= "a"-"z" { "a"-"z" } / ":" ;
+word ::= "a"-"z" { "a"-"z" } ;
+colon ::= ":" ;
+`
+
+func TestAnchors(t *testing.T) {
+	for _, minimize := range []bool{false, true} {
+		tables, err := GenerateTables(anchorGrammar, &LexTableOptions{Minimize: minimize})
+		if err != nil {
+			t.Fatalf("GenerateTables: %v", err)
+		}
+		if len(tables.LineStartStates) != 1 {
+			t.Errorf("LineStartStates: got %v", tables.LineStartStates)
+		}
+		runtime, err := RuntimeTables(tables)
+		if err != nil {
+			t.Fatalf("RuntimeTables: %v", err)
+		}
+		lexer := dfa.NewLexer(runtime, strings.NewReader("# top\n1..23 1.5 2. ab:c # x\n# y\nx"))
+		var got []string
+		for token := lexer.Scan(); !token.IsEOF(); token = lexer.Scan() {
+			if token.IsError() {
+				t.Fatalf("lex error: %s", string(token.Lexeme))
+			}
+			got = append(got, string(token.Type)+":"+string(token.Lexeme))
+		}
+		want := []string{
+			"comment:# top", "int:1", "dotdot:..", "int:23", "float:1.5", "float:2.",
+			"label:ab", "colon::", "word:c", "hash:#", "word:x", "comment:# y", "word:x",
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("minimize=%v tokens:\ngot  %q\nwant %q", minimize, got, want)
+		}
+	}
+}
+
+func TestAnchorsCode(t *testing.T) {
+	tables, err := GenerateTables(anchorGrammar, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables: %v", err)
+	}
+	code, err := GenerateCode(tables, LexCodegenOptions{Package: "lexers", Type: "AnchorLexer", Format: true})
+	if err != nil {
+		t.Fatalf("GenerateCode: %v", err)
+	}
+	for _, want := range []string{
+		"LineStarts: []int{",
+		"{Head: 0, Tail: 2}",
+		"{Head: 0, Tail: 1}",
+	} {
+		if !strings.Contains(string(code), want) {
+			t.Errorf("generated code should contain %q", want)
+		}
+	}
+}
+
+func TestAnchorsErrors(t *testing.T) {
+	for _, tc := range []struct {
+		grammar string
+		want    string
+	}{
+		{`_a ::= "a" / "b" ; a ::= _a ;`, `"^" and "/" are only allowed in token rules`},
+		{`a ::= ( "a" / "b" ) "c" ;`, "only allowed at the start and middle"},
+		{`a ::= "a" ( ^ "b" ) ;`, "only allowed at the start and middle"},
+		{`a ::= "a" / [ "b" ] ;`, "trailing context can match empty input"},
+		{`a ::= "a" { "a" } / "b" { "b" } ;`, "fixed length"},
+	} {
+		_, err := GenerateTables(tc.grammar, nil)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: got error %v, want %q", tc.grammar, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package lexgen
+
+import (
+	"fmt"
+	"sync"
+	"unicode"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+)
+
+// extractCaseless returns the lexer rules named by the grammar's %caseless directives.
+func extractCaseless(ast *asts.AST, lexerRuleSet map[string]bool) (map[string]bool, error) {
+	caseless := map[string]bool{}
+	for _, node := range ast.RootNode.Children {
+		if node.Type != parsers.EBNFParserNodeTypeDirective || parsers.DirectiveName(node) != parsers.EBNFDirectiveCaseless {
+			continue
+		}
+		if len(node.Children) == 0 {
+			return nil, fmt.Errorf("%%%s: expected one or more lexer rules", parsers.EBNFDirectiveCaseless)
+		}
+		for _, arg := range node.Children {
+			if arg.Type != parsers.EBNFParserNodeTypeIdentifier {
+				return nil, fmt.Errorf("%%%s: expected identifier, got %q", parsers.EBNFDirectiveCaseless, arg.Token.LexemeText())
+			}
+			rule := arg.Token.LexemeText()
+			if !lexerRuleSet[rule] {
+				return nil, fmt.Errorf("%%%s: %q is not a lexer rule", parsers.EBNFDirectiveCaseless, rule)
+			}
+			caseless[rule] = true
+		}
+	}
+	return caseless, nil
+}
+
+// foldCase returns a regex matching whatever node matches with any of its letters in any case,
+// using Unicode simple case folding as Go's regexp does for (?i): "k" matches "k", "K", and the
+// Kelvin sign, for instance. Literals become concatenations of the runs of runes without other
+// cases and the character sets of those with.
+func foldCase(node *regexNode) *regexNode {
+	switch node.kind {
+	case regexLiteral:
+		var parts []*regexNode
+		var plain []rune
+		for _, r := range node.literal {
+			set := foldRuneSet(runeSet{{r, r}})
+			if len(set) == 1 && set[0].from == set[0].to {
+				plain = append(plain, r)
+				continue
+			}
+			if len(plain) > 0 {
+				parts = append(parts, &regexNode{kind: regexLiteral, literal: string(plain)})
+				plain = nil
+			}
+			parts = append(parts, set.regex())
+		}
+		if len(plain) > 0 {
+			parts = append(parts, &regexNode{kind: regexLiteral, literal: string(plain)})
+		}
+		switch len(parts) {
+		case 0:
+			return node
+		case 1:
+			return parts[0]
+		default:
+			return &regexNode{kind: regexConcat, children: parts}
+		}
+	case regexRange:
+		return foldRuneSet(runeSet{{node.from, node.to}}).regex()
+	default:
+		folded := &regexNode{kind: node.kind, literal: node.literal, from: node.from, to: node.to}
+		for _, child := range node.children {
+			folded.children = append(folded.children, foldCase(child))
+		}
+		return folded
+	}
+}
+
+// foldRuneSet returns set with the other cases of its runes added.
+func foldRuneSet(set runeSet) runeSet {
+	ranges := append([]runeRange(nil), set...)
+	for _, orbit := range caseOrbits() {
+		for _, r := range orbit {
+			if set.contains(r) {
+				for _, other := range orbit {
+					ranges = append(ranges, runeRange{other, other})
+				}
+				break
+			}
+		}
+	}
+	return newRuneSet(ranges...)
+}
+
+// caseOrbits returns the sets of runes which are each other's other cases under simple case
+// folding, each with more than one rune. Each has a rune with a case mapping in
+// unicode.CaseRanges (ß has none, but ẞ does), so the orbits are found from the runes there.
+var caseOrbits = sync.OnceValue(func() [][]rune {
+	var orbits [][]rune
+	seen := map[rune]bool{}
+	for _, caseRange := range unicode.CaseRanges {
+		for c := rune(caseRange.Lo); c <= rune(caseRange.Hi); c++ {
+			if seen[c] || unicode.SimpleFold(c) == c {
+				continue
+			}
+			orbit := []rune{c}
+			for f := unicode.SimpleFold(c); f != c; f = unicode.SimpleFold(f) {
+				orbit = append(orbit, f)
+			}
+			for _, r := range orbit {
+				seen[r] = true
+			}
+			orbits = append(orbits, orbit)
+		}
+	}
+	return orbits
+})
@@ -0,0 +1,83 @@
+package lexgen
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
+)
+
+func TestCaseless(t *testing.T) {
+	tables, err := GenerateTables(`
+%caseless hex ;
+!ws ::= " " ;
+end ::= "end"i ;
+begin ::= "BEGIN"i ;
+hex ::= "0x" ( "0"-"9" | "a"-"f" ) { "0"-"9" | "a"-"f" } ;
+id ::= "a"-"z" { "a"-"z" } ;
+`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables: %v", err)
+	}
+	for rule, want := range map[string]string{
+		"end": `("E" | "e") ("N" | "n") ("D" | "d")`,
+		"hex": `"0" ("X" | "x") ('0'-'9' | ('A'-'F' | 'a'-'f')) (('0'-'9' | ('A'-'F' | 'a'-'f')))*`,
+	} {
+		if got := tables.Rules[rule]; got != want {
+			t.Errorf("rule %q:\ngot  %s\nwant %s", rule, got, want)
+		}
+	}
+
+	runtime, err := RuntimeTables(tables)
+	if err != nil {
+		t.Fatalf("RuntimeTables: %v", err)
+	}
+	lexer := dfa.NewLexer(runtime, strings.NewReader("END end End begin BeGiN endx 0XfF 0xab"))
+	var got []string
+	for token := lexer.Scan(); !token.IsEOF(); token = lexer.Scan() {
+		if token.IsError() {
+			t.Fatalf("lex error: %s", string(token.Lexeme))
+		}
+		got = append(got, string(token.Type)+":"+string(token.Lexeme))
+	}
+	want := []string{
+		"end:END", "end:end", "end:End", "begin:begin", "begin:BeGiN", "id:endx", "hex:0XfF", "hex:0xab",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("tokens:\ngot  %q\nwant %q", got, want)
+	}
+}
+
+func TestFoldCase(t *testing.T) {
+	for _, tc := range []struct {
+		literal string
+		want    string
+	}{
+		{"a1", `("A" | "a") "1"`},
+		{"k", "(\"K\" | \"k\" | \"\u212a\")"}, // and the Kelvin sign
+		{"ß", `("ß" | "ẞ")`},
+		{"+-", `"+-"`},
+	} {
+		got := regexToString(foldCase(&regexNode{kind: regexLiteral, literal: tc.literal}))
+		if got != tc.want {
+			t.Errorf("%q: got %s, want %s", tc.literal, got, tc.want)
+		}
+	}
+}
+
+func TestCaselessErrors(t *testing.T) {
+	for _, tc := range []struct {
+		grammar string
+		want    string
+	}{
+		{`%caseless ; a ::= "a" ;`, "expected one or more lexer rules"},
+		{`%caseless "a" ; a ::= "a" ;`, "expected identifier"},
+		{`%caseless A ; a ::= "a" ; A ::= a ;`, `"A" is not a lexer rule`},
+	} {
+		_, err := GenerateTables(tc.grammar, nil)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: got error %v, want %q", tc.grammar, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package lexgen
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// runeRange is an inclusive range of runes.
+type runeRange struct {
+	from, to rune
+}
+
+// runeSet is a set of runes, as sorted, disjoint, non-adjacent ranges.
+type runeSet []runeRange
+
+// newRuneSet returns the set of runes in any of ranges.
+func newRuneSet(ranges ...runeRange) runeSet {
+	sorted := append([]runeRange(nil), ranges...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].from < sorted[j].from })
+	var set runeSet
+	for _, r := range sorted {
+		if last := len(set) - 1; last >= 0 && r.from <= set[last].to+1 {
+			set[last].to = max(set[last].to, r.to)
+			continue
+		}
+		set = append(set, r)
+	}
+	return set
+}
+
+// charSet returns the set of runes node matches, if it matches exactly one rune: a single-rune
+// literal, a range, or an alternation of these, such as a wildcard or another set's regex.
+func charSet(node *regexNode) (runeSet, bool) {
+	switch node.kind {
+	case regexLiteral:
+		runes := []rune(node.literal)
+		if len(runes) != 1 {
+			return nil, false
+		}
+		return runeSet{{runes[0], runes[0]}}, true
+	case regexRange:
+		return runeSet{{node.from, node.to}}, true
+	case regexAlternate:
+		var ranges []runeRange
+		for _, child := range node.children {
+			set, ok := charSet(child)
+			if !ok {
+				return nil, false
+			}
+			ranges = append(ranges, set...)
+		}
+		return newRuneSet(ranges...), true
+	default:
+		return nil, false
+	}
+}
+
+// contains tells whether r is in set.
+func (set runeSet) contains(r rune) bool {
+	i := sort.Search(len(set), func(i int) bool { return set[i].to >= r })
+	return i < len(set) && set[i].from <= r
+}
+
+// complement returns the runes not in set.
+func (set runeSet) complement() runeSet {
+	var out runeSet
+	next := rune(0)
+	for _, r := range set {
+		if r.from > next {
+			out = append(out, runeRange{next, r.from - 1})
+		}
+		next = r.to + 1
+	}
+	if next <= utf8.MaxRune {
+		out = append(out, runeRange{next, utf8.MaxRune})
+	}
+	return out
+}
+
+// minus returns the runes in set but not in other.
+func (set runeSet) minus(other runeSet) runeSet {
+	exclude := other.complement()
+	var out runeSet
+	for i, j := 0, 0; i < len(set) && j < len(exclude); {
+		from, to := max(set[i].from, exclude[j].from), min(set[i].to, exclude[j].to)
+		if from <= to {
+			out = append(out, runeRange{from, to})
+		}
+		if set[i].to < exclude[j].to {
+			i++
+		} else {
+			j++
+		}
+	}
+	return out
+}
+
+// regex returns a regex matching any rune in set, which must not be empty.
+func (set runeSet) regex() *regexNode {
+	var children []*regexNode
+	for _, r := range set {
+		if r.from == r.to && utf8.ValidRune(r.from) {
+			children = append(children, &regexNode{kind: regexLiteral, literal: string(r.from)})
+		} else {
+			children = append(children, &regexNode{kind: regexRange, from: r.from, to: r.to})
+		}
+	}
+	if len(children) == 1 {
+		return children[0]
+	}
+	return &regexNode{kind: regexAlternate, children: children}
+}
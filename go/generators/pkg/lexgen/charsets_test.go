@@ -0,0 +1,47 @@
+package lexgen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCharSets(t *testing.T) {
+	tables, err := GenerateTables(`
+_vowel ::= "a" | "e" | "i" | "o" | "u" ;
+consonant ::= "a"-"z" - _vowel - "y" ;
+newline ::= ~ ( . | "\r" ) ;
+digit ::= "0"-"9" - "5"-"9" - "0" ;
+text ::= . - ( "\"" | "\\" ) ;
+`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables: %v", err)
+	}
+	for rule, want := range map[string]string{
+		"consonant": `('b'-'d' | 'f'-'h' | 'j'-'n' | 'p'-'t' | 'v'-'x' | "z")`,
+		"newline":   `"\n"`,
+		"digit":     `'1'-'4'`,
+		"text":      `('\x00'-'\t' | '\v'-'\f' | '\x0e'-'!' | '#'-'[' | ']'-'\U0010ffff')`,
+	} {
+		if got := tables.Rules[rule]; got != want {
+			t.Errorf("rule %q:\ngot  %s\nwant %s", rule, got, want)
+		}
+	}
+}
+
+func TestCharSetsErrors(t *testing.T) {
+	for _, tc := range []struct {
+		grammar string
+		want    string
+	}{
+		{`a ::= . - "ab" ;`, `operands of '-' must match single characters, not "ab"`},
+		{`a ::= ( "a" | "bc" ) - "a" ;`, "operands of '-' must match single characters"},
+		{`a ::= ~ { "a" } ;`, "operand of '~' must match single characters"},
+		{`a ::= "a"-"c" - "a"-"z" ;`, "difference matches no characters"},
+		{`a ::= ~ ( . | "\n" | "\r" ) ;`, "complement matches no characters"},
+	} {
+		_, err := GenerateTables(tc.grammar, nil)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: got error %v, want %q", tc.grammar, err, tc.want)
+		}
+	}
+}
@@ -5,11 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"go/format"
-	"sort"
 	"strconv"
 	"strings"
 	"text/template"
 
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
+
 	_ "embed"
 )
 
@@ -17,14 +18,31 @@ type lexerTemplateData struct {
 	PackageName string
 	TypeName    string
 	StartState  int
-	HasIgnored  bool
-	Transitions []lexerTransitionState
-	Actions     []lexerActionState
+	// ASCIIClasses and ASCIINext are the dfa.Tables slices, as lines of comma-separated values, with
+	// a line per state for ASCIINext.
+	ASCIIClasses    []string
+	ASCIIClassCount int
+	ASCIINext       []string
+	NonASCII        []lexerNonASCIIState
+	Actions         []lexerActionState
+	Ignored         []int
+	// Modes are the quoted mode names, and ModeStarts their start states, for lexers with modes.
+	Modes       []string
+	ModeStarts  []int
+	ModeActions []lexerModeActionState
+	// LineStarts and TrailingContexts are for lexers with rules using "^" and "/".
+	LineStarts       []int
+	TrailingContexts []lexerTrailingContextState
+}
+
+type lexerTrailingContextState struct {
+	State   int
+	Context dfa.TrailingContext
 }
 
-type lexerTransitionState struct {
+type lexerNonASCIIState struct {
 	State  int
-	Ranges []RangeTransition
+	Ranges []dfa.Transition
 }
 
 type lexerActionState struct {
@@ -32,6 +50,19 @@ type lexerActionState struct {
 	TokenType string
 }
 
+type lexerModeActionState struct {
+	State int
+	// Kind is the dfa.ModeActionKind constant's name.
+	Kind string
+	Mode int
+}
+
+var modeActionKindNames = map[dfa.ModeActionKind]string{
+	dfa.ModePush:   "dfa.ModePush",
+	dfa.ModePop:    "dfa.ModePop",
+	dfa.ModeSwitch: "dfa.ModeSwitch",
+}
+
 //go:embed templates/lexer.go.tmpl
 var lexerTemplateText string
 
@@ -57,6 +88,16 @@ func DecodeTables(data []byte) (*Tables, error) {
 	return &tables, nil
 }
 
+// RuntimeTables converts tables to those run by the dfa package, for lexers run from tables
+// built in memory rather than from generated code.
+func RuntimeTables(tables *Tables) (*dfa.Tables, error) {
+	data, err := EncodeTables(tables, &EncodeOptions{Sort: false})
+	if err != nil {
+		return nil, err
+	}
+	return dfa.DecodeTables(data)
+}
+
 // GenerateCode creates Go source implementing a lexer from tables.
 // When opts.Format is true, output is run through go/format.Source.
 func GenerateCode(tables *Tables, opts LexCodegenOptions) ([]byte, error) {
@@ -84,21 +125,51 @@ func GenerateCode(tables *Tables, opts LexCodegenOptions) ([]byte, error) {
 }
 
 func generateCodeRaw(tables *Tables, packageName string, typeName string) ([]byte, error) {
-	hasIgnoredActions := false
-	for _, action := range tables.Actions {
-		if strings.HasPrefix(action, "!") {
-			hasIgnoredActions = true
-			break
-		}
+	runtime, err := RuntimeTables(tables)
+	if err != nil {
+		return nil, err
 	}
-
 	data := lexerTemplateData{
-		PackageName: packageName,
-		TypeName:    typeName,
-		StartState:  tables.StartState,
-		HasIgnored:  hasIgnoredActions,
-		Transitions: buildLexerTransitions(tables),
-		Actions:     buildLexerActions(tables),
+		PackageName:     packageName,
+		TypeName:        typeName,
+		StartState:      runtime.StartState,
+		ASCIIClasses:    valueLines(runtime.ASCIIClasses, 16),
+		ASCIIClassCount: runtime.ASCIIClassCount,
+		ASCIINext:       valueLines(runtime.ASCIINext, runtime.ASCIIClassCount),
+	}
+	for state, ranges := range runtime.NonASCII {
+		if len(ranges) > 0 {
+			data.NonASCII = append(data.NonASCII, lexerNonASCIIState{State: state, Ranges: ranges})
+		}
+	}
+	for state, tokenType := range runtime.Actions {
+		if tokenType != "" {
+			data.Actions = append(data.Actions, lexerActionState{State: state, TokenType: string(tokenType)})
+		}
+	}
+	for state, ignored := range runtime.Ignored {
+		if ignored {
+			data.Ignored = append(data.Ignored, state)
+		}
+	}
+	for _, mode := range runtime.Modes {
+		data.Modes = append(data.Modes, strconv.Quote(mode))
+	}
+	data.ModeStarts = runtime.ModeStarts
+	for state, action := range runtime.ModeActions {
+		if action.Kind != dfa.ModeNone {
+			data.ModeActions = append(data.ModeActions, lexerModeActionState{
+				State: state,
+				Kind:  modeActionKindNames[action.Kind],
+				Mode:  action.Mode,
+			})
+		}
+	}
+	data.LineStarts = runtime.LineStarts
+	for state, context := range runtime.TrailingContexts {
+		if context != (dfa.TrailingContext{}) {
+			data.TrailingContexts = append(data.TrailingContexts, lexerTrailingContextState{State: state, Context: context})
+		}
 	}
 
 	var buf bytes.Buffer
@@ -108,43 +179,16 @@ func generateCodeRaw(tables *Tables, packageName string, typeName string) ([]byt
 	return buf.Bytes(), nil
 }
 
-func buildLexerTransitions(tables *Tables) []lexerTransitionState {
-	stateIDs := make([]int, 0, len(tables.Transitions))
-	for state := range tables.Transitions {
-		stateIDs = append(stateIDs, state)
-	}
-	sort.Ints(stateIDs)
-
-	out := make([]lexerTransitionState, 0, len(stateIDs))
-	for _, state := range stateIDs {
-		ranges := tables.Transitions[state]
-		sort.Slice(ranges, func(i, j int) bool {
-			if ranges[i].From == ranges[j].From {
-				return ranges[i].To < ranges[j].To
-			}
-			return ranges[i].From < ranges[j].From
-		})
-		out = append(out, lexerTransitionState{
-			State:  state,
-			Ranges: ranges,
-		})
-	}
-	return out
-}
-
-func buildLexerActions(tables *Tables) []lexerActionState {
-	stateIDs := make([]int, 0, len(tables.Actions))
-	for state := range tables.Actions {
-		stateIDs = append(stateIDs, state)
-	}
-	sort.Ints(stateIDs)
-
-	out := make([]lexerActionState, 0, len(stateIDs))
-	for _, state := range stateIDs {
-		out = append(out, lexerActionState{
-			State:     state,
-			TokenType: tables.Actions[state],
-		})
+// valueLines formats values as lines of a Go composite literal, perLine to a line, each line with
+// a trailing comma.
+func valueLines[T int32 | uint8](values []T, perLine int) []string {
+	var lines []string
+	for start := 0; start < len(values); start += perLine {
+		parts := make([]string, 0, perLine)
+		for _, value := range values[start:min(start+perLine, len(values))] {
+			parts = append(parts, strconv.Itoa(int(value)))
+		}
+		lines = append(lines, strings.Join(parts, ", ")+",")
 	}
-	return out
+	return lines
 }
@@ -22,3 +22,24 @@ func TestGenerateGoLexerCodeFormats(t *testing.T) {
 		t.Fatalf("generated code missing package declaration")
 	}
 }
+
+func TestGenerateGoLexerCodeTables(t *testing.T) {
+	tables, err := GenerateTables(`!ws ::= " " ; word ::= ("a"-"z" | "\u00e0"-"\u00ff") { "a"-"z" } ;`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
+	}
+	code, err := GenerateCode(tables, LexCodegenOptions{Package: "lexers", Type: "WordLexer", Format: true})
+	if err != nil {
+		t.Fatalf("GenerateCode() error: %v", err)
+	}
+	for _, want := range []string{
+		"ASCIIClassCount: 3,",
+		"{From: 'à', To: 'ÿ', Next: ",
+		`: "!ws",`,
+		": true,",
+	} {
+		if !strings.Contains(string(code), want) {
+			t.Errorf("generated code should contain %q", want)
+		}
+	}
+}
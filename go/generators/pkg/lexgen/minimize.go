@@ -0,0 +1,223 @@
+package lexgen
+
+import (
+	"fmt"
+	"sort"
+)
+
+// minimizeDFA merges equivalent DFA states of tables, whose Transitions, Actions, and
+// TrailingContexts it replaces and whose MinimizedStates it sets, using Hopcroft's partition
+// refinement. States start out partitioned by the token type they accept ("" for non-accepting
+// states) and its trailing context, so states accepting different tokens are never merged. The alphabet is the set of rune classes bounded by the
+// transitions' range endpoints; missing transitions go to an implicit dead state, which is dropped
+// again afterward. Minimized states are numbered breadth-first from the start states, which come
+// first, following transitions in rune order, as buildDFA numbers them. The minimized start states
+// are returned in the order of starts.
+func minimizeDFA(tables *Tables, starts []int) []int {
+	transitions, actions, trailing := tables.Transitions, tables.Actions, tables.TrailingContexts
+	numStates := tables.DFAStates
+	dead := numStates
+	total := numStates + 1
+
+	// Rune classes: class i is [bounds[i], bounds[i+1]-1].
+	var bounds []rune
+	for _, trs := range transitions {
+		for _, tr := range trs {
+			bounds = append(bounds, tr.From, tr.To+1)
+		}
+	}
+	sort.Slice(bounds, func(i, j int) bool { return bounds[i] < bounds[j] })
+	bounds = uniqueRunes(bounds)
+	numClasses := len(bounds) - 1
+	if numClasses < 1 {
+		numClasses = 1
+	}
+	classOf := func(r rune) int {
+		return sort.Search(len(bounds), func(i int) bool { return bounds[i] > r }) - 1
+	}
+
+	// Inverse transitions over runs of classes, including those into the dead state.
+	type inverseEdge struct {
+		lo, hi int
+		from   int
+	}
+	inverse := make([][]inverseEdge, total)
+	for state := 0; state < numStates; state++ {
+		next := 0
+		for _, tr := range transitions[state] {
+			lo, hi := classOf(tr.From), classOf(tr.To)
+			if lo > next {
+				inverse[dead] = append(inverse[dead], inverseEdge{lo: next, hi: lo - 1, from: state})
+			}
+			inverse[tr.Next] = append(inverse[tr.Next], inverseEdge{lo: lo, hi: hi, from: state})
+			next = hi + 1
+		}
+		if next < numClasses {
+			inverse[dead] = append(inverse[dead], inverseEdge{lo: next, hi: numClasses - 1, from: state})
+		}
+	}
+	inverse[dead] = append(inverse[dead], inverseEdge{lo: 0, hi: numClasses - 1, from: dead})
+
+	// Initial partition by accepted token type and trailing context.
+	blockOf := make([]int, total)
+	var blocks [][]int
+	blockByAction := map[string]int{}
+	for state := 0; state < total; state++ {
+		action := actions[state]
+		if context, ok := trailing[state]; ok {
+			action = fmt.Sprintf("%s/%d/%d", action, context.Head, context.Tail)
+		}
+		block, ok := blockByAction[action]
+		if !ok {
+			block = len(blocks)
+			blockByAction[action] = block
+			blocks = append(blocks, nil)
+		}
+		blockOf[state] = block
+		blocks[block] = append(blocks[block], state)
+	}
+
+	inWorklist := make([]bool, len(blocks))
+	worklist := make([]int, 0, len(blocks))
+	for block := range blocks {
+		worklist = append(worklist, block)
+		inWorklist[block] = true
+	}
+
+	predecessors := make([][]int, numClasses)
+	marked := make([]bool, total)
+	markedCount := make([]int, len(blocks))
+	for len(worklist) > 0 {
+		splitter := worklist[len(worklist)-1]
+		worklist = worklist[:len(worklist)-1]
+		inWorklist[splitter] = false
+
+		for class := range predecessors {
+			predecessors[class] = predecessors[class][:0]
+		}
+		for _, target := range blocks[splitter] {
+			for _, edge := range inverse[target] {
+				for class := edge.lo; class <= edge.hi; class++ {
+					predecessors[class] = append(predecessors[class], edge.from)
+				}
+			}
+		}
+
+		for _, preds := range predecessors {
+			if len(preds) == 0 {
+				continue
+			}
+			var touched []int
+			for _, state := range preds {
+				if marked[state] {
+					continue
+				}
+				marked[state] = true
+				block := blockOf[state]
+				if markedCount[block] == 0 {
+					touched = append(touched, block)
+				}
+				markedCount[block]++
+			}
+			for _, block := range touched {
+				count := markedCount[block]
+				markedCount[block] = 0
+				if count == len(blocks[block]) {
+					continue
+				}
+				var in, out []int
+				for _, state := range blocks[block] {
+					if marked[state] {
+						in = append(in, state)
+					} else {
+						out = append(out, state)
+					}
+				}
+				split := len(blocks)
+				blocks[block] = in
+				blocks = append(blocks, out)
+				inWorklist = append(inWorklist, false)
+				markedCount = append(markedCount, 0)
+				for _, state := range out {
+					blockOf[state] = split
+				}
+				switch {
+				case inWorklist[block]:
+					worklist = append(worklist, split)
+					inWorklist[split] = true
+				case len(in) <= len(out):
+					worklist = append(worklist, block)
+					inWorklist[block] = true
+				default:
+					worklist = append(worklist, split)
+					inWorklist[split] = true
+				}
+			}
+			for _, state := range preds {
+				marked[state] = false
+			}
+		}
+	}
+
+	// Renumber the live blocks breadth-first from the start states, taking each block's
+	// transitions from its first state.
+	deadBlock := blockOf[dead]
+	newID := make([]int, len(blocks))
+	for i := range newID {
+		newID[i] = -1
+	}
+	var order []int
+	newStarts := make([]int, len(starts))
+	for i, start := range starts {
+		block := blockOf[start]
+		if newID[block] < 0 {
+			newID[block] = len(order)
+			order = append(order, block)
+		}
+		newStarts[i] = newID[block]
+	}
+	outTransitions := map[int][]RangeTransition{}
+	outActions := map[int]string{}
+	var outTrailing map[int]TrailingContext
+	for i := 0; i < len(order); i++ {
+		block := order[i]
+		representative := blocks[block][0]
+		var trs []RangeTransition
+		for _, tr := range transitions[representative] {
+			target := blockOf[tr.Next]
+			if target == deadBlock {
+				continue
+			}
+			if newID[target] < 0 {
+				newID[target] = len(order)
+				order = append(order, target)
+			}
+			trs = append(trs, RangeTransition{From: tr.From, To: tr.To, Next: newID[target]})
+		}
+		if len(trs) > 0 {
+			outTransitions[i] = mergeRangeTransitions(trs)
+		}
+		if action, ok := actions[representative]; ok {
+			outActions[i] = action
+		}
+		if context, ok := trailing[representative]; ok {
+			if outTrailing == nil {
+				outTrailing = map[int]TrailingContext{}
+			}
+			outTrailing[i] = context
+		}
+	}
+	tables.Transitions, tables.Actions, tables.TrailingContexts = outTransitions, outActions, outTrailing
+	tables.MinimizedStates = len(order)
+	return newStarts
+}
+
+func uniqueRunes(sorted []rune) []rune {
+	out := sorted[:0]
+	for _, r := range sorted {
+		if len(out) == 0 || out[len(out)-1] != r {
+			out = append(out, r)
+		}
+	}
+	return out
+}
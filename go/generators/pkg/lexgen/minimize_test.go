@@ -0,0 +1,105 @@
+package lexgen
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestMinimize(t *testing.T) {
+	cases := []struct {
+		name    string
+		grammar string
+		states  int
+	}{
+		{
+			// The keywords' states after their first letters merge with the identifier's.
+			name:    "keywords",
+			grammar: `if ::= "if" ; in ::= "int" ; id ::= ("a"-"z") { "a"-"z" } ;`,
+			states:  6,
+		},
+		{
+			// States accepting different tokens are not merged, though their transitions agree.
+			name:    "distinct accepts",
+			grammar: `x ::= "x" ; y ::= "y" ; !ws ::= " " { " " } ;`,
+			states:  4,
+		},
+		{
+			name:    "numbers",
+			grammar: `num ::= ("0"-"9") { "0"-"9" } [ "." ("0"-"9") { "0"-"9" } ] ; dot ::= "." ;`,
+			states:  5,
+		},
+		{
+			name:    "non-ASCII",
+			grammar: `word ::= ("a"-"z" | "α"-"ω") { "a"-"z" | "α"-"ω" } ; ws ::= " " | "　" ;`,
+			states:  3,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			plain, err := GenerateTables(tc.grammar, nil)
+			if err != nil {
+				t.Fatalf("GenerateTables: %v", err)
+			}
+			minimized, err := GenerateTables(tc.grammar, &LexTableOptions{Minimize: true})
+			if err != nil {
+				t.Fatalf("GenerateTables(Minimize): %v", err)
+			}
+			if minimized.DFAStates != plain.DFAStates {
+				t.Errorf("DFAStates: got %d, want %d", minimized.DFAStates, plain.DFAStates)
+			}
+			if minimized.MinimizedStates != tc.states {
+				t.Errorf("MinimizedStates: got %d, want %d (from %d)",
+					minimized.MinimizedStates, tc.states, minimized.DFAStates)
+			}
+			if plain.MinimizedStates != 0 {
+				t.Errorf("MinimizedStates without Minimize: got %d", plain.MinimizedStates)
+			}
+			checkEquivalent(t, plain, minimized)
+		})
+	}
+}
+
+// checkEquivalent walks the two DFAs in step from their start states, checking that every pair of
+// states reached accepts the same token type and has transitions on the same runes.
+func checkEquivalent(t *testing.T, a, b *Tables) {
+	t.Helper()
+	type pair struct{ a, b int }
+	seen := map[pair]bool{{a.StartState, b.StartState}: true}
+	queue := []pair{{a.StartState, b.StartState}}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		if a.Actions[p.a] != b.Actions[p.b] {
+			t.Fatalf("states %d and %d accept %q and %q", p.a, p.b, a.Actions[p.a], b.Actions[p.b])
+		}
+		var bounds []rune
+		for _, tr := range a.Transitions[p.a] {
+			bounds = append(bounds, tr.From, tr.To+1)
+		}
+		for _, tr := range b.Transitions[p.b] {
+			bounds = append(bounds, tr.From, tr.To+1)
+		}
+		sort.Slice(bounds, func(i, j int) bool { return bounds[i] < bounds[j] })
+		for _, r := range bounds {
+			nextA, okA := nextState(a.Transitions[p.a], r)
+			nextB, okB := nextState(b.Transitions[p.b], r)
+			if okA != okB {
+				t.Fatalf("states %d and %d differ on %q", p.a, p.b, r)
+			}
+			next := pair{nextA, nextB}
+			if okA && !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+}
+
+func nextState(transitions []RangeTransition, r rune) (int, bool) {
+	for _, tr := range transitions {
+		if tr.From <= r && r <= tr.To {
+			return tr.Next, true
+		}
+	}
+	return 0, false
+}
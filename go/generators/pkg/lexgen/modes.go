@@ -0,0 +1,157 @@
+package lexgen
+
+import (
+	"fmt"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+)
+
+// DefaultMode is the lexer mode scanning starts in. It holds the token rules which no %mode
+// directive assigns to a mode, and those assigned to it by name.
+const DefaultMode = "default"
+
+// Mode is a lexer mode and the DFA state scanning begins in while the lexer is in it.
+type Mode struct {
+	Name       string `json:"name"`
+	StartState int    `json:"start_state"`
+}
+
+// ModeAction is the mode change a lexer makes after scanning a token: entering the mode Push,
+// returning to the mode it was in before the last push, or replacing the current mode with Switch.
+// Exactly one of the fields is set.
+type ModeAction struct {
+	Push   string `json:"push,omitempty"`
+	Pop    bool   `json:"pop,omitempty"`
+	Switch string `json:"switch,omitempty"`
+}
+
+// lexerModes are the modes declared by a grammar's %mode, %push, %pop, and %switch directives.
+type lexerModes struct {
+	// names lists the modes, DefaultMode first and the others in order of declaration.
+	names []string
+	// rules maps each mode to its token rules, in grammar order.
+	rules map[string][]string
+	// actions maps the token rules which change mode to their changes.
+	actions map[string]ModeAction
+}
+
+// extractModes reads the grammar's mode directives, returning nil if it has none. Token rules not
+// named in any %mode directive are in DefaultMode.
+func extractModes(ast *asts.AST, tokenRuleNames []string) (*lexerModes, error) {
+	isTokenRule := map[string]bool{}
+	for _, name := range tokenRuleNames {
+		isTokenRule[name] = true
+	}
+
+	modes := &lexerModes{
+		names:   []string{DefaultMode},
+		rules:   map[string][]string{},
+		actions: map[string]ModeAction{},
+	}
+	ruleModes := map[string]map[string]bool{}
+	found := false
+	var changes []*asts.ASTNode
+	for _, node := range ast.RootNode.Children {
+		if node.Type != parsers.EBNFParserNodeTypeDirective {
+			continue
+		}
+		name := parsers.DirectiveName(node)
+		switch name {
+		case parsers.EBNFDirectiveMode:
+			found = true
+			mode, rules, err := modeDirectiveArgs(node, true, isTokenRule)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := modes.rules[mode]; !ok && mode != DefaultMode {
+				modes.names = append(modes.names, mode)
+			}
+			modes.rules[mode] = nil
+			for _, rule := range rules {
+				if ruleModes[rule] == nil {
+					ruleModes[rule] = map[string]bool{}
+				}
+				ruleModes[rule][mode] = true
+			}
+		case parsers.EBNFDirectivePush, parsers.EBNFDirectivePop, parsers.EBNFDirectiveSwitch:
+			found = true
+			changes = append(changes, node)
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+
+	for _, rule := range tokenRuleNames {
+		if ruleModes[rule] == nil {
+			modes.rules[DefaultMode] = append(modes.rules[DefaultMode], rule)
+			continue
+		}
+		for _, mode := range modes.names {
+			if ruleModes[rule][mode] {
+				modes.rules[mode] = append(modes.rules[mode], rule)
+			}
+		}
+	}
+	for _, mode := range modes.names {
+		if len(modes.rules[mode]) == 0 {
+			return nil, fmt.Errorf("mode %q has no token rules", mode)
+		}
+	}
+
+	for _, node := range changes {
+		name := parsers.DirectiveName(node)
+		mode, rules, err := modeDirectiveArgs(node, name != parsers.EBNFDirectivePop, isTokenRule)
+		if err != nil {
+			return nil, err
+		}
+		if name != parsers.EBNFDirectivePop {
+			if _, ok := modes.rules[mode]; !ok {
+				return nil, fmt.Errorf("%%%s: unknown mode %q", name, mode)
+			}
+		}
+		for _, rule := range rules {
+			if _, ok := modes.actions[rule]; ok {
+				return nil, fmt.Errorf("%%%s: token rule %q already changes mode", name, rule)
+			}
+			switch name {
+			case parsers.EBNFDirectivePush:
+				modes.actions[rule] = ModeAction{Push: mode}
+			case parsers.EBNFDirectivePop:
+				modes.actions[rule] = ModeAction{Pop: true}
+			case parsers.EBNFDirectiveSwitch:
+				modes.actions[rule] = ModeAction{Switch: mode}
+			}
+		}
+	}
+	return modes, nil
+}
+
+// modeDirectiveArgs returns a mode directive's mode name, if withMode, and its token rules.
+func modeDirectiveArgs(node *asts.ASTNode, withMode bool, isTokenRule map[string]bool) (string, []string, error) {
+	name := parsers.DirectiveName(node)
+	var args []string
+	for _, arg := range node.Children {
+		if arg.Type != parsers.EBNFParserNodeTypeIdentifier {
+			return "", nil, fmt.Errorf("%%%s: expected identifier, got %q", name, arg.Token.LexemeText())
+		}
+		args = append(args, arg.Token.LexemeText())
+	}
+	mode := ""
+	if withMode {
+		if len(args) == 0 {
+			return "", nil, fmt.Errorf("%%%s: expected a mode name", name)
+		}
+		mode, args = args[0], args[1:]
+	}
+	if len(args) == 0 {
+		return "", nil, fmt.Errorf("%%%s: expected one or more token rules", name)
+	}
+	for _, rule := range args {
+		if !isTokenRule[rule] {
+			return "", nil, fmt.Errorf("%%%s: %q is not a lexer token rule", name, rule)
+		}
+	}
+	return mode, args, nil
+}
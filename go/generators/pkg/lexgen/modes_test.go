@@ -0,0 +1,117 @@
+package lexgen
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
+)
+
+// interpGrammar lexes strings with interpolated expressions, as in "a ${x + "b"} c". Braces in
+// expressions push the default mode too, so that the closing brace of an interpolation pops
+// back to the string.
+const interpGrammar = `
+%mode string text interp close ;
+%push string open ;
+%push default interp lbrace ;
+%pop close rbrace ;
+!ws ::= " " { " " } ;
+id ::= "a"-"z" { "a"-"z" } ;
+plus ::= "+" ;
+open ::= "\"" ;
+lbrace ::= "{" ;
+rbrace ::= "}" ;
+text ::= ( "a"-"z" | " " | "{" | "}" ) { "a"-"z" | " " | "{" | "}" } ;
+interp ::= "${" ;
+close ::= "\"" ;
+`
+
+func TestModes(t *testing.T) {
+	for _, minimize := range []bool{false, true} {
+		tables, err := GenerateTables(interpGrammar, &LexTableOptions{Minimize: minimize})
+		if err != nil {
+			t.Fatalf("GenerateTables: %v", err)
+		}
+		if len(tables.Modes) != 2 || tables.Modes[0].Name != DefaultMode || tables.Modes[1].Name != "string" {
+			t.Fatalf("Modes: got %+v", tables.Modes)
+		}
+		if tables.Modes[0].StartState != tables.StartState {
+			t.Errorf("default mode starts at %d, not the start state %d", tables.Modes[0].StartState, tables.StartState)
+		}
+		wantActions := map[string]ModeAction{
+			"open":   {Push: "string"},
+			"interp": {Push: DefaultMode},
+			"lbrace": {Push: DefaultMode},
+			"close":  {Pop: true},
+			"rbrace": {Pop: true},
+		}
+		if !reflect.DeepEqual(tables.ModeActions, wantActions) {
+			t.Errorf("ModeActions: got %+v", tables.ModeActions)
+		}
+
+		runtime, err := RuntimeTables(tables)
+		if err != nil {
+			t.Fatalf("RuntimeTables: %v", err)
+		}
+		lexer := dfa.NewLexer(runtime, strings.NewReader(`"a {b} ${x + "c ${ {y} }"} d" e`))
+		var got []string
+		for token := lexer.Scan(); !token.IsEOF(); token = lexer.Scan() {
+			if token.IsError() {
+				t.Fatalf("lex error: %s", string(token.Lexeme))
+			}
+			got = append(got, string(token.Type)+":"+string(token.Lexeme))
+		}
+		want := []string{
+			`open:"`, "text:a {b} ", "interp:${", "id:x", "plus:+", `open:"`, "text:c ",
+			"interp:${", "lbrace:{", "id:y", "rbrace:}", "rbrace:}", `close:"`, "rbrace:}",
+			"text: d", `close:"`, "id:e",
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("minimize=%v tokens:\ngot  %q\nwant %q", minimize, got, want)
+		}
+	}
+}
+
+func TestModesCode(t *testing.T) {
+	tables, err := GenerateTables(interpGrammar, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables: %v", err)
+	}
+	code, err := GenerateCode(tables, LexCodegenOptions{Package: "lexers", Type: "InterpLexer", Format: true})
+	if err != nil {
+		t.Fatalf("GenerateCode: %v", err)
+	}
+	for _, want := range []string{
+		`"default",`,
+		`"string",`,
+		"ModeStarts: []int{",
+		"{Kind: dfa.ModePush, Mode: 1}",
+		"{Kind: dfa.ModePop, Mode: 0}",
+	} {
+		if !strings.Contains(string(code), want) {
+			t.Errorf("generated code should contain %q", want)
+		}
+	}
+}
+
+func TestModesErrors(t *testing.T) {
+	const rules = ` a ::= "a" ; b ::= "b" ; _c ::= "c" ; `
+	for _, tc := range []struct {
+		grammar string
+		want    string
+	}{
+		{`%mode m ;` + rules, "expected one or more token rules"},
+		{`%mode m x ;` + rules, `"x" is not a lexer token rule`},
+		{`%mode m _c ;` + rules, `"_c" is not a lexer token rule`},
+		{`%mode m a b ;` + rules, `mode "default" has no token rules`},
+		{`%mode m a ; %push n b ;` + rules, `unknown mode "n"`},
+		{`%mode m a ; %push m b ; %pop b ;` + rules, `"b" already changes mode`},
+		{`%mode m "a" ;` + rules, "expected identifier"},
+	} {
+		_, err := GenerateTables(tc.grammar, nil)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: got error %v, want %q", tc.grammar, err, tc.want)
+		}
+	}
+}
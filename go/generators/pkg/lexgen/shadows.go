@@ -0,0 +1,101 @@
+package lexgen
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Shadow is a token rule which loses to one listed before it on lexemes both match, since ties in
+// match length go to the rule listed first. This is what a keyword rule wants of a more general
+// identifier rule listed after it, but a keyword rule listed after the identifier rule never wins.
+type Shadow struct {
+	// Rule loses to By on Example, a shortest lexeme on which it does.
+	Rule    string
+	By      string
+	Example string
+	// Full is set if Rule wins on no lexeme at all, so that the lexer never returns its tokens.
+	Full bool
+}
+
+// Summary describes the shadowing in a line.
+func (shadow *Shadow) Summary() string {
+	if shadow.Full {
+		return fmt.Sprintf("token rule %q is shadowed by %q, listed before it, and never matches; e.g. %q is %s",
+			shadow.Rule, shadow.By, shadow.Example, shadow.By)
+	}
+	return fmt.Sprintf("token rule %q overlaps %q, listed before it, which wins on e.g. %q",
+		shadow.Rule, shadow.By, shadow.Example)
+}
+
+// findShadows finds the token rules which lose to others on some lexemes, from the subset
+// construction's DFA, whose states' NFA sets give every rule matching the lexemes reaching them.
+// Shadows are ordered by the losing rule's priority, then the winning rule's.
+func findShadows(dfa *dfaResult, priorities map[string]int) []*Shadow {
+	// Breadth-first search gives a shortest lexeme reaching each state.
+	type step struct {
+		from int
+		r    rune
+	}
+	parents := make([]step, len(dfa.states))
+	reached := make([]bool, len(dfa.states))
+	var queue []int
+	for _, start := range dfa.startIDs {
+		if !reached[start] {
+			reached[start] = true
+			parents[start] = step{from: -1}
+			queue = append(queue, start)
+		}
+	}
+	lexeme := func(state int) string {
+		var runes []rune
+		for ; parents[state].from >= 0; state = parents[state].from {
+			runes = append(runes, parents[state].r)
+		}
+		for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+			runes[i], runes[j] = runes[j], runes[i]
+		}
+		return string(runes)
+	}
+
+	wins := map[string]bool{}
+	shadows := map[[2]string]*Shadow{}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		state := dfa.states[id]
+		for _, tr := range state.transitions {
+			if !reached[tr.Next] {
+				reached[tr.Next] = true
+				parents[tr.Next] = step{from: id, r: tr.From}
+				queue = append(queue, tr.Next)
+			}
+		}
+		if state.accept == nil {
+			continue
+		}
+		winner := state.accept.name
+		wins[winner] = true
+		for _, nfa := range state.nfaSet {
+			for _, accept := range nfa.accepts {
+				key := [2]string{accept.name, winner}
+				if accept.name == winner || shadows[key] != nil {
+					continue
+				}
+				shadows[key] = &Shadow{Rule: accept.name, By: winner, Example: lexeme(id)}
+			}
+		}
+	}
+
+	var out []*Shadow
+	for _, shadow := range shadows {
+		shadow.Full = !wins[shadow.Rule]
+		out = append(out, shadow)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Rule != out[j].Rule {
+			return priorities[out[i].Rule] < priorities[out[j].Rule]
+		}
+		return priorities[out[i].By] < priorities[out[j].By]
+	})
+	return out
+}
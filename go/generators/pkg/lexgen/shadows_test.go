@@ -0,0 +1,67 @@
+package lexgen
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestShadows(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		grammar string
+		want    []Shadow
+	}{
+		{
+			name:    "keyword after identifier",
+			grammar: `!ws ::= " " ; id ::= "a"-"z" { "a"-"z" } ; if ::= "if" ;`,
+			want:    []Shadow{{Rule: "if", By: "id", Example: "if", Full: true}},
+		},
+		{
+			name:    "keyword before identifier",
+			grammar: `if ::= "if" ; id ::= "a"-"z" { "a"-"z" } ;`,
+			want:    []Shadow{{Rule: "id", By: "if", Example: "if"}},
+		},
+		{
+			name:    "partial overlap",
+			grammar: `ab ::= "a" { "b" } ; ac ::= "a" { "c" } ; digits ::= "0"-"9" { "0"-"9" } ;`,
+			want:    []Shadow{{Rule: "ac", By: "ab", Example: "a"}},
+		},
+		{
+			name:    "shadowed by two rules",
+			grammar: `lower ::= "a"-"z" ; digit ::= "0"-"9" ; alnum ::= "a"-"z" | "0"-"9" ;`,
+			want: []Shadow{
+				{Rule: "alnum", By: "lower", Example: "a", Full: true},
+				{Rule: "alnum", By: "digit", Example: "0", Full: true},
+			},
+		},
+		{
+			name:    "different modes",
+			grammar: `%mode m if ; %push m id ; id ::= "a"-"z" { "a"-"z" } ; if ::= "if" ;`,
+		},
+	} {
+		tables, err := GenerateTables(tc.grammar, nil)
+		if err != nil {
+			t.Fatalf("%s: GenerateTables: %v", tc.name, err)
+		}
+		var got []Shadow
+		for _, shadow := range tables.Shadows {
+			got = append(got, *shadow)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: got %+v, want %+v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestShadowsRejected(t *testing.T) {
+	const grammar = `id ::= "a"-"z" { "a"-"z" } ; if ::= "if" ;`
+	_, err := GenerateTables(grammar, &LexTableOptions{RejectShadowed: true})
+	want := `token rule "if" is shadowed by "id", listed before it, and never matches; e.g. "if" is id`
+	if err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+
+	if _, err := GenerateTables(`if ::= "if" ; id ::= "a"-"z" { "a"-"z" } ;`, &LexTableOptions{RejectShadowed: true}); err != nil {
+		t.Errorf("partial overlap should not be rejected: %v", err)
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"strconv"
 	"strings"
 	"unicode"
-	"unicode/utf8"
 
 	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
 	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
@@ -19,6 +18,12 @@ import (
 type LexTableOptions struct {
 	// SourceName is used in error messages (e.g. file path). Empty means "".
 	SourceName string
+	// Minimize merges equivalent DFA states after subset construction, never merging states which
+	// accept different token types. Tables.DFAStates and Tables.MinimizedStates give the counts.
+	Minimize bool
+	// RejectShadowed fails table generation if a token rule is entirely shadowed by rules listed
+	// before it, so that it never matches. Either way, Tables.Shadows lists every shadowing.
+	RejectShadowed bool
 }
 
 // EncodeOptions configures JSON encoding of tables.
@@ -35,6 +40,26 @@ type Tables struct {
 	Actions     map[int]string            `json:"actions"`
 	Rules       map[string]string         `json:"rules,omitempty"`
 	Metadata    map[string]string         `json:"metadata,omitempty"`
+	// Modes lists the lexer modes, for grammars with mode directives, with the start state of
+	// each; the first is DefaultMode, starting at StartState.
+	Modes []Mode `json:"modes,omitempty"`
+	// ModeActions maps the token types after which the lexer changes mode to their changes.
+	ModeActions map[string]ModeAction `json:"mode_actions,omitempty"`
+	// TrailingContexts gives, for accepting states of rule alternatives with trailing context, how
+	// much of the match is the token.
+	TrailingContexts map[int]TrailingContext `json:"trailing_contexts,omitempty"`
+	// LineStartStates holds, for grammars with rules anchored to the start of a line ("^"), the
+	// state scanning begins in at the start of a line, for each mode in order, or for the one
+	// start state for grammars without modes.
+	LineStartStates []int `json:"line_start_states,omitempty"`
+	// DFAStates is the number of states from subset construction, and MinimizedStates the number
+	// after minimization, or 0 if LexTableOptions.Minimize was not set. Neither is part of the
+	// JSON encoding.
+	DFAStates       int `json:"-"`
+	MinimizedStates int `json:"-"`
+	// Shadows lists the token rules which lose to rules listed before them on some lexemes both
+	// match. It is not part of the JSON encoding.
+	Shadows []*Shadow `json:"-"`
 }
 
 // RangeTransition is a DFA transition on an inclusive rune range.
@@ -81,6 +106,39 @@ func marshalTablesDeterministic(tables *Tables) ([]byte, error) {
 		fields = append(fields, jsonField{name: "rules", value: rulesBytes})
 	}
 
+	if len(tables.Modes) > 0 {
+		modesBytes, err := json.Marshal(tables.Modes)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{name: "modes", value: modesBytes})
+	}
+
+	if len(tables.ModeActions) > 0 {
+		// json.Marshal writes map keys in sorted order.
+		modeActionsBytes, err := json.Marshal(tables.ModeActions)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{name: "mode_actions", value: modeActionsBytes})
+	}
+
+	if len(tables.TrailingContexts) > 0 {
+		trailingBytes, err := json.Marshal(tables.TrailingContexts)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{name: "trailing_contexts", value: trailingBytes})
+	}
+
+	if len(tables.LineStartStates) > 0 {
+		lineStartBytes, err := json.Marshal(tables.LineStartStates)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{name: "line_start_states", value: lineStartBytes})
+	}
+
 	if len(tables.Metadata) > 0 {
 		metadataBytes, err := marshalMapStringString(tables.Metadata)
 		if err != nil {
@@ -212,8 +270,12 @@ func GenerateTablesFromReader(r io.Reader, opts *LexTableOptions) (*Tables, erro
 // opts may be nil; SourceName is then "".
 func GenerateTables(grammarText string, opts *LexTableOptions) (*Tables, error) {
 	sourceName := ""
+	minimize := false
+	rejectShadowed := false
 	if opts != nil {
 		sourceName = opts.SourceName
+		minimize = opts.Minimize
+		rejectShadowed = opts.RejectShadowed
 	}
 	parser := parsers.NewEBNFParserWithSourceName(sourceName)
 	ast, err := parser.Parse(strings.NewReader(grammarText))
@@ -243,6 +305,13 @@ func GenerateTables(grammarText string, opts *LexTableOptions) (*Tables, error)
 	for _, rule := range ruleDefs {
 		ruleMap[rule.name] = rule.expr
 	}
+	caseless, err := extractCaseless(ast, lexerRuleSet)
+	if err != nil {
+		return nil, err
+	}
+	for ruleName := range caseless {
+		ruleMap[ruleName] = asts.NewASTNode(nil, parsers.EBNFParserNodeTypeCaseless, []*asts.ASTNode{ruleMap[ruleName]})
+	}
 
 	regexCache := map[string]*regexNode{}
 	regexRules := map[string]*regexNode{}
@@ -254,43 +323,133 @@ func GenerateTables(grammarText string, opts *LexTableOptions) (*Tables, error)
 		if canBeEmpty(node) {
 			return nil, fmt.Errorf("rule %q expands to empty literal", ruleName)
 		}
+		if hasAnchors(node) && !isTokenRuleName(ruleName) {
+			return nil, fmt.Errorf("rule %q: \"^\" and \"/\" are only allowed in token rules", ruleName)
+		}
 		regexRules[ruleName] = node
 	}
 
+	modes, err := extractModes(ast, tokenRuleNames)
+	if err != nil {
+		return nil, err
+	}
+
 	nfaBuilder := &nfaBuilder{}
-	globalStart := nfaBuilder.newState()
+	ruleStarts := map[string][]*nfaState{}
+	lineStartRules := map[*nfaState]bool{}
 	for i, ruleName := range tokenRuleNames {
-		node := regexRules[ruleName]
-		fragment, err := nfaBuilder.build(node)
+		alternatives, err := splitTokenAlternatives(regexRules[ruleName])
 		if err != nil {
 			return nil, fmt.Errorf("rule %q: %w", ruleName, err)
 		}
-		accept := acceptRule{name: ruleName, priority: i}
-		for _, state := range fragment.accepts {
-			state.accepts = append(state.accepts, accept)
+		for j, alternative := range alternatives {
+			fragment, err := nfaBuilder.build(alternative.regex)
+			if err != nil {
+				return nil, fmt.Errorf("rule %q: %w", ruleName, err)
+			}
+			accepts := fragment.accepts
+			if alternative.context != nil {
+				context, err := nfaBuilder.build(alternative.context)
+				if err != nil {
+					return nil, fmt.Errorf("rule %q: %w", ruleName, err)
+				}
+				for _, state := range fragment.accepts {
+					state.epsilon = append(state.epsilon, context.start)
+				}
+				accepts = context.accepts
+			}
+			accept := acceptRule{name: ruleName, priority: i, alternative: j, trailing: alternative.trailing}
+			for _, state := range accepts {
+				state.accepts = append(state.accepts, accept)
+			}
+			ruleStarts[ruleName] = append(ruleStarts[ruleName], fragment.start)
+			if alternative.lineStart {
+				lineStartRules[fragment.start] = true
+			}
+		}
+	}
+	modeNames := []string{DefaultMode}
+	modeRules := map[string][]string{DefaultMode: tokenRuleNames}
+	if modes != nil {
+		modeNames, modeRules = modes.names, modes.rules
+	}
+	// Each mode has a start state, and, if any rule is anchored to the start of a line, another
+	// for the start of a line, after all the others.
+	starts := make([]*nfaState, len(modeNames))
+	var lineStarts []*nfaState
+	if len(lineStartRules) > 0 {
+		lineStarts = make([]*nfaState, len(modeNames))
+	}
+	for i, mode := range modeNames {
+		starts[i] = nfaBuilder.newState()
+		if lineStarts != nil {
+			lineStarts[i] = nfaBuilder.newState()
+		}
+		for _, ruleName := range modeRules[mode] {
+			for _, ruleStart := range ruleStarts[ruleName] {
+				if !lineStartRules[ruleStart] {
+					starts[i].epsilon = append(starts[i].epsilon, ruleStart)
+				}
+				if lineStarts != nil {
+					lineStarts[i].epsilon = append(lineStarts[i].epsilon, ruleStart)
+				}
+			}
 		}
-		globalStart.epsilon = append(globalStart.epsilon, fragment.start)
 	}
 
-	dfa := buildDFA(globalStart)
+	dfa := buildDFA(append(starts, lineStarts...))
 
-	transitions := map[int][]RangeTransition{}
-	actions := map[int]string{}
+	priorities := map[string]int{}
+	for i, ruleName := range tokenRuleNames {
+		priorities[ruleName] = i
+	}
+	shadows := findShadows(dfa, priorities)
+	if rejectShadowed {
+		for _, shadow := range shadows {
+			if shadow.Full {
+				return nil, fmt.Errorf("%s", shadow.Summary())
+			}
+		}
+	}
+
+	tables := &Tables{
+		Transitions: map[int][]RangeTransition{},
+		Actions:     map[int]string{},
+		Rules:       stringifyRegexRules(regexRules, lexerRuleNames),
+		DFAStates:   len(dfa.states),
+		Shadows:     shadows,
+	}
 	for _, state := range dfa.states {
 		if len(state.transitions) > 0 {
-			transitions[state.id] = mergeRangeTransitions(state.transitions)
+			tables.Transitions[state.id] = mergeRangeTransitions(state.transitions)
 		}
 		if state.accept != nil {
-			actions[state.id] = state.accept.name
+			tables.Actions[state.id] = state.accept.name
+			if state.accept.trailing != (TrailingContext{}) {
+				if tables.TrailingContexts == nil {
+					tables.TrailingContexts = map[int]TrailingContext{}
+				}
+				tables.TrailingContexts[state.id] = state.accept.trailing
+			}
 		}
 	}
-
-	return &Tables{
-		StartState:  dfa.startID,
-		Transitions: transitions,
-		Actions:     actions,
-		Rules:       stringifyRegexRules(regexRules, lexerRuleNames),
-	}, nil
+	startIDs := dfa.startIDs
+	if minimize {
+		startIDs = minimizeDFA(tables, startIDs)
+	}
+	tables.StartState = startIDs[0]
+	if modes != nil {
+		for i, mode := range modeNames {
+			tables.Modes = append(tables.Modes, Mode{Name: mode, StartState: startIDs[i]})
+		}
+		if len(modes.actions) > 0 {
+			tables.ModeActions = modes.actions
+		}
+	}
+	if lineStarts != nil {
+		tables.LineStartStates = startIDs[len(modeNames):]
+	}
+	return tables, nil
 }
 
 type ruleDef struct {
@@ -307,6 +466,9 @@ func extractRuleDefs(ast *asts.AST) ([]ruleDef, error) {
 	}
 	var rules []ruleDef
 	for _, ruleNode := range ast.RootNode.Children {
+		if ruleNode.Type == parsers.EBNFParserNodeTypeDirective || ruleNode.Type == parsers.EBNFParserNodeTypeAction {
+			continue
+		}
 		if ruleNode.Type != parsers.EBNFParserNodeTypeRule {
 			return nil, fmt.Errorf("expected rule node, got %q", ruleNode.Type)
 		}
@@ -368,6 +530,11 @@ const (
 	regexOptional
 	regexStar
 	regexRange
+	// regexTrailingContext matches its first child only when followed by its second, and
+	// regexLineStart its child only at the start of a line. Both are only allowed at the top
+	// level of token rules' alternatives.
+	regexTrailingContext
+	regexLineStart
 )
 
 type regexNode struct {
@@ -460,12 +627,54 @@ func regexFromAST(
 		}
 		return &regexNode{kind: regexRange, from: startRune, to: endRune}, nil
 	case parsers.EBNFParserNodeTypeWildcard:
-		children := []*regexNode{
-			{kind: regexRange, from: 0x0000, to: '\t'},
-			{kind: regexRange, from: '\v', to: '\f'},
-			{kind: regexRange, from: 0x000E, to: utf8.MaxRune},
+		return newRuneSet(runeRange{'\n', '\n'}, runeRange{'\r', '\r'}).complement().regex(), nil
+	case parsers.EBNFParserNodeTypeDifference:
+		if err := node.CheckArity(2); err != nil {
+			return nil, err
 		}
-		return &regexNode{kind: regexAlternate, children: children}, nil
+		var sets []runeSet
+		for _, child := range node.Children {
+			part, err := regexFromAST(child, ruleMap, lexerRuleSet, cache, visiting)
+			if err != nil {
+				return nil, err
+			}
+			set, ok := charSet(part)
+			if !ok {
+				return nil, fmt.Errorf("operands of '-' must match single characters, not %s", regexToString(part))
+			}
+			sets = append(sets, set)
+		}
+		difference := sets[0].minus(sets[1])
+		if len(difference) == 0 {
+			return nil, fmt.Errorf("character set difference matches no characters")
+		}
+		return difference.regex(), nil
+	case parsers.EBNFParserNodeTypeCaseless:
+		if err := node.CheckArity(1); err != nil {
+			return nil, err
+		}
+		part, err := regexFromAST(node.Children[0], ruleMap, lexerRuleSet, cache, visiting)
+		if err != nil {
+			return nil, err
+		}
+		return foldCase(part), nil
+	case parsers.EBNFParserNodeTypeComplement:
+		if err := node.CheckArity(1); err != nil {
+			return nil, err
+		}
+		part, err := regexFromAST(node.Children[0], ruleMap, lexerRuleSet, cache, visiting)
+		if err != nil {
+			return nil, err
+		}
+		set, ok := charSet(part)
+		if !ok {
+			return nil, fmt.Errorf("operand of '~' must match single characters, not %s", regexToString(part))
+		}
+		complement := set.complement()
+		if len(complement) == 0 {
+			return nil, fmt.Errorf("character set complement matches no characters")
+		}
+		return complement.regex(), nil
 	case parsers.EBNFParserNodeTypeSequence:
 		if len(node.Children) == 0 {
 			return &regexNode{kind: regexLiteral, literal: ""}, nil
@@ -513,6 +722,28 @@ func regexFromAST(
 			return nil, err
 		}
 		return &regexNode{kind: regexStar, children: []*regexNode{part}}, nil
+	case parsers.EBNFParserNodeTypeTrailingContext:
+		if err := node.CheckArity(2); err != nil {
+			return nil, err
+		}
+		var children []*regexNode
+		for _, child := range node.Children {
+			part, err := regexFromAST(child, ruleMap, lexerRuleSet, cache, visiting)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, part)
+		}
+		return &regexNode{kind: regexTrailingContext, children: children}, nil
+	case parsers.EBNFParserNodeTypeLineStart:
+		if err := node.CheckArity(1); err != nil {
+			return nil, err
+		}
+		part, err := regexFromAST(node.Children[0], ruleMap, lexerRuleSet, cache, visiting)
+		if err != nil {
+			return nil, err
+		}
+		return &regexNode{kind: regexLineStart, children: []*regexNode{part}}, nil
 	case parsers.EBNFParserNodeTypeIdentifier:
 		if node.Token == nil {
 			return nil, fmt.Errorf("identifier node missing token")
@@ -549,6 +780,8 @@ func canBeEmpty(node *regexNode) bool {
 		return true
 	case regexRange:
 		return false
+	case regexTrailingContext, regexLineStart:
+		return canBeEmpty(node.children[0])
 	default:
 		return false
 	}
@@ -557,6 +790,10 @@ func canBeEmpty(node *regexNode) bool {
 type acceptRule struct {
 	name     string
 	priority int
+	// alternative is the index of the rule's alternative, for rules with line anchors or
+	// trailing context, which break ties between them, and trailing is that alternative's.
+	alternative int
+	trailing    TrailingContext
 }
 
 type nfaState struct {
@@ -685,12 +922,14 @@ type dfaState struct {
 }
 
 type dfaResult struct {
-	startID int
-	states  []*dfaState
+	// startIDs holds the state for each of the NFA start states, in order.
+	startIDs []int
+	states   []*dfaState
 }
 
-func buildDFA(start *nfaState) *dfaResult {
-	startSet := epsilonClosure(map[int]*nfaState{start.id: start})
+// buildDFA builds a DFA by subset construction from one or more NFA start states, as for lexer
+// modes, numbering the DFA states for the starts first. The starts share all other DFA states.
+func buildDFA(starts []*nfaState) *dfaResult {
 	stateMap := map[string]*dfaState{}
 	var states []*dfaState
 	queue := []*dfaState{}
@@ -711,7 +950,15 @@ func buildDFA(start *nfaState) *dfaResult {
 		return state
 	}
 
-	startState := newState(startSet)
+	startIDs := make([]int, len(starts))
+	for i, start := range starts {
+		startSet := epsilonClosure(map[int]*nfaState{start.id: start})
+		startState, ok := stateMap[setKey(startSet)]
+		if !ok {
+			startState = newState(startSet)
+		}
+		startIDs[i] = startState.id
+	}
 	for len(queue) > 0 {
 		state := queue[0]
 		queue = queue[1:]
@@ -731,7 +978,7 @@ func buildDFA(start *nfaState) *dfaResult {
 		}
 	}
 
-	return &dfaResult{startID: startState.id, states: states}
+	return &dfaResult{startIDs: startIDs, states: states}
 }
 
 type dfaTransitionSeed struct {
@@ -827,7 +1074,8 @@ func selectAcceptRule(set map[int]*nfaState) *acceptRule {
 	var best *acceptRule
 	for _, state := range set {
 		for _, accept := range state.accepts {
-			if best == nil || accept.priority < best.priority {
+			if best == nil || accept.priority < best.priority ||
+				(accept.priority == best.priority && accept.alternative < best.alternative) {
 				candidate := accept
 				best = &candidate
 			}
@@ -901,6 +1149,10 @@ func regexToString(node *regexNode) string {
 		return "(" + regexToString(node.children[0]) + ")*"
 	case regexRange:
 		return strconv.QuoteRuneToASCII(node.from) + "-" + strconv.QuoteRuneToASCII(node.to)
+	case regexTrailingContext:
+		return regexToString(node.children[0]) + " / " + regexToString(node.children[1])
+	case regexLineStart:
+		return "^ " + regexToString(node.children[0])
 	default:
 		return "<?>"
 	}
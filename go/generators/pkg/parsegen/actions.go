@@ -0,0 +1,215 @@
+package parsegen
+
+import (
+	"fmt"
+	"go/ast"
+	goparser "go/parser"
+	"go/token"
+	pathpkg "path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/bnf"
+	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+)
+
+// actionValuePattern matches the references $0, $1, ... in a semantic action to the values of the
+// production's right-hand-side symbols.
+var actionValuePattern = regexp.MustCompile(`\$[0-9]+`)
+
+// actionNonCodePattern matches the start of a Go string or rune literal or comment.
+var actionNonCodePattern = regexp.MustCompile("[\"'`]|//|/\\*")
+
+// replaceActionValues returns action with each $i reference replaced by replace(ref, i), except in
+// its Go string and rune literals and comments, such as the "$1" of << fmt.Sprintf("$1") >>.
+// An index too large for an int is passed as -1.
+func replaceActionValues(action string, replace func(ref string, index int) string) string {
+	replaceCode := func(code string) string {
+		return actionValuePattern.ReplaceAllStringFunc(code, func(ref string) string {
+			index, err := strconv.Atoi(ref[1:])
+			if err != nil {
+				index = -1
+			}
+			return replace(ref, index)
+		})
+	}
+	var b strings.Builder
+	for action != "" {
+		loc := actionNonCodePattern.FindStringIndex(action)
+		if loc == nil {
+			b.WriteString(replaceCode(action))
+			break
+		}
+		b.WriteString(replaceCode(action[:loc[0]]))
+		end := len(action)
+		switch opener := action[loc[0]:loc[1]]; opener {
+		case "//":
+			if i := strings.IndexByte(action[loc[1]:], '\n'); i >= 0 {
+				end = loc[1] + i
+			}
+		case "/*":
+			if i := strings.Index(action[loc[1]:], "*/"); i >= 0 {
+				end = loc[1] + i + 2
+			}
+		case "`":
+			if i := strings.IndexByte(action[loc[1]:], '`'); i >= 0 {
+				end = loc[1] + i + 1
+			}
+		default:
+			for i := loc[1]; i < len(action); i++ {
+				if action[i] == '\\' {
+					i++
+				} else if action[i] == opener[0] {
+					end = i + 1
+					break
+				}
+			}
+		}
+		b.WriteString(action[loc[0]:end])
+		action = action[end:]
+	}
+	return b.String()
+}
+
+// extractActionHeader returns the code of the grammar's << >> blocks between rules, in order.
+func extractActionHeader(ast *asts.AST) string {
+	var blocks []string
+	for _, node := range ast.RootNode.Children {
+		if node.Type == parsers.EBNFParserNodeTypeAction && node.Token != nil {
+			blocks = append(blocks, strings.TrimSpace(string(node.Token.Lexeme)))
+		}
+	}
+	return strings.Join(blocks, "\n")
+}
+
+// validateActions checks that each semantic action refers only to values of its production's
+// right-hand side.
+func validateActions(productions []Production) error {
+	for _, prod := range productions {
+		var err error
+		replaceActionValues(prod.Action, func(ref string, index int) string {
+			if err == nil && (index < 0 || index >= len(prod.RHS)) {
+				err = fmt.Errorf("production %s: action refers to %s, out of range [0, %d)",
+					prod.LHS, ref, len(prod.RHS))
+			}
+			return ""
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addRepeatActions gives the productions synthesized for { ... } repetitions actions making their
+// values lists, []any, of the values of the repeated symbols, if the grammar has any actions.
+func addRepeatActions(productions []Production) {
+	hasActions := false
+	for _, prod := range productions {
+		if prod.Action != "" {
+			hasActions = true
+			break
+		}
+	}
+	if !hasActions {
+		return
+	}
+	for i, prod := range productions {
+		if !strings.HasPrefix(prod.LHS, bnf.SyntheticPrefix+"repeat_") {
+			continue
+		}
+		if len(prod.RHS) == 0 {
+			productions[i].Action = "[]any{}, nil"
+			continue
+		}
+		// The last symbol is the rest of the repetition.
+		items := make([]string, len(prod.RHS)-1)
+		for j := range items {
+			items[j] = "$" + strconv.Itoa(j)
+		}
+		productions[i].Action = fmt.Sprintf("append([]any{%s}, $%d.([]any)...), nil",
+			strings.Join(items, ", "), len(prod.RHS)-1)
+	}
+}
+
+// goActionCode returns a semantic action as Go code, with its $i references replaced by X[i].
+func goActionCode(action string) string {
+	return replaceActionValues(action, func(_ string, index int) string {
+		return fmt.Sprintf("X[%d]", index)
+	})
+}
+
+func buildParserSemanticActions(tables *Tables) []parserSemanticAction {
+	var actions []parserSemanticAction
+	for i, prod := range tables.Productions {
+		if prod.Action == "" {
+			continue
+		}
+		rhs := make([]string, len(prod.RHS))
+		for j, sym := range prod.RHS {
+			rhs[j] = sym.Name
+		}
+		actions = append(actions, parserSemanticAction{
+			Index:      i,
+			Production: strings.TrimSpace(prod.LHS + " ::= " + strings.Join(rhs, " ")),
+			Code:       goActionCode(prod.Action),
+		})
+	}
+	return actions
+}
+
+// templateImports are the packages the parser template imports under their own names, which a
+// grammar's action header may import too.
+var templateImports = map[string]bool{
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts":   true,
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr":     true,
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens": true,
+}
+
+// splitActionHeader splits a grammar's action header into its import specs, less those the
+// parser template already has, and the Go declarations after them. The import specs are split into
+// those of the standard library and the rest.
+func splitActionHeader(header string) ([]string, []string, string, error) {
+	if header == "" {
+		return nil, nil, "", nil
+	}
+	const prefix = "package header\n"
+	fset := token.NewFileSet()
+	file, err := goparser.ParseFile(fset, "", prefix+header, goparser.SkipObjectResolution)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("action header: %w", err)
+	}
+	source := prefix + header
+	var stdImports, imports []string
+	declsStart := len(source)
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			declsStart = fset.Position(decl.Pos()).Offset
+			break
+		}
+		for _, spec := range genDecl.Specs {
+			importSpec := spec.(*ast.ImportSpec)
+			path, _ := strconv.Unquote(importSpec.Path.Value)
+			name := ""
+			if importSpec.Name != nil {
+				name = importSpec.Name.Name
+			}
+			if templateImports[path] && (name == "" || name == pathpkg.Base(path)) {
+				continue
+			}
+			spec := importSpec.Path.Value
+			if name != "" {
+				spec = name + " " + spec
+			}
+			if strings.Contains(strings.Split(path, "/")[0], ".") {
+				imports = append(imports, spec)
+			} else {
+				stdImports = append(stdImports, spec)
+			}
+		}
+	}
+	return stdImports, imports, strings.TrimSpace(source[declsStart:]), nil
+}
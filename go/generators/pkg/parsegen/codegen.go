@@ -10,6 +10,8 @@ import (
 	"strings"
 	"text/template"
 
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+
 	_ "embed"
 )
 
@@ -17,16 +19,6 @@ import (
 var parserTemplateText string
 
 var parserTemplateFuncs = template.FuncMap{
-	"childIndicesLiteral": func(indices []int) string {
-		if len(indices) == 0 {
-			return "[]int{}"
-		}
-		parts := make([]string, len(indices))
-		for i, idx := range indices {
-			parts[i] = strconv.Itoa(idx)
-		}
-		return "[]int{" + strings.Join(parts, ", ") + "}"
-	},
 	"quote": strconv.Quote,
 }
 
@@ -50,6 +42,16 @@ func DecodeTables(data []byte) (*Tables, error) {
 	return &tables, nil
 }
 
+// RuntimeTables converts tables to those run by the lr package, for parsers run from tables
+// built in memory rather than from generated code.
+func RuntimeTables(tables *Tables) (*lr.Tables, error) {
+	data, err := EncodeTables(tables, &EncodeOptions{Sort: false})
+	if err != nil {
+		return nil, err
+	}
+	return lr.DecodeTables(data)
+}
+
 // GenerateCode creates Go source implementing an LR(1) parser from tables.
 // When opts.Format is true, output is run through go/format.Source.
 func GenerateCode(tables *Tables, opts ParseCodegenOptions) ([]byte, error) {
@@ -77,13 +79,40 @@ func GenerateCode(tables *Tables, opts ParseCodegenOptions) ([]byte, error) {
 }
 
 func generateCodeRaw(tables *Tables, packageName string, typeName string) ([]byte, error) {
+	runtime, err := RuntimeTables(tables)
+	if err != nil {
+		return nil, err
+	}
 	data := parserTemplateData{
-		PackageName: packageName,
-		TypeName:    typeName,
-		Actions:     buildParserActions(tables, typeName),
-		Gotos:       buildParserGotos(tables),
-		Productions: buildParserProductions(tables),
-		HintMode:    tables.HintMode,
+		PackageName:   packageName,
+		TypeName:      typeName,
+		StartSymbol:   tables.StartSymbol,
+		Terminals:     quoteAll(runtime.Terminals.Types[1:]),
+		Nonterminals:  quoteAll(runtime.Nonterminals),
+		ActionBase:    int32Lines(runtime.ActionBase),
+		ActionCheck:   int32Lines(runtime.ActionCheck),
+		ActionEntries: int32Lines(runtime.ActionEntries),
+		GotoBase:      int32Lines(runtime.GotoBase),
+		GotoCheck:     int32Lines(runtime.GotoCheck),
+		GotoTargets:   int32Lines(runtime.GotoTargets),
+		Productions:   buildParserProductions(tables, runtime),
+		HintMode:      tables.HintMode,
+	}
+	entryPoints, err := buildParserEntryPoints(tables)
+	if err != nil {
+		return nil, err
+	}
+	data.EntryPoints = entryPoints
+	data.ConflictActions = buildParserConflictActions(tables)
+	if tables.RecordSeparator != "" {
+		data.RecordSeparatorLiteral = tokenTypeLiteral(tables.RecordSeparator)
+	}
+	data.DisplayNames = buildParserDisplayNames(tables)
+	data.ErrorMessages = buildParserErrorMessages(tables)
+	data.SemanticActions = buildParserSemanticActions(tables)
+	data.ActionStdImports, data.ActionImports, data.ActionDecls, err = splitActionHeader(tables.ActionHeader)
+	if err != nil {
+		return nil, err
 	}
 
 	var buf bytes.Buffer
@@ -96,168 +125,261 @@ func generateCodeRaw(tables *Tables, packageName string, typeName string) ([]byt
 type parserTemplateData struct {
 	PackageName string
 	TypeName    string
-	Actions     []parserActionState
-	Gotos       []parserGotoState
-	Productions []parserProductionInfo
-	HintMode    string
+	StartSymbol string
+	// Terminals and Nonterminals are the quoted symbols, in order of their IDs, and the action and
+	// goto tables are the lr.Tables arrays indexed by them, as lines of comma-separated values.
+	Terminals     []string
+	Nonterminals  []string
+	ActionBase    []string
+	ActionCheck   []string
+	ActionEntries []string
+	GotoBase      []string
+	GotoCheck     []string
+	GotoTargets   []string
+	Productions   []parserProductionInfo
+	HintMode      string
+	// EntryPoints are the grammar's %start symbols, when there are several, each with a Parse method.
+	EntryPoints []parserEntryPoint
+	// RecordSeparatorLiteral is the token type literal of the %records separator, if any.
+	RecordSeparatorLiteral string
+	// DisplayNames are the terminals' names in syntax errors, from the grammar's %display declarations.
+	DisplayNames []parserDisplayName
+	// ErrorMessages are the messages for syntax errors in particular states, from a messages file.
+	ErrorMessages []parserErrorMessage
+	// SemanticActions are the productions' semantic actions, from the grammar's << >> blocks, for
+	// generating ParseValue. ActionImports and ActionDecls are the grammar's header block, split
+	// into import specs for the generated import block and the declarations following them.
+	SemanticActions  []parserSemanticAction
+	ActionStdImports []string
+	ActionImports    []string
+	ActionDecls      string
+	// ConflictActions are every action of each conflicting table entry, kept for ParseAll by
+	// parsegen-tables -glr.
+	ConflictActions []parserConflictState
 }
 
-type parserActionState struct {
-	State   int
-	Entries []parserActionEntry
+type parserDisplayName struct {
+	TerminalLiteral string
+	NameLiteral     string
+}
+
+type parserSemanticAction struct {
+	Index      int
+	Production string
+	Code       string
+}
+
+type parserErrorMessage struct {
+	State          int
+	MessageLiteral string
+}
+
+type parserEntryPoint struct {
+	MethodName string
+	Symbol     string
+	State      int
 }
 
 type parserActionEntry struct {
-	TerminalLiteral string
-	KindLiteral     string
-	Target          int
-	HasTarget       bool
+	KindLiteral string
+	Target      int
+	HasTarget   bool
 }
 
-type parserGotoState struct {
+type parserConflictState struct {
 	State   int
-	Entries []parserGotoEntry
+	Entries []parserConflictEntry
 }
 
-type parserGotoEntry struct {
-	NontermLiteral string
-	Target         int
+type parserConflictEntry struct {
+	TerminalLiteral string
+	Actions         []parserActionEntry
 }
 
 type parserProductionInfo struct {
-	LHSLiteral                  string
-	RHSCount                    int
-	HasHint                     bool
-	HasPassthrough              bool
-	HasParentLiteral            bool
-	HasWithAppendedChildren     bool
-	HasWithPrependedChildren    bool
-	HasWithAdoptedGrandchildren bool
-	ParentIndex                 int
-	PassthroughIndex            int
-	ParentLiteral               string
-	ChildIndices                []int
-	WithAppendedChildren        []int
-	WithPrependedChildren       []int
-	WithAdoptedGrandchildren    []int
-	NodeType                    string
+	LHSLiteral string
+	LHSID      int
+	RHSCount   int
+	// HintLiteral is the production's *lr.Hint, or empty if it has no AST hint.
+	HintLiteral string
 }
 
-func buildParserActions(tables *Tables, typeName string) []parserActionState {
-	stateIDs := make([]int, 0, len(tables.Actions))
-	for state := range tables.Actions {
+func buildParserConflictActions(tables *Tables) []parserConflictState {
+	stateIDs := make([]int, 0, len(tables.ConflictActions))
+	for state := range tables.ConflictActions {
 		stateIDs = append(stateIDs, state)
 	}
 	sort.Ints(stateIDs)
 
-	out := make([]parserActionState, 0, len(stateIDs))
+	out := make([]parserConflictState, 0, len(stateIDs))
 	for _, state := range stateIDs {
-		entries := tables.Actions[state]
+		entries := tables.ConflictActions[state]
 		terminals := make([]string, 0, len(entries))
 		for term := range entries {
 			terminals = append(terminals, term)
 		}
 		sort.Strings(terminals)
 
-		actionEntries := make([]parserActionEntry, 0, len(terminals))
+		conflictEntries := make([]parserConflictEntry, 0, len(terminals))
 		for _, term := range terminals {
-			action := entries[term]
-			hasTarget := action.Type == "shift" || action.Type == "reduce"
-			actionEntries = append(actionEntries, parserActionEntry{
+			actions := make([]parserActionEntry, 0, len(entries[term]))
+			for _, action := range entries[term] {
+				actions = append(actions, parserActionEntry{
+					KindLiteral: actionKindLiteral(action.Type),
+					Target:      action.Target,
+					HasTarget:   action.Type == "shift" || action.Type == "reduce",
+				})
+			}
+			conflictEntries = append(conflictEntries, parserConflictEntry{
 				TerminalLiteral: tokenTypeLiteral(term),
-				KindLiteral:     actionKindLiteral(action.Type, typeName),
-				Target:          action.Target,
-				HasTarget:       hasTarget,
-			})
-		}
-
-		out = append(out, parserActionState{
-			State:   state,
-			Entries: actionEntries,
-		})
-	}
-	return out
-}
-
-func buildParserGotos(tables *Tables) []parserGotoState {
-	stateIDs := make([]int, 0, len(tables.Gotos))
-	for state := range tables.Gotos {
-		stateIDs = append(stateIDs, state)
-	}
-	sort.Ints(stateIDs)
-
-	out := make([]parserGotoState, 0, len(stateIDs))
-	for _, state := range stateIDs {
-		entries := tables.Gotos[state]
-		names := make([]string, 0, len(entries))
-		for name := range entries {
-			names = append(names, name)
-		}
-		sort.Strings(names)
-
-		gotoEntries := make([]parserGotoEntry, 0, len(names))
-		for _, name := range names {
-			gotoEntries = append(gotoEntries, parserGotoEntry{
-				NontermLiteral: "asts.NodeType(" + strconv.Quote(name) + ")",
-				Target:         entries[name],
+				Actions:         actions,
 			})
 		}
 
-		out = append(out, parserGotoState{
+		out = append(out, parserConflictState{
 			State:   state,
-			Entries: gotoEntries,
+			Entries: conflictEntries,
 		})
 	}
 	return out
 }
 
-func buildParserProductions(tables *Tables) []parserProductionInfo {
+func buildParserProductions(tables *Tables, runtime *lr.Tables) []parserProductionInfo {
 	out := make([]parserProductionInfo, 0, len(tables.Productions))
-	for _, prod := range tables.Productions {
+	for i, prod := range tables.Productions {
 		info := parserProductionInfo{
 			LHSLiteral: "asts.NodeType(" + strconv.Quote(prod.LHS) + ")",
+			LHSID:      runtime.Productions[i].LHSID,
 			RHSCount:   len(prod.RHS),
 		}
 		if prod.Hint != nil {
-			if prod.Hint.PassthroughIndex != nil {
-				info.HasPassthrough = true
-				info.PassthroughIndex = *prod.Hint.PassthroughIndex
-			} else {
-				info.HasHint = true
-				if prod.Hint.ParentLiteral != nil {
-					info.HasParentLiteral = true
-					info.ParentLiteral = *prod.Hint.ParentLiteral
-				} else {
-					info.ParentIndex = prod.Hint.ParentIndex
-				}
-				info.ChildIndices = prod.Hint.ChildIndices
-				info.WithAppendedChildren = prod.Hint.WithAppendedChildren
-				info.HasWithAppendedChildren = len(prod.Hint.WithAppendedChildren) > 0
-				info.WithPrependedChildren = prod.Hint.WithPrependedChildren
-				info.HasWithPrependedChildren = len(prod.Hint.WithPrependedChildren) > 0
-				info.WithAdoptedGrandchildren = prod.Hint.WithAdoptedGrandchildren
-				info.HasWithAdoptedGrandchildren = len(prod.Hint.WithAdoptedGrandchildren) > 0
-				info.NodeType = prod.Hint.NodeType
-			}
+			info.HintLiteral = hintLiteral(prod.Hint)
 		}
 		out = append(out, info)
 	}
 	return out
 }
 
-func actionKindLiteral(kind string, typeName string) string {
+// hintLiteral returns the Go expression for hint as an *lr.Hint, leaving out zero fields.
+func hintLiteral(hint *ASTHint) string {
+	if hint.PassthroughIndex != nil {
+		return fmt.Sprintf("&lr.Hint{Passthrough: true, PassthroughIndex: %d}", *hint.PassthroughIndex)
+	}
+	var fields []string
+	if hint.ParentLiteral != nil {
+		fields = append(fields, "HasParentLiteral: true", "ParentLiteral: "+strconv.Quote(*hint.ParentLiteral))
+	} else if hint.ParentIndex != 0 {
+		fields = append(fields, fmt.Sprintf("ParentIndex: %d", hint.ParentIndex))
+	}
+	for _, indices := range []struct {
+		name    string
+		indices []int
+	}{
+		{"ChildIndices", hint.ChildIndices},
+		{"WithAppendedChildren", hint.WithAppendedChildren},
+		{"WithPrependedChildren", hint.WithPrependedChildren},
+		{"WithAdoptedGrandchildren", hint.WithAdoptedGrandchildren},
+	} {
+		if len(indices.indices) == 0 {
+			continue
+		}
+		parts := make([]string, len(indices.indices))
+		for i, index := range indices.indices {
+			parts[i] = strconv.Itoa(index)
+		}
+		fields = append(fields, indices.name+": []int{"+strings.Join(parts, ", ")+"}")
+	}
+	if hint.NodeType != "" {
+		fields = append(fields, "NodeType: asts.NodeType("+strconv.Quote(hint.NodeType)+")")
+	}
+	return "&lr.Hint{" + strings.Join(fields, ", ") + "}"
+}
+
+func actionKindLiteral(kind string) string {
 	switch kind {
 	case "shift":
-		return typeName + "ActionShift"
+		return "lr.ActionShift"
 	case "reduce":
-		return typeName + "ActionReduce"
-	case "accept":
-		return typeName + "ActionAccept"
+		return "lr.ActionReduce"
 	case "accept_and_yield":
-		return typeName + "ActionAcceptAndYield"
+		return "lr.ActionAcceptAndYield"
 	default:
-		return typeName + "ActionAccept"
+		return "lr.ActionAccept"
+	}
+}
+
+// buildParserEntryPoints names a Parse method for each entry point, e.g. ParseExpression for
+// the start symbol Expression.
+func buildParserEntryPoints(tables *Tables) ([]parserEntryPoint, error) {
+	var entryPoints []parserEntryPoint
+	for _, entryPoint := range tables.EntryPoints {
+		methodName := "Parse" + entryPoint.Symbol
+		switch methodName {
+		case "ParseOne", "ParseAll", "ParseFrom":
+			return nil, fmt.Errorf("entry point %q: method %s is already generated for other use", entryPoint.Symbol, methodName)
+		}
+		entryPoints = append(entryPoints, parserEntryPoint{
+			MethodName: methodName,
+			Symbol:     entryPoint.Symbol,
+			State:      entryPoint.State,
+		})
+	}
+	return entryPoints, nil
+}
+
+func buildParserErrorMessages(tables *Tables) []parserErrorMessage {
+	states := make([]int, 0, len(tables.ErrorMessages))
+	for state := range tables.ErrorMessages {
+		states = append(states, state)
+	}
+	sort.Ints(states)
+	errorMessages := make([]parserErrorMessage, len(states))
+	for i, state := range states {
+		errorMessages[i] = parserErrorMessage{
+			State:          state,
+			MessageLiteral: strconv.Quote(tables.ErrorMessages[state]),
+		}
+	}
+	return errorMessages
+}
+
+func buildParserDisplayNames(tables *Tables) []parserDisplayName {
+	terminals := make([]string, 0, len(tables.DisplayNames))
+	for terminal := range tables.DisplayNames {
+		terminals = append(terminals, terminal)
+	}
+	sort.Strings(terminals)
+	displayNames := make([]parserDisplayName, len(terminals))
+	for i, terminal := range terminals {
+		displayNames[i] = parserDisplayName{
+			TerminalLiteral: tokenTypeLiteral(terminal),
+			NameLiteral:     strconv.Quote(tables.DisplayNames[terminal]),
+		}
+	}
+	return displayNames
+}
+
+// quoteAll returns the quoted names.
+func quoteAll[S ~string](names []S) []string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = strconv.Quote(string(name))
+	}
+	return quoted
+}
+
+// int32Lines formats values as lines of a Go composite literal, each with a trailing comma.
+func int32Lines(values []int32) []string {
+	const perLine = 16
+	var lines []string
+	for start := 0; start < len(values); start += perLine {
+		parts := make([]string, 0, perLine)
+		for _, value := range values[start:min(start+perLine, len(values))] {
+			parts = append(parts, strconv.Itoa(int(value)))
+		}
+		lines = append(lines, strings.Join(parts, ", ")+",")
 	}
+	return lines
 }
 
 func tokenTypeLiteral(term string) string {
@@ -1,8 +1,13 @@
 package parsegen
 
 import (
+	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
 )
 
 func TestGenerateGoParserCodeFormats(t *testing.T) {
@@ -51,17 +56,12 @@ func TestGenerateGoParserCodeHintMode(t *testing.T) {
 		t.Fatalf("GenerateCode() error: %v", err)
 	}
 	codeStr := string(code)
-	if !strings.Contains(codeStr, "hasHint") {
-		t.Fatalf("hint-mode generated code should contain hasHint field")
-	}
-	if !strings.Contains(codeStr, "parentIndex") {
-		t.Fatalf("hint-mode generated code should contain parentIndex field")
+	want := `Hint: &lr.Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: asts.NodeType("operator")}`
+	if !strings.Contains(codeStr, want) {
+		t.Fatalf("hint-mode generated code should contain %q", want)
 	}
-	if !strings.Contains(codeStr, "childIndices") {
-		t.Fatalf("hint-mode generated code should contain childIndices field")
-	}
-	if !strings.Contains(codeStr, `"operator"`) {
-		t.Fatalf("hint-mode generated code should contain node type \"operator\"")
+	if !strings.Contains(codeStr, `HintMode: "hints",`) {
+		t.Fatalf("hint-mode generated code should set the tables' hint mode")
 	}
 }
 
@@ -92,11 +92,9 @@ func TestGenerateGoParserCodeWithAppendedChildrenHint(t *testing.T) {
 		t.Fatalf("GenerateCode() error: %v", err)
 	}
 	codeStr := string(code)
-	if !strings.Contains(codeStr, "hasWithAppendedChildren") {
-		t.Fatalf("generated code should contain hasWithAppendedChildren")
-	}
-	if !strings.Contains(codeStr, "withAppendedChildren") {
-		t.Fatalf("generated code should contain withAppendedChildren")
+	want := "Hint: &lr.Hint{ParentIndex: 1, WithAppendedChildren: []int{2, 3}}"
+	if !strings.Contains(codeStr, want) {
+		t.Fatalf("generated code should contain %q", want)
 	}
 }
 
@@ -127,11 +125,9 @@ func TestGenerateGoParserCodeWithPrependedChildrenHint(t *testing.T) {
 		t.Fatalf("GenerateCode() error: %v", err)
 	}
 	codeStr := string(code)
-	if !strings.Contains(codeStr, "hasWithPrependedChildren") {
-		t.Fatalf("generated code should contain hasWithPrependedChildren")
-	}
-	if !strings.Contains(codeStr, "withPrependedChildren") {
-		t.Fatalf("generated code should contain withPrependedChildren")
+	want := "Hint: &lr.Hint{ParentIndex: 1, WithPrependedChildren: []int{0, 2}}"
+	if !strings.Contains(codeStr, want) {
+		t.Fatalf("generated code should contain %q", want)
 	}
 }
 
@@ -162,18 +158,16 @@ func TestGenerateGoParserCodeWithAdoptedGrandchildrenHint(t *testing.T) {
 		t.Fatalf("GenerateCode() error: %v", err)
 	}
 	codeStr := string(code)
-	if !strings.Contains(codeStr, "hasWithAdoptedGrandchildren") {
-		t.Fatalf("generated code should contain hasWithAdoptedGrandchildren")
-	}
-	if !strings.Contains(codeStr, "withAdoptedGrandchildren") {
-		t.Fatalf("generated code should contain withAdoptedGrandchildren")
+	want := "Hint: &lr.Hint{ParentIndex: 1, WithAdoptedGrandchildren: []int{0, 2}}"
+	if !strings.Contains(codeStr, want) {
+		t.Fatalf("generated code should contain %q", want)
 	}
 }
 
 // TestGenerateGoParserCodeWithAdoptedGrandchildrenRespectsType verifies that when a
 // production uses with_adopted_grandchildren and specifies a "type" hint, the generated
-// parser applies that type to the result node (so e.g. Array with parent_literal "[]"
-// and type "array" yields node type "array", not "[]").
+// production table keeps that type along with the parent literal (so e.g. Array with
+// parent_literal "[]" and type "array" yields node type "array", not "[]").
 func TestGenerateGoParserCodeWithAdoptedGrandchildrenRespectsType(t *testing.T) {
 	tables := &Tables{
 		StartSymbol: "Root",
@@ -201,17 +195,293 @@ func TestGenerateGoParserCodeWithAdoptedGrandchildrenRespectsType(t *testing.T)
 		t.Fatalf("GenerateCode() error: %v", err)
 	}
 	codeStr := string(code)
-	// The template must apply prod.nodeType when set, so the result node gets type "array" not "[]".
-	if !strings.Contains(codeStr, "nodeType := prod.nodeType") {
-		t.Fatalf("generated code should apply hint type in with_adopted_grandchildren branch (nodeType := prod.nodeType)")
+	want := `Hint: &lr.Hint{HasParentLiteral: true, ParentLiteral: "[]", WithAdoptedGrandchildren: []int{1}, NodeType: asts.NodeType("array")}`
+	if !strings.Contains(codeStr, want) {
+		t.Fatalf("generated production table should contain %q", want)
+	}
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestGenerateGoParserCodeGLR(t *testing.T) {
+	tables, err := GenerateTables(danglingElseBNF, &ParseTableOptions{GLR: true})
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
 	}
-	if !strings.Contains(codeStr, `asts.NodeType("array")`) {
-		t.Fatalf("generated production table should include nodeType for the hint")
+	code, err := GenerateCode(tables, ParseCodegenOptions{Package: "parsers", Type: "GLRTestParser", Format: true})
+	if err != nil {
+		t.Fatalf("GenerateCode() error: %v", err)
 	}
-	// Result node must be built with nodeType, not parentType, when type is set.
-	if !strings.Contains(codeStr, "NewASTNode(parentToken, nodeType, newChildren)") {
-		t.Fatalf("generated code should build node with nodeType in with_adopted_grandchildren branch")
+	codeStr := string(code)
+	for _, want := range []string{
+		"ConflictActions: map[int]map[tokens.TokenType][]lr.Action{",
+		"{Kind: lr.ActionShift, Target: ",
+	} {
+		if !strings.Contains(codeStr, want) {
+			t.Errorf("GLR generated code should contain %q", want)
+		}
+	}
+
+	tables, err = GenerateTables("%expect 1 ;\n"+danglingElseBNF, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
+	}
+	code, err = GenerateCode(tables, ParseCodegenOptions{Package: "parsers", Type: "LRTestParser", Format: true})
+	if err != nil {
+		t.Fatalf("GenerateCode() error: %v", err)
+	}
+	if strings.Contains(string(code), "ConflictActions") {
+		t.Errorf("non-GLR generated code should not keep conflicting actions")
 	}
 }
 
-func strPtr(s string) *string { return &s }
+func TestGenerateGoParserCodeRecordSeparator(t *testing.T) {
+	tables, err := GenerateTables(sumRecordsBNF, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
+	}
+	code, err := GenerateCode(tables, ParseCodegenOptions{Package: "parsers", Type: "SumTestParser", Format: true})
+	if err != nil {
+		t.Fatalf("GenerateCode() error: %v", err)
+	}
+	want := `RecordSeparator: tokens.TokenType("semi"),`
+	if !strings.Contains(string(code), want) {
+		t.Errorf("generated code should contain %q", want)
+	}
+}
+
+func TestGenerateGoParserCodeEntryPoints(t *testing.T) {
+	tables, err := GenerateTables(entryPointsBNF, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
+	}
+	code, err := GenerateCode(tables, ParseCodegenOptions{Package: "parsers", Type: "EntryTestParser", Format: true})
+	if err != nil {
+		t.Fatalf("GenerateCode() error: %v", err)
+	}
+	codeStr := string(code)
+	for _, want := range []string{
+		"func (parser *EntryTestParser) ParseProgram(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {\n\treturn parser.Parser.ParseFrom(\"Program\", lexer, astMode)",
+		"func (parser *EntryTestParser) ParseStatement(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {\n\treturn parser.Parser.ParseFrom(\"Statement\", lexer, astMode)",
+		"func (parser *EntryTestParser) ParseExpr(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {\n\treturn parser.Parser.ParseFrom(\"Expr\", lexer, astMode)",
+		"func ParseWithEntryTestParser[T any](parser *EntryTestParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {\n\treturn lr.ParseWith(&parser.Parser, lexer, handler)",
+		"func ParseStatementWithEntryTestParser[T any](parser *EntryTestParser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {\n\treturn lr.ParseFromWith(&parser.Parser, \"Statement\", lexer, handler)",
+		"{Symbol: \"Statement\", State: 1},",
+	} {
+		if !strings.Contains(codeStr, want) {
+			t.Errorf("generated code should contain %q", want)
+		}
+	}
+
+	tables, err = GenerateTables(`%start Root One ; a ::= "a" ; Root ::= One ; One ::= a ;`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
+	}
+	_, err = GenerateCode(tables, ParseCodegenOptions{Package: "parsers", Type: "EntryTestParser"})
+	if err == nil || !strings.Contains(err.Error(), "ParseOne") {
+		t.Errorf("expected an error for the entry point One, got %v", err)
+	}
+}
+
+func TestGenerateGoParserCodeErrorRecovery(t *testing.T) {
+	tables, err := GenerateTables(`int ::= "0" ; semi ::= ";" ; Root ::= { Statement } ; Statement ::= int semi | error semi ;`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
+	}
+	code, err := GenerateCode(tables, ParseCodegenOptions{Package: "parsers", Type: "RecoveryTestParser", Format: true})
+	if err != nil {
+		t.Fatalf("GenerateCode() error: %v", err)
+	}
+	// Recovery is done by the lr package, using the tables' actions on the error terminal.
+	want := "Terminals: tokens.NewKinds(\n\t\t\"EOF\",\n\t\t\"error\",\n"
+	if !strings.Contains(string(code), want) {
+		t.Errorf("generated code should contain %q", want)
+	}
+}
+
+func TestGenerateGoParserCodeDisplayNames(t *testing.T) {
+	tables, err := GenerateTables(`%display plus "'+'" ; int ::= "0" ; plus ::= "+" ; Root ::= int plus int ;`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
+	}
+	code, err := GenerateCode(tables, ParseCodegenOptions{Package: "parsers", Type: "DisplayTestParser", Format: true})
+	if err != nil {
+		t.Fatalf("GenerateCode() error: %v", err)
+	}
+	codeStr := string(code)
+	for _, want := range []string{
+		"DisplayNames: map[tokens.TokenType]string{",
+		`tokens.TokenType("plus"): "'+'",`,
+	} {
+		if !strings.Contains(codeStr, want) {
+			t.Errorf("generated code should contain %q", want)
+		}
+	}
+}
+
+func TestGenerateGoParserCodeErrorMessages(t *testing.T) {
+	tables, err := GenerateTables(`int ::= "0" ; plus ::= "+" ; Root ::= int plus int ;`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
+	}
+	if err := ApplyErrorMessages(tables, "int int\n\nmissing \"+\"\n", ""); err != nil {
+		t.Fatalf("ApplyErrorMessages() error: %v", err)
+	}
+	code, err := GenerateCode(tables, ParseCodegenOptions{Package: "parsers", Type: "MessagesTestParser", Format: true})
+	if err != nil {
+		t.Fatalf("GenerateCode() error: %v", err)
+	}
+	codeStr := string(code)
+	for state, message := range tables.ErrorMessages {
+		want := fmt.Sprintf("%d: %q,", state, message)
+		if !strings.Contains(codeStr, want) {
+			t.Errorf("generated code should contain %q", want)
+		}
+	}
+	if !strings.Contains(codeStr, "ErrorMessages: map[int]string{") {
+		t.Error("generated tables should hold the error messages")
+	}
+}
+
+func TestGenerateGoParserCodeSemanticActions(t *testing.T) {
+	tables, err := GenerateTables(`
+<<
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+	ast "go/ast"
+)
+
+func atoi(value any) (any, error) { return strconv.Atoi(string(value.(*tokens.Token).Lexeme)) }
+>>
+int ::= "0" ; plus ::= "+" ;
+Sum ::= Sum plus int << fmt.Sprint($0, $2), nil >> | int << atoi($0) >> ;
+`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
+	}
+	code, err := GenerateCode(tables, ParseCodegenOptions{Package: "parsers", Type: "ActionsTestParser", Format: true})
+	if err != nil {
+		t.Fatalf("GenerateCode() error: %v", err)
+	}
+	codeStr := string(code)
+	for _, want := range []string{
+		"import (\n\t\"fmt\"\n\tast \"go/ast\"\n\t\"strconv\"\n\n",
+		"\nfunc atoi(value any) (any, error) {",
+		"func (parser *ActionsTestParser) ParseValue(lexer liblexers.AbstractLexer) (any, error) {",
+		"return fmt.Sprint(X[0], X[2]), nil",
+		"return atoi(X[0])",
+	} {
+		if !strings.Contains(codeStr, want) {
+			t.Errorf("generated code should contain %q", want)
+		}
+	}
+	if strings.Count(codeStr, "\"fmt\"") != 1 || strings.Count(codeStr, "pgpg/go/lib/pkg/tokens\"") != 1 {
+		t.Error("generated code should import fmt and tokens once each")
+	}
+
+	tables, err = GenerateTables(`int ::= "0" ; Root ::= int ;`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
+	}
+	code, err = GenerateCode(tables, ParseCodegenOptions{Package: "parsers", Type: "NoActionsTestParser", Format: true})
+	if err != nil {
+		t.Fatalf("GenerateCode() error: %v", err)
+	}
+	if strings.Contains(string(code), "ParseValue") {
+		t.Error("generated code without semantic actions should not have ParseValue")
+	}
+}
+
+func TestRuntimeTables(t *testing.T) {
+	tables, err := GenerateTables(entryPointsBNF, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
+	}
+	runtimeTables, err := RuntimeTables(tables)
+	if err != nil {
+		t.Fatalf("RuntimeTables() error: %v", err)
+	}
+	if runtimeTables.StartSymbol != tables.StartSymbol || len(runtimeTables.EntryPoints) != len(tables.EntryPoints) {
+		t.Errorf("start symbols: got %s and %v", runtimeTables.StartSymbol, runtimeTables.EntryPoints)
+	}
+	if len(runtimeTables.ActionBase) != len(tables.Actions) || len(runtimeTables.Productions) != len(tables.Productions) {
+		t.Errorf("got %d states and %d productions, want %d and %d",
+			len(runtimeTables.ActionBase), len(runtimeTables.Productions), len(tables.Actions), len(tables.Productions))
+	}
+	for state, actions := range tables.Actions {
+		for terminal, action := range actions {
+			got, ok := runtimeTables.Action(state, tokens.TokenType(terminal))
+			if !ok || got.String() != fmt.Sprintf("%s(%d)", action.Type, action.Target) && got.String() != action.Type {
+				t.Errorf("state %d, %s: got %v, want %s %d", state, terminal, got, action.Type, action.Target)
+			}
+		}
+		if len(runtimeTables.Lookaheads(state)) != len(actions) {
+			t.Errorf("state %d: got lookaheads %v, want %d", state, runtimeTables.Lookaheads(state), len(actions))
+		}
+	}
+	for state, gotos := range tables.Gotos {
+		for nonterminal, target := range gotos {
+			if got, ok := runtimeTables.Goto(state, asts.NodeType(nonterminal)); !ok || got != target {
+				t.Errorf("state %d, %s: got goto %d, want %d", state, nonterminal, got, target)
+			}
+		}
+	}
+}
+
+// fieldsLexer returns one token per whitespace-separated field, of that type.
+type fieldsLexer struct {
+	fields []string
+}
+
+func (lexer *fieldsLexer) Scan() *tokens.Token {
+	if len(lexer.fields) == 0 {
+		return tokens.NewEOFToken(tokens.NewTokenLocation())
+	}
+	field := lexer.fields[0]
+	lexer.fields = lexer.fields[1:]
+	return tokens.NewToken([]rune(field), tokens.TokenType(field), tokens.NewTokenLocation())
+}
+
+func TestRuntimeTablesParseAllFrom(t *testing.T) {
+	tables, err := GenerateTables(`
+%start Program Expr ;
+int ::= "0" ; plus ::= "+" ; semi ::= ";" ;
+Program ::= Expr semi ;
+Expr ::= Expr plus Expr | int ;
+`, &ParseTableOptions{GLR: true})
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
+	}
+	runtimeTables, err := RuntimeTables(tables)
+	if err != nil {
+		t.Fatalf("RuntimeTables() error: %v", err)
+	}
+	parser := lr.NewParser(runtimeTables)
+
+	parses, err := parser.ParseAllFrom("Expr", &fieldsLexer{strings.Fields("int plus int plus int")}, "")
+	if err != nil {
+		t.Fatalf("ParseAllFrom(Expr) error: %v", err)
+	}
+	if len(parses) != 2 {
+		t.Errorf("ParseAllFrom(Expr): got %d parses, want 2", len(parses))
+	}
+	for _, parse := range parses {
+		if parse.RootNode.Type != "Expr" {
+			t.Errorf("ParseAllFrom(Expr): got root %s, want Expr", parse.RootNode.Type)
+		}
+	}
+
+	parses, err = parser.ParseAll(&fieldsLexer{strings.Fields("int plus int semi")}, "")
+	if err != nil || len(parses) != 1 {
+		t.Errorf("ParseAll: got %d parses and error %v, want 1 parse", len(parses), err)
+	}
+	if _, err := parser.ParseAll(&fieldsLexer{strings.Fields("int plus int")}, ""); err == nil {
+		t.Error("ParseAll of an Expr: expected a syntax error, as Program needs a semicolon")
+	}
+	if _, err := parser.ParseAllFrom("Missing", &fieldsLexer{}, ""); err == nil || !strings.Contains(err.Error(), "not a start symbol") {
+		t.Errorf("ParseAllFrom(Missing): got %v, want not a start symbol", err)
+	}
+}
@@ -0,0 +1,349 @@
+package parsegen
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+)
+
+// Conflict describes two or more LR actions for the same state and lookahead terminal, which
+// declared precedence did not resolve.
+type Conflict struct {
+	State      int
+	StateLabel string
+	Lookahead  string
+	// Actions are the conflicting actions, in the order table construction produced them.
+	Actions []Action
+	// Resolution is the action kept under default resolution: shift is preferred over reduce,
+	// and among reduces the earliest production wins.
+	Resolution Action
+	// Items are the LR(1) items of the state, formatted for display.
+	Items []string
+	// LALRMerge is set when the conflict was introduced by LALR(1) merging of states.
+	LALRMerge bool
+	// Counterexample, if found, shows an input reaching the conflict, and ideally an ambiguous sentence.
+	Counterexample *Counterexample
+
+	message string
+}
+
+// Kind returns "shift/reduce", "reduce/reduce", or the conflicting action types joined by "/".
+// An accept_and_yield action, which ends a %records record, counts as a reduce.
+func (conflict *Conflict) Kind() string {
+	hasShift := false
+	reduceCount := 0
+	var types []string
+	for _, action := range conflict.Actions {
+		switch action.Type {
+		case "shift":
+			hasShift = true
+		case "reduce", "accept_and_yield":
+			reduceCount++
+		}
+		types = append(types, action.Type)
+	}
+	switch {
+	case hasShift && reduceCount > 0:
+		return "shift/reduce"
+	case reduceCount > 1:
+		return "reduce/reduce"
+	default:
+		return strings.Join(types, "/")
+	}
+}
+
+// Summary returns a one-line description of the conflict and its default resolution.
+func (conflict *Conflict) Summary() string {
+	state := fmt.Sprintf("%d", conflict.State)
+	if conflict.StateLabel != "" {
+		state = fmt.Sprintf("%d (%s)", conflict.State, conflict.StateLabel)
+	}
+	resolution := conflict.Resolution.Type
+	switch conflict.Resolution.Type {
+	case "shift", "reduce":
+		resolution = fmt.Sprintf("%s %d", conflict.Resolution.Type, conflict.Resolution.Target)
+	case actionTypeNonassocError:
+		resolution = "syntax error (%nonassoc)"
+	}
+	return fmt.Sprintf("%s conflict in state %s on %q: resolved as %s",
+		conflict.Kind(), state, conflict.Lookahead, resolution)
+}
+
+// String returns a multi-line description of the conflict, with its actions, items, and hints.
+func (conflict *Conflict) String() string {
+	return conflict.message
+}
+
+// ConflictsError is returned by GenerateTables when the grammar has conflicts which are neither
+// expected (via %expect) nor resolved by ParseTableOptions.ResolveConflicts.
+type ConflictsError struct {
+	Conflicts []*Conflict
+	// Expected is the %expect count, or -1 if the grammar has none.
+	Expected int
+}
+
+func (e *ConflictsError) Error() string {
+	shiftReduce, reduceReduce := countConflicts(e.Conflicts)
+	var b strings.Builder
+	for i, conflict := range e.Conflicts {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(conflict.String())
+	}
+	if len(e.Conflicts) > 0 {
+		b.WriteByte('\n')
+	}
+	b.WriteString(formatConflictCounts(shiftReduce, reduceReduce))
+	if e.Expected >= 0 {
+		fmt.Fprintf(&b, " (%%expect %d", e.Expected)
+		if reduceReduce > 0 {
+			b.WriteString("; reduce/reduce conflicts are never expected")
+		}
+		b.WriteString(")")
+	} else if reduceReduce == 0 {
+		fmt.Fprintf(&b, " (declare %%expect %d to accept, resolving by shift)", shiftReduce)
+	}
+	return b.String()
+}
+
+func formatConflictCounts(shiftReduce, reduceReduce int) string {
+	var parts []string
+	if shiftReduce > 0 {
+		parts = append(parts, pluralize(shiftReduce, "shift/reduce conflict"))
+	}
+	if reduceReduce > 0 {
+		parts = append(parts, pluralize(reduceReduce, "reduce/reduce conflict"))
+	}
+	if len(parts) == 0 {
+		return "no conflicts"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func pluralize(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// countConflicts returns the number of shift/reduce and other (reduce/reduce) conflicts.
+func countConflicts(conflicts []*Conflict) (int, int) {
+	shiftReduce := 0
+	reduceReduce := 0
+	for _, conflict := range conflicts {
+		if conflict.Kind() == "shift/reduce" {
+			shiftReduce++
+		} else {
+			reduceReduce++
+		}
+	}
+	return shiftReduce, reduceReduce
+}
+
+// checkConflicts decides whether table generation succeeds given the conflicts found:
+// all conflicts are accepted when resolve is set; otherwise the grammar must declare, via
+// %expect, exactly the number of shift/reduce conflicts found, and have no others. As with
+// bison, %expect also fails when there are fewer conflicts than declared, even none.
+func checkConflicts(conflicts []*Conflict, expected int, resolve bool) error {
+	if resolve {
+		return nil
+	}
+	shiftReduce, reduceReduce := countConflicts(conflicts)
+	if reduceReduce == 0 && (shiftReduce == expected || (expected < 0 && shiftReduce == 0)) {
+		return nil
+	}
+	return &ConflictsError{Conflicts: conflicts, Expected: expected}
+}
+
+// extractExpectedConflicts returns the %expect count, or -1 if the grammar has none.
+func extractExpectedConflicts(ast *asts.AST) (int, error) {
+	expected := -1
+	for _, node := range ast.RootNode.Children {
+		if node.Type != parsers.EBNFParserNodeTypeDirective || parsers.DirectiveName(node) != parsers.EBNFDirectiveExpect {
+			continue
+		}
+		if expected >= 0 {
+			return 0, fmt.Errorf("%%expect: declared more than once")
+		}
+		if len(node.Children) != 1 || node.Children[0].Type != parsers.EBNFParserNodeTypeInteger {
+			return 0, fmt.Errorf("%%expect: expected one integer argument")
+		}
+		n, err := strconv.Atoi(string(node.Children[0].Token.Lexeme))
+		if err != nil {
+			return 0, fmt.Errorf("%%expect: %w", err)
+		}
+		expected = n
+	}
+	return expected, nil
+}
+
+// actionTable accumulates parser actions, recording conflicts rather than failing on them.
+// Each conflicting entry holds the default resolution, so that the table is usable when
+// conflicts are accepted.
+type actionTable struct {
+	actions   map[int]map[string]Action
+	conflicts map[stateLookahead]*Conflict
+	// nonassoc holds the reduce which %nonassoc resolved, with a shift, as a syntax error.
+	nonassoc  map[stateLookahead]Action
+	grammar   *grammar
+	automaton *lrAutomaton
+}
+
+func newActionTable(grammar *grammar, automaton *lrAutomaton) *actionTable {
+	return &actionTable{
+		actions:   map[int]map[string]Action{},
+		conflicts: map[stateLookahead]*Conflict{},
+		nonassoc:  map[stateLookahead]Action{},
+		grammar:   grammar,
+		automaton: automaton,
+	}
+}
+
+func (table *actionTable) set(state int, terminal string, action Action) {
+	if table.actions[state] == nil {
+		table.actions[state] = map[string]Action{}
+	}
+	existing, ok := table.actions[state][terminal]
+	if !ok {
+		table.actions[state][terminal] = action
+		return
+	}
+	if existing == action {
+		return
+	}
+	key := stateLookahead{state: state, lookahead: terminal}
+	if conflict, ok := table.conflicts[key]; ok {
+		for _, prior := range conflict.Actions {
+			if prior == action {
+				return
+			}
+		}
+		conflict.Actions = append(conflict.Actions, action)
+		conflict.Resolution = defaultResolution(conflict.Resolution, action)
+		table.actions[state][terminal] = conflict.Resolution
+		return
+	}
+	if existing.Type == actionTypeNonassocError {
+		// Precedence does not apply between reduces, so the entry stays a syntax error, but
+		// the reduce/reduce conflict with the one %nonassoc resolved is reported.
+		table.conflicts[key] = &Conflict{
+			State:      state,
+			Lookahead:  terminal,
+			Actions:    []Action{table.nonassoc[key], action},
+			Resolution: existing,
+		}
+		return
+	}
+	if resolved, ok := table.grammar.resolveByPrecedence(terminal, existing, action); ok {
+		if resolved.Type == actionTypeNonassocError {
+			if existing.Type == "reduce" {
+				table.nonassoc[key] = existing
+			} else {
+				table.nonassoc[key] = action
+			}
+		}
+		table.actions[state][terminal] = resolved
+		return
+	}
+	resolution := defaultResolution(existing, action)
+	table.conflicts[key] = &Conflict{
+		State:      state,
+		Lookahead:  terminal,
+		Actions:    []Action{existing, action},
+		Resolution: resolution,
+	}
+	table.actions[state][terminal] = resolution
+}
+
+// defaultResolution prefers shift over reduce, and the earlier production between two reduces.
+// Ending a record (accept_and_yield) comes last, so that records are as long as possible.
+// Otherwise the existing action is kept.
+func defaultResolution(existing Action, next Action) Action {
+	switch {
+	case existing.Type == "shift":
+		return existing
+	case next.Type == "shift":
+		return next
+	case existing.Type == "accept_and_yield" && next.Type == "reduce":
+		return next
+	case existing.Type == "reduce" && next.Type == "reduce" && next.Target < existing.Target:
+		return next
+	default:
+		return existing
+	}
+}
+
+// sortedConflicts returns the recorded conflicts ordered by state and lookahead, with their
+// counterexamples and descriptions filled in.
+func (table *actionTable) sortedConflicts(gotos map[int]map[string]int) []*Conflict {
+	conflicts := make([]*Conflict, 0, len(table.conflicts))
+	for key, conflict := range table.conflicts {
+		itemSet := table.automaton.states[key.state]
+		conflict.StateLabel = table.automaton.labels[key.state]
+		conflict.LALRMerge = table.automaton.mergeConflicts[key]
+		conflict.Items = nil
+		for _, it := range sortedItems(itemSet) {
+			conflict.Items = append(conflict.Items, formatItem(it, table.grammar))
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		if conflicts[i].State != conflicts[j].State {
+			return conflicts[i].State < conflicts[j].State
+		}
+		return conflicts[i].Lookahead < conflicts[j].Lookahead
+	})
+	findCounterexamples(conflicts, table, gotos)
+	for _, conflict := range conflicts {
+		conflict.message = formatConflict(conflict, table.grammar, table.automaton.labels)
+	}
+	return conflicts
+}
+
+func formatConflict(conflict *Conflict, grammar *grammar, stateLabels map[int]string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Action conflict (%s)\n  State: %s\n  Lookahead: %q\n\n",
+		conflict.Kind(), formatStateLabel(conflict.State, stateLabels), conflict.Lookahead)
+	for i, action := range conflict.Actions {
+		label := "Existing"
+		if i > 0 {
+			label = "New"
+		}
+		b.WriteString(formatAction(label, action, grammar, stateLabels))
+	}
+	b.WriteString(formatAction("Default", conflict.Resolution, grammar, stateLabels))
+	if len(conflict.Items) > 0 {
+		b.WriteString("\n  Items in state:\n")
+		for _, it := range conflict.Items {
+			b.WriteString("    ")
+			b.WriteString(it)
+			b.WriteByte('\n')
+		}
+	}
+	b.WriteString(formatCounterexample(conflict, grammar))
+	var hint string
+	seen := map[string]bool{}
+	for i := 1; i < len(conflict.Actions); i++ {
+		for _, line := range strings.SplitAfter(buildConflictHint(conflict.Actions[0], conflict.Actions[i], grammar), "\n") {
+			if line != "" && !seen[line] {
+				seen[line] = true
+				hint += line
+			}
+		}
+	}
+	if conflict.LALRMerge {
+		hint += "- This reduce/reduce conflict was introduced by LALR(1) merging of states with identical cores; " +
+			"the grammar may be LR(1) but not LALR(1) (try canonical LR(1) tables)\n"
+	}
+	if hint != "" {
+		b.WriteString("\n  Hint:\n")
+		b.WriteString(hint)
+	}
+	return b.String()
+}
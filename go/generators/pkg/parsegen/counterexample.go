@@ -0,0 +1,358 @@
+package parsegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Counterexample shows how the parser reaches a conflict and, where one was found, an input
+// which the grammar derives in two ways.
+type Counterexample struct {
+	// Prefix is a sequence of grammar symbols leading from the start state to the conflict state.
+	Prefix []string
+	// Input is Prefix with each nonterminal expanded to a shortest terminal string. The
+	// conflict's lookahead terminal comes next.
+	Input []string
+	// Sentence, when non-empty, is a complete input with two distinct derivations. It begins
+	// with Input; Derivations[i] results from taking Actions[i] at the conflict.
+	Sentence    []string
+	Actions     []Action
+	Derivations []string
+}
+
+// Search bounds: counterexamples are best-effort, and must not make table generation slow
+// for grammars with many conflicts.
+const (
+	counterexampleMaxPrefixStacks = 2000
+	counterexampleMaxUnifyTries   = 20
+	counterexampleMaxUnifyNodes   = 5000
+	counterexampleMaxSuffixLength = 12
+	counterexampleMaxReduceSteps  = 100
+)
+
+// counterexampleSearch runs a nondeterministic LR parser over the action table, following every
+// action of a conflict entry rather than its default resolution.
+type counterexampleSearch struct {
+	grammar    *grammar
+	automaton  *lrAutomaton
+	table      *actionTable
+	gotos      map[int]map[string]int
+	terminals  []string
+	yields     map[string][]string
+	derivation map[string]string
+}
+
+// lrBranch is one parser configuration: a state stack, with a bracketed derivation per entry.
+type lrBranch struct {
+	states   []int
+	values   []string
+	accepted bool
+}
+
+func (branch *lrBranch) top() int {
+	return branch.states[len(branch.states)-1]
+}
+
+func (branch *lrBranch) key() string {
+	var b strings.Builder
+	for _, state := range branch.states {
+		fmt.Fprintf(&b, "%d,", state)
+	}
+	if branch.accepted {
+		b.WriteString("accepted")
+	}
+	return b.String()
+}
+
+// findCounterexamples attaches a counterexample to each conflict.
+func findCounterexamples(conflicts []*Conflict, table *actionTable, gotos map[int]map[string]int) {
+	if len(conflicts) == 0 {
+		return
+	}
+	search := &counterexampleSearch{
+		grammar:   table.grammar,
+		automaton: table.automaton,
+		table:     table,
+		gotos:     gotos,
+	}
+	for term := range table.grammar.terminals {
+		if term != eofSymbol {
+			search.terminals = append(search.terminals, term)
+		}
+	}
+	sort.Strings(search.terminals)
+	// Try end of input first, so that the shortest completions are found first.
+	search.terminals = append([]string{eofSymbol}, search.terminals...)
+	search.yields, search.derivation = shortestYields(table.grammar)
+
+	for _, conflict := range conflicts {
+		conflict.Counterexample = search.find(conflict)
+	}
+}
+
+// shortestYields returns, for each productive nonterminal, a shortest terminal string it derives,
+// along with the bracketed derivation of that string.
+func shortestYields(grammar *grammar) (map[string][]string, map[string]string) {
+	yields := map[string][]string{}
+	derivations := map[string]string{}
+	for changed := true; changed; {
+		changed = false
+		for _, prod := range grammar.productions {
+			var yield []string
+			var children []string
+			productive := true
+			for _, sym := range prod.RHS {
+				if sym.Terminal {
+					yield = append(yield, sym.Name)
+					children = append(children, sym.Name)
+					continue
+				}
+				symYield, ok := yields[sym.Name]
+				if !ok {
+					productive = false
+					break
+				}
+				yield = append(yield, symYield...)
+				children = append(children, derivations[sym.Name])
+			}
+			if !productive {
+				continue
+			}
+			if existing, ok := yields[prod.LHS]; ok && len(existing) <= len(yield) {
+				continue
+			}
+			yields[prod.LHS] = yield
+			derivations[prod.LHS] = formatDerivation(prod.LHS, children)
+			changed = true
+		}
+	}
+	return yields, derivations
+}
+
+func formatDerivation(lhs string, children []string) string {
+	return lhs + "(" + strings.Join(children, " ") + ")"
+}
+
+// find searches breadth-first over state stacks reachable from the start states, i.e. over viable
+// prefixes. Each stack ending in the conflict state is a candidate from which the conflicting
+// actions are followed jointly. The first candidate is kept as the example if none unifies.
+func (search *counterexampleSearch) find(conflict *Conflict) *Counterexample {
+	type prefixNode struct {
+		branch  *lrBranch
+		symbols []string
+		input   []string
+	}
+	var shortest *Counterexample
+	var queue []prefixNode
+	visited := map[string]bool{}
+	for startState := range search.grammar.startSymbols {
+		queue = append(queue, prefixNode{branch: &lrBranch{states: []int{startState}}})
+		visited[queue[startState].branch.key()] = true
+	}
+	tries := 0
+	for len(queue) > 0 && len(visited) <= counterexampleMaxPrefixStacks && tries < counterexampleMaxUnifyTries {
+		node := queue[0]
+		queue = queue[1:]
+		if node.branch.top() == conflict.State {
+			example := &Counterexample{Prefix: node.symbols, Input: node.input}
+			if shortest == nil {
+				shortest = example
+			}
+			tries++
+			if search.unify(conflict, node.branch, example) {
+				return example
+			}
+		}
+		for _, tr := range search.automaton.transitions[node.branch.top()] {
+			var value string
+			var yield []string
+			if tr.symbol.Terminal {
+				value = tr.symbol.Name
+				yield = []string{tr.symbol.Name}
+			} else {
+				var ok bool
+				if yield, ok = search.yields[tr.symbol.Name]; !ok {
+					continue
+				}
+				value = search.derivation[tr.symbol.Name]
+			}
+			next := &lrBranch{
+				states: appendInt(node.branch.states, tr.target),
+				values: appendString(node.branch.values, value),
+			}
+			key := next.key()
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+			queue = append(queue, prefixNode{
+				branch:  next,
+				symbols: appendString(node.symbols, tr.symbol.Name),
+				input:   append(append([]string{}, node.input...), yield...),
+			})
+		}
+	}
+	return shortest
+}
+
+// unify looks for a completion of the input such that two parses, differing only in the
+// action taken at the conflict, both accept. Two such parses are two distinct rightmost
+// derivations of one sentence, showing that the grammar is ambiguous.
+func (search *counterexampleSearch) unify(conflict *Conflict, prefix *lrBranch, example *Counterexample) bool {
+	for i := 0; i < len(conflict.Actions); i++ {
+		for j := i + 1; j < len(conflict.Actions); j++ {
+			if search.unifyActions(conflict, prefix, conflict.Actions[i], conflict.Actions[j], example) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (search *counterexampleSearch) unifyActions(conflict *Conflict, prefix *lrBranch, first Action, second Action, example *Counterexample) bool {
+	type unifyNode struct {
+		a, b     *lrBranch
+		consumed []string
+	}
+	firstBranches := search.step(prefix, conflict.Lookahead, &first)
+	secondBranches := search.step(prefix, conflict.Lookahead, &second)
+	var queue []unifyNode
+	visited := map[string]bool{}
+	for _, a := range firstBranches {
+		for _, b := range secondBranches {
+			queue = append(queue, unifyNode{a: a, b: b, consumed: []string{conflict.Lookahead}})
+		}
+	}
+	for len(queue) > 0 && len(visited) <= counterexampleMaxUnifyNodes {
+		node := queue[0]
+		queue = queue[1:]
+		if node.a.accepted && node.b.accepted {
+			example.Sentence = append(append([]string{}, example.Input...), node.consumed[:len(node.consumed)-1]...)
+			example.Actions = []Action{first, second}
+			example.Derivations = []string{node.a.values[len(node.a.values)-1], node.b.values[len(node.b.values)-1]}
+			return true
+		}
+		if node.a.accepted || node.b.accepted || len(node.consumed) > counterexampleMaxSuffixLength {
+			continue
+		}
+		for _, term := range search.terminals {
+			for _, a := range search.step(node.a, term, nil) {
+				for _, b := range search.step(node.b, term, nil) {
+					key := a.key() + "|" + b.key()
+					if visited[key] {
+						continue
+					}
+					visited[key] = true
+					queue = append(queue, unifyNode{a: a, b: b, consumed: appendString(node.consumed, term)})
+				}
+			}
+		}
+	}
+	return false
+}
+
+// step returns the configurations reachable from branch by reductions on lookahead terminal
+// followed by a shift of it, or by acceptance at end of input. If forced is non-nil, it is
+// taken as the first action in place of the table's.
+func (search *counterexampleSearch) step(branch *lrBranch, terminal string, forced *Action) []*lrBranch {
+	var out []*lrBranch
+	pending := []*lrBranch{branch}
+	for steps := 0; len(pending) > 0 && steps < counterexampleMaxReduceSteps; steps++ {
+		current := pending[0]
+		pending = pending[1:]
+		actions := search.actionsAt(current.top(), terminal)
+		if forced != nil {
+			actions = []Action{*forced}
+			forced = nil
+		}
+		for _, action := range actions {
+			switch action.Type {
+			case "shift":
+				out = append(out, &lrBranch{
+					states: appendInt(current.states, action.Target),
+					values: appendString(current.values, terminal),
+				})
+			case "accept":
+				out = append(out, &lrBranch{states: current.states, values: current.values, accepted: true})
+			case "reduce":
+				if reduced := search.reduce(current, action.Target); reduced != nil {
+					pending = append(pending, reduced)
+				}
+			}
+		}
+	}
+	return out
+}
+
+func (search *counterexampleSearch) reduce(branch *lrBranch, prodIndex int) *lrBranch {
+	prod := search.grammar.productions[prodIndex]
+	n := len(prod.RHS)
+	if n >= len(branch.states) {
+		return nil
+	}
+	base := len(branch.states) - n
+	target, ok := search.gotos[branch.states[base-1]][prod.LHS]
+	if !ok {
+		return nil
+	}
+	children := branch.values[len(branch.values)-n:]
+	return &lrBranch{
+		states: appendInt(branch.states[:base], target),
+		values: appendString(branch.values[:len(branch.values)-n], formatDerivation(prod.LHS, children)),
+	}
+}
+
+// actionsAt returns every action for the state and terminal, including all sides of a conflict.
+func (search *counterexampleSearch) actionsAt(state int, terminal string) []Action {
+	if conflict, ok := search.table.conflicts[stateLookahead{state: state, lookahead: terminal}]; ok {
+		return conflict.Actions
+	}
+	action, ok := search.table.actions[state][terminal]
+	if !ok || action.Type == actionTypeNonassocError {
+		return nil
+	}
+	return []Action{action}
+}
+
+func appendInt(values []int, value int) []int {
+	out := make([]int, len(values), len(values)+1)
+	copy(out, values)
+	return append(out, value)
+}
+
+func appendString(values []string, value string) []string {
+	out := make([]string, len(values), len(values)+1)
+	copy(out, values)
+	return append(out, value)
+}
+
+func formatCounterexample(conflict *Conflict, grammar *grammar) string {
+	example := conflict.Counterexample
+	if example == nil {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("\n  Counterexample:\n")
+	fmt.Fprintf(&b, "    Prefix: %s\n", joinWithConflictPoint(example.Prefix, conflict.Lookahead))
+	fmt.Fprintf(&b, "    Input:  %s\n", joinWithConflictPoint(example.Input, conflict.Lookahead))
+	if len(example.Sentence) == 0 {
+		b.WriteString("    No ambiguous sentence found; the grammar may be unambiguous but need more lookahead\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "    Ambiguous sentence: %s\n", strings.Join(example.Sentence, " "))
+	for i, derivation := range example.Derivations {
+		action := example.Actions[i]
+		description := action.Type
+		if action.Type == "reduce" {
+			description = "reduce by " + formatProduction(action.Target, grammar)
+		}
+		fmt.Fprintf(&b, "    Derivation %d (%s):\n      %s\n", i+1, description, derivation)
+	}
+	return b.String()
+}
+
+func joinWithConflictPoint(symbols []string, lookahead string) string {
+	parts := append(append([]string{}, symbols...), ".", lookahead)
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,54 @@
+package parsegen
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCounterexampleDanglingElse(t *testing.T) {
+	tables, err := GenerateTables(danglingElseBNF, &ParseTableOptions{ResolveConflicts: true})
+	if err != nil {
+		t.Fatalf("GenerateTables: %v", err)
+	}
+	if len(tables.Conflicts) != 1 {
+		t.Fatalf("expected one conflict, got %d", len(tables.Conflicts))
+	}
+	example := tables.Conflicts[0].Counterexample
+	if example == nil {
+		t.Fatal("expected a counterexample")
+	}
+	if got := strings.Join(example.Input, " "); got != "if cond then if cond then stmt" {
+		t.Errorf("input: got %q", got)
+	}
+	if got := strings.Join(example.Sentence, " "); got != "if cond then if cond then stmt else stmt" {
+		t.Errorf("sentence: got %q", got)
+	}
+	if len(example.Derivations) != 2 || example.Derivations[0] == example.Derivations[1] {
+		t.Fatalf("expected two distinct derivations, got %v", example.Derivations)
+	}
+	// The shift derivation attaches the else to the inner if.
+	if !strings.Contains(example.Derivations[0], "S(if cond then S(stmt) else S(stmt))") {
+		t.Errorf("unexpected shift derivation %s", example.Derivations[0])
+	}
+	if !strings.Contains(tables.Conflicts[0].String(), "Ambiguous sentence: if cond then if cond then stmt else stmt") {
+		t.Errorf("conflict description should include the counterexample:\n%s", tables.Conflicts[0])
+	}
+}
+
+func TestCounterexampleNotAmbiguous(t *testing.T) {
+	_, err := GenerateTables(lr1NotLALRBNF, &ParseTableOptions{LALR: true})
+	var conflictsErr *ConflictsError
+	if !errors.As(err, &conflictsErr) {
+		t.Fatalf("expected ConflictsError, got %v", err)
+	}
+	for _, conflict := range conflictsErr.Conflicts {
+		example := conflict.Counterexample
+		if example == nil || len(example.Prefix) == 0 {
+			t.Fatalf("expected a prefix reaching state %d", conflict.State)
+		}
+		if len(example.Sentence) != 0 {
+			t.Errorf("grammar is unambiguous, but got ambiguous sentence %v", example.Sentence)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package parsegen
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/bnf"
+	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+)
+
+// extractDisplayNames returns the display names of terminals from the grammar's %display
+// directives, each of which lists terminals, each followed by its quoted display name. Generated
+// parsers' syntax errors name terminals by their display names, e.g. '+' rather than plus.
+func extractDisplayNames(ast *asts.AST) (map[string]string, error) {
+	displayNames := map[string]string{}
+	for _, node := range ast.RootNode.Children {
+		if node.Type != parsers.EBNFParserNodeTypeDirective || parsers.DirectiveName(node) != parsers.EBNFDirectiveDisplay {
+			continue
+		}
+		if len(node.Children) == 0 || len(node.Children)%2 != 0 {
+			return nil, fmt.Errorf("%%display: expected pairs of terminal and display name")
+		}
+		for i := 0; i < len(node.Children); i += 2 {
+			terminal, err := bnf.PrecedenceSymbolName(node.Children[i])
+			if err != nil {
+				return nil, fmt.Errorf("%%display: %w", err)
+			}
+			if node.Children[i+1].Type != parsers.EBNFParserNodeTypeLiteral {
+				return nil, fmt.Errorf("%%display: display name of %q must be a quoted literal", terminal)
+			}
+			displayName, err := bnf.PrecedenceSymbolName(node.Children[i+1])
+			if err != nil {
+				return nil, fmt.Errorf("%%display: %w", err)
+			}
+			if _, ok := displayNames[terminal]; ok {
+				return nil, fmt.Errorf("%%display: %q declared more than once", terminal)
+			}
+			displayNames[terminal] = displayName
+		}
+	}
+	return displayNames, nil
+}
+
+// validateDisplayNames checks that each terminal given a display name is one of the grammar's.
+func validateDisplayNames(grammar *grammar, displayNames map[string]string) error {
+	terminals := make([]string, 0, len(displayNames))
+	for terminal := range displayNames {
+		terminals = append(terminals, terminal)
+	}
+	sort.Strings(terminals)
+	for _, terminal := range terminals {
+		if !grammar.terminals[terminal] {
+			return fmt.Errorf("%%display: %q is not a terminal of the grammar", terminal)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,328 @@
+package parsegen
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxErrorSearchConfigurations bounds the parser configurations ErrorStates explores.
+const maxErrorSearchConfigurations = 10000
+
+// errorSearchStackDepth is the number of states, from the top of the stack, by which ErrorStates
+// tells configurations apart.
+const errorSearchStackDepth = 8
+
+// ErrorState is a parser state in which a syntax error can be found, with a shortest example
+// sentence ending in such an error, written as in a messages file.
+type ErrorState struct {
+	State    int
+	Sentence string
+}
+
+// messageEntry is one entry of a messages file: example sentences and the message for the
+// states they fail in.
+type messageEntry struct {
+	sentences []messageSentence
+	message   string
+}
+
+// messageSentence is a sentence line of a messages file, split into fields.
+type messageSentence struct {
+	line   int
+	fields []string
+}
+
+// ApplyErrorMessages sets tables.ErrorMessages from the text of a messages file, in the style of
+// Menhir's .messages files. Each entry is one or more example sentences, one per line, then a
+// blank line, then the message, running to the next blank line:
+//
+//	# Lines starting with '#' between entries are comments.
+//	Program: id equals int_literal id
+//	Program: int_literal id
+//
+//	missing ';' after expression
+//
+// A sentence is a list of terminals, optionally preceded by a start symbol and a colon; the
+// default is the grammar's first start symbol. Parsing it must give a syntax error at its last
+// terminal, which may be EOF. The message is then used for syntax errors in the state where that
+// error is found, whatever the input leading there. Sentences of different entries failing in the
+// same state are an error.
+func ApplyErrorMessages(tables *Tables, messagesText string, sourceName string) error {
+	entries, err := parseMessages(messagesText, sourceName)
+	if err != nil {
+		return err
+	}
+	startStates := tablesStartStates(tables)
+	terminals := tablesTerminals(tables)
+	messages := map[int]string{}
+	messageLines := map[int]int{}
+	for _, entry := range entries {
+		for _, sentence := range entry.sentences {
+			where := messagesLocation(sourceName, sentence.line)
+			symbol, sentenceTerminals := "", sentence.fields
+			if name, ok := strings.CutSuffix(sentenceTerminals[0], ":"); ok && !terminals[sentenceTerminals[0]] {
+				symbol, sentenceTerminals = name, sentenceTerminals[1:]
+			}
+			startState, ok := startStates[symbol]
+			if !ok {
+				return fmt.Errorf("%s: %q is not a start symbol", where, symbol)
+			}
+			if len(sentenceTerminals) == 0 {
+				return fmt.Errorf("%s: sentence has no terminals", where)
+			}
+			for _, terminal := range sentenceTerminals {
+				if !terminals[terminal] {
+					return fmt.Errorf("%s: %q is not a terminal of the grammar", where, terminal)
+				}
+			}
+			state, err := sentenceErrorState(tables, startState, sentenceTerminals)
+			if err != nil {
+				return fmt.Errorf("%s: %w", where, err)
+			}
+			if existing, ok := messages[state]; ok && existing != entry.message {
+				return fmt.Errorf("%s: fails in state %d, which line %d already gives a different message",
+					where, state, messageLines[state])
+			}
+			messages[state] = entry.message
+			messageLines[state] = sentence.line
+		}
+	}
+	if len(messages) == 0 {
+		messages = nil
+	}
+	tables.ErrorMessages = messages
+	return nil
+}
+
+// UncoveredErrorStates returns the states found by ErrorStates which have no error message.
+func UncoveredErrorStates(tables *Tables) []ErrorState {
+	uncovered := make([]ErrorState, 0)
+	for _, errorState := range ErrorStates(tables) {
+		if _, ok := tables.ErrorMessages[errorState.State]; !ok {
+			uncovered = append(uncovered, errorState)
+		}
+	}
+	return uncovered
+}
+
+// ErrorStates returns the states in which a syntax error can be found, in order, each with a
+// shortest sentence giving such an error. It searches breadth-first over parser configurations
+// from each start state, treating configurations with the same top few states as the same, and
+// within a bound on their number, so states whose errors need long inputs or deep stacks to reach
+// may be missed.
+func ErrorStates(tables *Tables) []ErrorState {
+	type configuration struct {
+		stack     []int
+		symbol    string
+		terminals []string
+	}
+	terminals := make([]string, 0)
+	for terminal := range tablesTerminals(tables) {
+		terminals = append(terminals, terminal)
+	}
+	sort.Strings(terminals)
+
+	startStates := tablesStartStates(tables)
+	symbols := make([]string, 0, len(startStates))
+	for symbol := range startStates {
+		if symbol != "" {
+			symbols = append(symbols, symbol)
+		}
+	}
+	sort.Slice(symbols, func(i, j int) bool { return startStates[symbols[i]] < startStates[symbols[j]] })
+
+	queue := make([]configuration, 0)
+	seen := map[string]bool{}
+	for _, symbol := range symbols {
+		stack := []int{startStates[symbol]}
+		queue = append(queue, configuration{stack: stack, symbol: symbol})
+		seen[stackKey(stack)] = true
+	}
+	// Only states lacking an action for some terminal can have syntax errors.
+	candidates := 0
+	for _, stateActions := range tables.Actions {
+		for _, terminal := range terminals {
+			if _, ok := stateActions[terminal]; !ok {
+				candidates++
+				break
+			}
+		}
+	}
+	found := map[int]string{}
+	for len(queue) > 0 && len(found) < candidates {
+		config := queue[0]
+		queue = queue[1:]
+		for _, terminal := range terminals {
+			stack, outcome := stepTables(tables, config.stack, terminal)
+			sentence := append(append([]string(nil), config.terminals...), terminal)
+			switch outcome {
+			case stepError:
+				state := stack[len(stack)-1]
+				if _, ok := found[state]; !ok {
+					found[state] = config.symbol + ": " + strings.Join(sentence, " ")
+				}
+			case stepShifted:
+				if terminal == eofSymbol || len(seen) >= maxErrorSearchConfigurations {
+					continue
+				}
+				key := stackKey(stack)
+				if !seen[key] {
+					seen[key] = true
+					queue = append(queue, configuration{stack: stack, symbol: config.symbol, terminals: sentence})
+				}
+			}
+		}
+	}
+
+	errorStates := make([]ErrorState, 0, len(found))
+	for state, sentence := range found {
+		errorStates = append(errorStates, ErrorState{State: state, Sentence: sentence})
+	}
+	sort.Slice(errorStates, func(i, j int) bool { return errorStates[i].State < errorStates[j].State })
+	return errorStates
+}
+
+// parseMessages parses the entries of a messages file.
+func parseMessages(text string, sourceName string) ([]messageEntry, error) {
+	entries := make([]messageEntry, 0)
+	var entry *messageEntry
+	var messageLines []string
+	finishEntry := func() {
+		if entry != nil && len(messageLines) > 0 {
+			entry.message = strings.Join(messageLines, "\n")
+			entries = append(entries, *entry)
+			entry, messageLines = nil, nil
+		}
+	}
+	inMessage := false
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lineNumber := i + 1
+		trimmed := strings.TrimSpace(line)
+		if inMessage {
+			if trimmed == "" {
+				if len(messageLines) > 0 {
+					finishEntry()
+					inMessage = false
+				}
+				continue
+			}
+			messageLines = append(messageLines, trimmed)
+			continue
+		}
+		if strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if trimmed == "" {
+			if entry != nil {
+				inMessage = true
+			}
+			continue
+		}
+		if entry == nil {
+			entry = &messageEntry{}
+		}
+		entry.sentences = append(entry.sentences, messageSentence{line: lineNumber, fields: strings.Fields(trimmed)})
+	}
+	finishEntry()
+	if entry != nil {
+		return nil, fmt.Errorf("%s: sentences without a message", messagesLocation(sourceName, entry.sentences[0].line))
+	}
+	return entries, nil
+}
+
+func messagesLocation(sourceName string, line int) string {
+	if sourceName == "" {
+		return fmt.Sprintf("line %d", line)
+	}
+	return fmt.Sprintf("%s, line %d", sourceName, line)
+}
+
+// tablesStartStates maps the tables' start symbols to their start states. The empty symbol maps
+// to the state of the first start symbol.
+func tablesStartStates(tables *Tables) map[string]int {
+	startStates := map[string]int{"": 0, tables.StartSymbol: 0}
+	for _, entryPoint := range tables.EntryPoints {
+		startStates[entryPoint.Symbol] = entryPoint.State
+	}
+	return startStates
+}
+
+// tablesTerminals returns the terminals having actions in the tables, and EOF, but not the error
+// terminal.
+func tablesTerminals(tables *Tables) map[string]bool {
+	terminals := map[string]bool{eofSymbol: true}
+	for _, stateActions := range tables.Actions {
+		for terminal := range stateActions {
+			if terminal != errorSymbol {
+				terminals[terminal] = true
+			}
+		}
+	}
+	return terminals
+}
+
+// sentenceErrorState runs the tables on the terminals, and returns the state in which the last of
+// them is a syntax error. It fails if there is a syntax error earlier, or none.
+func sentenceErrorState(tables *Tables, startState int, terminals []string) (int, error) {
+	stack := []int{startState}
+	for i, terminal := range terminals {
+		next, outcome := stepTables(tables, stack, terminal)
+		last := i == len(terminals)-1
+		switch {
+		case outcome == stepError && last:
+			return next[len(next)-1], nil
+		case outcome == stepError:
+			return 0, fmt.Errorf("syntax error at %s, before the end of the sentence", terminal)
+		case outcome == stepAccepted || last || terminal == eofSymbol:
+			return 0, fmt.Errorf("sentence does not end in a syntax error")
+		}
+		stack = next
+	}
+	return 0, fmt.Errorf("sentence has no terminals")
+}
+
+const (
+	stepShifted = iota
+	stepError
+	stepAccepted
+)
+
+// stepTables runs the tables on one terminal from a copy of the state stack: reductions, then a
+// shift, an accept, or a syntax error. It returns the new stack, which on a syntax error is the
+// stack after the reductions, with the state the error is found in on top.
+func stepTables(tables *Tables, stateStack []int, terminal string) ([]int, int) {
+	stack := append([]int(nil), stateStack...)
+	for {
+		action, ok := tables.Actions[stack[len(stack)-1]][terminal]
+		if !ok {
+			return stack, stepError
+		}
+		switch action.Type {
+		case "shift":
+			return append(stack, action.Target), stepShifted
+		case "reduce":
+			prod := tables.Productions[action.Target]
+			stack = stack[:len(stack)-len(prod.RHS)]
+			target, ok := tables.Gotos[stack[len(stack)-1]][prod.LHS]
+			if !ok {
+				return stack, stepError
+			}
+			stack = append(stack, target)
+		default:
+			return stack, stepAccepted
+		}
+	}
+}
+
+// stackKey identifies a configuration by the top errorSearchStackDepth states of its stack.
+func stackKey(stack []int) string {
+	var b strings.Builder
+	for _, state := range stack[max(0, len(stack)-errorSearchStackDepth):] {
+		b.WriteString(strconv.Itoa(state))
+		b.WriteByte(',')
+	}
+	return b.String()
+}
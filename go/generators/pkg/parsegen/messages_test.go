@@ -0,0 +1,127 @@
+package parsegen
+
+import (
+	"reflect"
+	"testing"
+)
+
+const messagesTestBNF = `
+id ::= "x" ; int ::= "0" ; equals ::= "=" ; semi ::= ";" ;
+%start Program Expression ;
+Program ::= { Statement } ;
+Statement ::= id equals Expression semi ;
+Expression ::= int | id ;
+`
+
+func messagesTestTables(t *testing.T) *Tables {
+	t.Helper()
+	tables, err := GenerateTables(messagesTestBNF, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables: %v", err)
+	}
+	return tables
+}
+
+func TestApplyErrorMessages(t *testing.T) {
+	tables := messagesTestTables(t)
+	err := ApplyErrorMessages(tables, `
+# Comments are skipped.
+id equals int id
+Program: id equals id EOF
+
+missing ';'
+
+id int
+id EOF
+
+expected '='
+after identifier
+
+Expression: semi
+
+expected an expression
+`, "test.messages")
+	if err != nil {
+		t.Fatalf("ApplyErrorMessages: %v", err)
+	}
+
+	expected := map[string]string{
+		"Program: id equals int id": "missing ';'",
+		"Program: id equals id EOF": "missing ';'",
+		"Program: id int":           "expected '='\nafter identifier",
+		"Expression: semi":          "expected an expression",
+	}
+	for sentence, message := range expected {
+		state := sentenceState(t, tables, sentence)
+		if tables.ErrorMessages[state] != message {
+			t.Errorf("%s: got message %q in state %d, expected %q", sentence, tables.ErrorMessages[state], state, message)
+		}
+	}
+	if len(tables.ErrorMessages) != 4 {
+		t.Errorf("ErrorMessages: got %v, expected 4 states", tables.ErrorMessages)
+	}
+}
+
+// sentenceState returns the state a messages-file sentence fails in.
+func sentenceState(t *testing.T, tables *Tables, sentence string) int {
+	t.Helper()
+	probe := &Tables{StartSymbol: tables.StartSymbol, EntryPoints: tables.EntryPoints, Actions: tables.Actions, Gotos: tables.Gotos, Productions: tables.Productions}
+	if err := ApplyErrorMessages(probe, sentence+"\n\nprobe\n", ""); err != nil {
+		t.Fatalf("%s: %v", sentence, err)
+	}
+	for state := range probe.ErrorMessages {
+		return state
+	}
+	t.Fatalf("%s: no state", sentence)
+	return 0
+}
+
+func TestApplyErrorMessagesErrors(t *testing.T) {
+	for text, expected := range map[string]string{
+		"id equals\n":                            "line 1: sentences without a message",
+		"Root: id int\n\nm\n":                    `line 1: "Root" is not a start symbol`,
+		"id plus\n\nm\n":                         `line 1: "plus" is not a terminal of the grammar`,
+		"Program:\n\nm\n":                        "line 1: sentence has no terminals",
+		"id equals int semi\n\nm\n":              "line 1: sentence does not end in a syntax error",
+		"id int equals\n\nm\n":                   "line 1: syntax error at int, before the end of the sentence",
+		"id int\n\nfirst\n\nid semi\n\nsecond\n": "line 5: fails in state 5, which line 1 already gives a different message",
+	} {
+		err := ApplyErrorMessages(messagesTestTables(t), text, "")
+		if err == nil || err.Error() != expected {
+			t.Errorf("%q: got %v, expected %q", text, err, expected)
+		}
+	}
+}
+
+func TestUncoveredErrorStates(t *testing.T) {
+	tables := messagesTestTables(t)
+	all := ErrorStates(tables)
+	if len(all) == 0 {
+		t.Fatal("ErrorStates: none found")
+	}
+	sentences := map[string]bool{}
+	for _, errorState := range all {
+		sentences[errorState.Sentence] = true
+	}
+	for _, sentence := range []string{"Program: equals", "Program: id EOF", "Expression: EOF"} {
+		if !sentences[sentence] {
+			t.Errorf("ErrorStates: no %q among %v", sentence, all)
+		}
+	}
+
+	if err := ApplyErrorMessages(tables, "id EOF\n\nexpected '='\n", ""); err != nil {
+		t.Fatalf("ApplyErrorMessages: %v", err)
+	}
+	uncovered := UncoveredErrorStates(tables)
+	if len(uncovered) != len(all)-1 {
+		t.Errorf("UncoveredErrorStates: got %d states, expected %d", len(uncovered), len(all)-1)
+	}
+	for _, errorState := range uncovered {
+		if errorState.Sentence == "Program: id EOF" {
+			t.Errorf("UncoveredErrorStates: includes the covered state %d", errorState.State)
+		}
+	}
+	if !reflect.DeepEqual(UncoveredErrorStates(tables), uncovered) {
+		t.Error("UncoveredErrorStates: not deterministic")
+	}
+}
@@ -0,0 +1,124 @@
+package parsegen
+
+import (
+	"fmt"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/bnf"
+	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+)
+
+// precedenceLevel is the yacc-style precedence of a terminal (or %prec pseudo-symbol).
+// Each %left/%right/%nonassoc directive declares one level; later directives bind tighter.
+type precedenceLevel struct {
+	level int
+	assoc string
+}
+
+// actionTypeNonassocError marks a shift/reduce conflict resolved as a syntax error by %nonassoc.
+// It is only used during table construction: such entries are removed from the final tables.
+const actionTypeNonassocError = "nonassoc_error"
+
+// extractPrecedence collects %left, %right, and %nonassoc directives from the grammar AST.
+// Directive arguments are lexer-rule names, quoted literals, or pseudo-symbols used only with %prec.
+func extractPrecedence(ast *asts.AST) (map[string]precedenceLevel, error) {
+	precedence := map[string]precedenceLevel{}
+	level := 0
+	for _, node := range ast.RootNode.Children {
+		if node.Type != parsers.EBNFParserNodeTypeDirective {
+			continue
+		}
+		assoc := parsers.DirectiveName(node)
+		switch assoc {
+		case parsers.EBNFDirectiveLeft, parsers.EBNFDirectiveRight, parsers.EBNFDirectiveNonassoc:
+		default:
+			continue
+		}
+		if len(node.Children) == 0 {
+			return nil, fmt.Errorf("%%%s: expected one or more symbols", assoc)
+		}
+		level++
+		for _, child := range node.Children {
+			name, err := bnf.PrecedenceSymbolName(child)
+			if err != nil {
+				return nil, fmt.Errorf("%%%s: %w", assoc, err)
+			}
+			if _, ok := precedence[name]; ok {
+				return nil, fmt.Errorf("%%%s: symbol %q already has a declared precedence", assoc, name)
+			}
+			precedence[name] = precedenceLevel{level: level, assoc: assoc}
+		}
+	}
+	return precedence, nil
+}
+
+// validatePrecedenceOverrides checks that each %prec symbol has a declared precedence.
+func validatePrecedenceOverrides(productions []Production, precedence map[string]precedenceLevel) error {
+	for i, prod := range productions {
+		if prod.Precedence == "" {
+			continue
+		}
+		if _, ok := precedence[prod.Precedence]; !ok {
+			return fmt.Errorf("production %d (%s): %%prec symbol %q has no declared precedence",
+				i, prod.LHS, prod.Precedence)
+		}
+	}
+	return nil
+}
+
+// productionPrecedence returns the precedence of a production: that of its %prec symbol if any,
+// else that of its rightmost terminal having a declared precedence.
+func (grammar *grammar) productionPrecedence(prodIndex int) (precedenceLevel, bool) {
+	prod := grammar.productions[prodIndex]
+	if prod.Precedence != "" {
+		level, ok := grammar.precedence[prod.Precedence]
+		return level, ok
+	}
+	for i := len(prod.RHS) - 1; i >= 0; i-- {
+		sym := prod.RHS[i]
+		if !sym.Terminal {
+			continue
+		}
+		if level, ok := grammar.precedence[sym.Name]; ok {
+			return level, true
+		}
+	}
+	return precedenceLevel{}, false
+}
+
+// resolveByPrecedence resolves a shift/reduce conflict on the given lookahead terminal using
+// declared precedence and associativity. It returns false when the conflict is not a
+// shift/reduce conflict or when either side lacks a declared precedence.
+func (grammar *grammar) resolveByPrecedence(terminal string, existing Action, next Action) (Action, bool) {
+	var shift, reduce Action
+	switch {
+	case existing.Type == "shift" && next.Type == "reduce":
+		shift, reduce = existing, next
+	case existing.Type == "reduce" && next.Type == "shift":
+		shift, reduce = next, existing
+	default:
+		return Action{}, false
+	}
+	terminalLevel, ok := grammar.precedence[terminal]
+	if !ok {
+		return Action{}, false
+	}
+	productionLevel, ok := grammar.productionPrecedence(reduce.Target)
+	if !ok {
+		return Action{}, false
+	}
+	switch {
+	case productionLevel.level > terminalLevel.level:
+		return reduce, true
+	case productionLevel.level < terminalLevel.level:
+		return shift, true
+	}
+	switch terminalLevel.assoc {
+	case parsers.EBNFDirectiveLeft:
+		return reduce, true
+	case parsers.EBNFDirectiveRight:
+		return shift, true
+	default:
+		return Action{Type: actionTypeNonassocError}, true
+	}
+}
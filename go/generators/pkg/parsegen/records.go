@@ -0,0 +1,73 @@
+package parsegen
+
+import (
+	"fmt"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/bnf"
+	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+)
+
+// recordsDecl is the grammar's %records declaration: the input is a sequence of records, each
+// derived from the start symbol, optionally separated by a separator terminal. Generated
+// parsers' ParseOne returns one record per call.
+type recordsDecl struct {
+	separator string
+}
+
+// extractRecords returns the %records declaration, or nil if the grammar has none.
+func extractRecords(ast *asts.AST) (*recordsDecl, error) {
+	var decl *recordsDecl
+	for _, node := range ast.RootNode.Children {
+		if node.Type != parsers.EBNFParserNodeTypeDirective || parsers.DirectiveName(node) != parsers.EBNFDirectiveRecords {
+			continue
+		}
+		if decl != nil {
+			return nil, fmt.Errorf("%%records: declared more than once")
+		}
+		decl = &recordsDecl{}
+		switch len(node.Children) {
+		case 0:
+		case 1:
+			name, err := bnf.PrecedenceSymbolName(node.Children[0])
+			if err != nil {
+				return nil, fmt.Errorf("%%records: %w", err)
+			}
+			decl.separator = name
+		default:
+			return nil, fmt.Errorf("%%records: expected at most one separator")
+		}
+	}
+	return decl, nil
+}
+
+// validateRecords checks the %records declaration against the grammar, and adds the separator
+// to the grammar's terminals if no production uses it.
+func validateRecords(grammar *grammar, decl *recordsDecl, lexerRuleSet map[string]bool) error {
+	if decl.separator == "" {
+		first := computeFirstSets(grammar)
+		for _, startSymbol := range grammar.startSymbols {
+			if first.nullable[startSymbol] {
+				return fmt.Errorf("%%records: start symbol %q derives the empty string, so records need a separator", startSymbol)
+			}
+		}
+		return nil
+	}
+	if !lexerRuleSet[decl.separator] && !grammar.terminals[decl.separator] {
+		return fmt.Errorf("%%records: separator %q is not a terminal", decl.separator)
+	}
+	grammar.terminals[decl.separator] = true
+	return nil
+}
+
+// recordLookaheads returns the terminals which may follow a complete record of startSymbol, other
+// than EOF: the separator if there is one, else any terminal which can start the next record.
+func recordLookaheads(grammar *grammar, first *firstSets, startSymbol string) []string {
+	if grammar.records == nil {
+		return nil
+	}
+	if grammar.records.separator != "" {
+		return []string{grammar.records.separator}
+	}
+	return sortedKeys(first.terminals[startSymbol])
+}
@@ -8,18 +8,33 @@ import (
 	"sort"
 	"strconv"
 	"strings"
-	"unicode"
 
-	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/bnf"
 	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
 )
 
 const eofSymbol = "EOF"
 
+// errorSymbol is the reserved terminal for yacc-style error recovery: a production such as
+// Statement ::= error semicolon matches the input skipped over to recover from a syntax error.
+const errorSymbol = bnf.ErrorSymbol
+
 // ParseTableOptions configures parser table generation from a grammar.
 type ParseTableOptions struct {
 	// SourceName is used in error messages (e.g. file path). Empty means "".
 	SourceName string
+	// LALR selects LALR(1) table construction: canonical LR(1) states with identical cores are
+	// merged, giving far fewer states. Merging can introduce reduce/reduce conflicts for grammars
+	// which are LR(1) but not LALR(1); these are reported as conflicts.
+	LALR bool
+	// ResolveConflicts accepts all conflicts, resolving each by default: shift is preferred over
+	// reduce, and among reduces the earliest production wins. The conflicts are still reported
+	// in Tables.Conflicts. When false, conflicts fail table generation unless the grammar's
+	// %expect count matches the number of shift/reduce conflicts and there are no others.
+	ResolveConflicts bool
+	// GLR accepts all conflicts, as ResolveConflicts does, and also keeps every action of each
+	// conflicting entry in Tables.ConflictActions, for generated parsers' GLR driver (ParseAll).
+	GLR bool
 }
 
 // EncodeOptions configures JSON encoding of tables.
@@ -31,12 +46,39 @@ type EncodeOptions struct {
 
 // Tables captures LR(1) parsing tables and productions.
 type Tables struct {
-	StartSymbol string                    `json:"start_symbol"`
+	StartSymbol string `json:"start_symbol"`
+	// EntryPoints lists, for grammars whose %start directive names more than one symbol, each
+	// start symbol with the state its parses begin in. StartSymbol is the first of them.
+	EntryPoints []EntryPoint              `json:"entry_points,omitempty"`
 	Actions     map[int]map[string]Action `json:"actions"`
 	Gotos       map[int]map[string]int    `json:"gotos"`
 	Productions []Production              `json:"productions"`
 	Metadata    map[string]string         `json:"metadata,omitempty"`
 	HintMode    string                    `json:"hint_mode,omitempty"`
+	// RecordSeparator is the terminal separating records, from the grammar's %records
+	// declaration. Generated parsers' ParseOne consumes it between records.
+	RecordSeparator string `json:"record_separator,omitempty"`
+	// DisplayNames maps terminals to their names in syntax errors, from the grammar's %display
+	// declarations.
+	DisplayNames map[string]string `json:"display_names,omitempty"`
+	// ErrorMessages maps states to the messages for syntax errors found in them, from a messages
+	// file applied by ApplyErrorMessages.
+	ErrorMessages map[int]string `json:"error_messages,omitempty"`
+	// ActionHeader is Go code for the productions' semantic actions, such as imports, from the
+	// grammar's << >> blocks between rules.
+	ActionHeader string `json:"action_header,omitempty"`
+	// ConflictActions holds, for GLR tables, every action of each conflicting entry. Actions
+	// holds the default resolution of these entries, for deterministic parsing.
+	ConflictActions map[int]map[string][]Action `json:"conflict_actions,omitempty"`
+	// Conflicts lists the conflicts resolved by default, via %expect or
+	// ParseTableOptions.ResolveConflicts. It is not part of the JSON encoding.
+	Conflicts []*Conflict `json:"-"`
+}
+
+// EntryPoint is a start symbol and the parser state in which parses of it begin.
+type EntryPoint struct {
+	Symbol string `json:"symbol"`
+	State  int    `json:"state"`
 }
 
 type Action struct {
@@ -44,28 +86,12 @@ type Action struct {
 	Target int    `json:"target,omitempty"`
 }
 
-type Production struct {
-	LHS  string   `json:"lhs"`
-	RHS  []Symbol `json:"rhs"`
-	Hint *ASTHint `json:"hint,omitempty"`
-}
+type Production = bnf.Production
 
 // ASTHint captures AST-construction directives for a production.
-type ASTHint struct {
-	ParentIndex              int     `json:"parent"`
-	ChildIndices             []int   `json:"children"`
-	WithAppendedChildren     []int   `json:"with_appended_children,omitempty"`
-	WithPrependedChildren    []int   `json:"with_prepended_children,omitempty"`
-	WithAdoptedGrandchildren []int   `json:"with_adopted_grandchildren,omitempty"`
-	ParentLiteral            *string `json:"parent_literal,omitempty"`
-	PassthroughIndex         *int    `json:"pass-through,omitempty"`
-	NodeType                 string  `json:"type,omitempty"`
-}
+type ASTHint = bnf.Hint
 
-type Symbol struct {
-	Name     string `json:"name"`
-	Terminal bool   `json:"terminal"`
-}
+type Symbol = bnf.Symbol
 
 // MarshalJSON ensures deterministic map ordering for stable output.
 func (tables *Tables) MarshalJSON() ([]byte, error) {
@@ -84,6 +110,14 @@ func marshalTablesDeterministic(tables *Tables) ([]byte, error) {
 	}
 	fields = append(fields, jsonField{name: "start_symbol", value: startSymbolBytes})
 
+	if len(tables.EntryPoints) > 0 {
+		entryPointsBytes, err := json.Marshal(tables.EntryPoints)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{name: "entry_points", value: entryPointsBytes})
+	}
+
 	actionsBytes, err := marshalMapIntActionMap(tables.Actions)
 	if err != nil {
 		return nil, err
@@ -118,6 +152,46 @@ func marshalTablesDeterministic(tables *Tables) ([]byte, error) {
 		fields = append(fields, jsonField{name: "hint_mode", value: hintModeBytes})
 	}
 
+	if tables.RecordSeparator != "" {
+		recordSeparatorBytes, err := json.Marshal(tables.RecordSeparator)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{name: "record_separator", value: recordSeparatorBytes})
+	}
+
+	if len(tables.DisplayNames) > 0 {
+		displayNamesBytes, err := marshalMapStringString(tables.DisplayNames)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{name: "display_names", value: displayNamesBytes})
+	}
+
+	if len(tables.ErrorMessages) > 0 {
+		errorMessagesBytes, err := marshalMapIntString(tables.ErrorMessages)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{name: "error_messages", value: errorMessagesBytes})
+	}
+
+	if tables.ActionHeader != "" {
+		actionHeaderBytes, err := json.Marshal(tables.ActionHeader)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{name: "action_header", value: actionHeaderBytes})
+	}
+
+	if len(tables.ConflictActions) > 0 {
+		conflictActionsBytes, err := marshalMapIntActionListMap(tables.ConflictActions)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{name: "conflict_actions", value: conflictActionsBytes})
+	}
+
 	return marshalOrderedFields(fields), nil
 }
 
@@ -132,12 +206,19 @@ func GenerateTablesFromReader(r io.Reader, opts *ParseTableOptions) (*Tables, er
 	return GenerateTables(string(b), opts)
 }
 
-// GenerateTables parses an EBNF grammar and produces LR(1) parser tables.
+// GenerateTables parses an EBNF grammar and produces LR(1) parser tables,
+// or LALR(1) tables when opts.LALR is set.
 // opts may be nil; SourceName is then "".
 func GenerateTables(grammarText string, opts *ParseTableOptions) (*Tables, error) {
 	sourceName := ""
+	buildOpts := lrBuildOptions{}
+	resolveConflicts := false
+	glr := false
 	if opts != nil {
 		sourceName = opts.SourceName
+		buildOpts.lalr = opts.LALR
+		resolveConflicts = opts.ResolveConflicts || opts.GLR
+		glr = opts.GLR
 	}
 	parser := parsers.NewEBNFParserWithSourceName(sourceName)
 	ast, err := parser.Parse(strings.NewReader(grammarText))
@@ -145,57 +226,95 @@ func GenerateTables(grammarText string, opts *ParseTableOptions) (*Tables, error
 		return nil, err
 	}
 
-	ruleDefs, err := extractRuleDefs(ast)
+	precedence, err := extractPrecedence(ast)
+	if err != nil {
+		return nil, err
+	}
+	expectedConflicts, err := extractExpectedConflicts(ast)
+	if err != nil {
+		return nil, err
+	}
+	records, err := extractRecords(ast)
+	if err != nil {
+		return nil, err
+	}
+	displayNames, err := extractDisplayNames(ast)
 	if err != nil {
 		return nil, err
 	}
 
-	lexerRuleNames := selectLexerRuleNames(ruleDefs)
-	lexerRuleSet := map[string]bool{}
-	for _, name := range lexerRuleNames {
-		lexerRuleSet[name] = true
+	expanded, err := bnf.FromAST(ast)
+	if err != nil {
+		return nil, err
 	}
+	if err := validatePrecedenceOverrides(expanded.Productions, precedence); err != nil {
+		return nil, err
+	}
+	if err := validateActions(expanded.Productions); err != nil {
+		return nil, err
+	}
+	addRepeatActions(expanded.Productions)
 
-	parserRuleNames := selectParserRuleNames(ruleDefs)
-	if len(parserRuleNames) == 0 {
-		return nil, fmt.Errorf("no parser rules found")
+	hintMode := ""
+	if expanded.HintMode() {
+		hintMode = "hints"
 	}
 
-	startSymbol := selectStartSymbol(parserRuleNames)
-	builder := newGrammarBuilder(parserRuleNames, lexerRuleSet)
-	for _, rule := range ruleDefs {
-		if !builder.parserRuleSet[rule.name] {
-			continue
-		}
-		if err := builder.addRule(rule.name, rule.expr); err != nil {
+	startSymbols := expanded.StartSymbols
+	grammar := newGrammar(expanded)
+	grammar.precedence = precedence
+	recordSeparator := ""
+	if records != nil {
+		if err := validateRecords(grammar, records, expanded.LexerRuleSet); err != nil {
 			return nil, err
 		}
+		grammar.records = records
+		recordSeparator = records.separator
 	}
-
-	if err := validateHints(builder.productions); err != nil {
+	if err := validateDisplayNames(grammar, displayNames); err != nil {
+		return nil, err
+	}
+	if len(displayNames) == 0 {
+		displayNames = nil
+	}
+	actions, gotos, conflicts, err := buildLR1Tables(grammar, buildOpts)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkConflicts(conflicts, expectedConflicts, resolveConflicts); err != nil {
 		return nil, err
 	}
 
-	hintMode := ""
-	for _, prod := range builder.productions {
-		if prod.Hint != nil {
-			hintMode = "hints"
-			break
+	var conflictActions map[int]map[string][]Action
+	if glr && len(conflicts) > 0 {
+		conflictActions = map[int]map[string][]Action{}
+		for _, conflict := range conflicts {
+			if conflictActions[conflict.State] == nil {
+				conflictActions[conflict.State] = map[string][]Action{}
+			}
+			conflictActions[conflict.State][conflict.Lookahead] = conflict.Actions
 		}
 	}
 
-	grammar := newGrammar(builder, startSymbol)
-	actions, gotos, err := buildLR1Tables(grammar)
-	if err != nil {
-		return nil, err
+	var entryPoints []EntryPoint
+	if len(startSymbols) > 1 {
+		for i, symbol := range startSymbols {
+			entryPoints = append(entryPoints, EntryPoint{Symbol: symbol, State: i})
+		}
 	}
 
 	return &Tables{
-		StartSymbol: startSymbol,
-		Actions:     actions,
-		Gotos:       gotos,
-		Productions: grammar.productions,
-		HintMode:    hintMode,
+		StartSymbol:     startSymbols[0],
+		EntryPoints:     entryPoints,
+		Actions:         actions,
+		Gotos:           gotos,
+		Productions:     grammar.productions,
+		HintMode:        hintMode,
+		RecordSeparator: recordSeparator,
+		DisplayNames:    displayNames,
+		ActionHeader:    extractActionHeader(ast),
+		ConflictActions: conflictActions,
+		Conflicts:       conflicts,
 	}, nil
 }
 
@@ -269,6 +388,45 @@ func marshalMapIntActionMap(m map[int]map[string]Action) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+func marshalMapIntActionListMap(m map[int]map[string][]Action) ([]byte, error) {
+	keys := make([]int, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(strconv.Quote(strconv.Itoa(key)))
+		buf.WriteByte(':')
+		terminals := make([]string, 0, len(m[key]))
+		for terminal := range m[key] {
+			terminals = append(terminals, terminal)
+		}
+		sort.Strings(terminals)
+		buf.WriteByte('{')
+		for j, terminal := range terminals {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(strconv.Quote(terminal))
+			buf.WriteByte(':')
+			valueBytes, err := json.Marshal(m[key][terminal])
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(valueBytes)
+		}
+		buf.WriteByte('}')
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
 func marshalMapStringAction(m map[string]Action) ([]byte, error) {
 	if m == nil {
 		return []byte("null"), nil
@@ -353,15 +511,12 @@ func marshalMapStringInt(m map[string]int) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func marshalMapStringString(m map[string]string) ([]byte, error) {
-	if m == nil {
-		return []byte("null"), nil
-	}
-	keys := make([]string, 0, len(m))
+func marshalMapIntString(m map[int]string) ([]byte, error) {
+	keys := make([]int, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
 	}
-	sort.Strings(keys)
+	sort.Ints(keys)
 
 	var buf bytes.Buffer
 	buf.WriteByte('{')
@@ -369,7 +524,7 @@ func marshalMapStringString(m map[string]string) ([]byte, error) {
 		if i > 0 {
 			buf.WriteByte(',')
 		}
-		buf.WriteString(strconv.Quote(key))
+		buf.WriteString(strconv.Quote(strconv.Itoa(key)))
 		buf.WriteByte(':')
 		valueBytes, err := json.Marshal(m[key])
 		if err != nil {
@@ -381,509 +536,61 @@ func marshalMapStringString(m map[string]string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-type ruleDef struct {
-	name string
-	expr *asts.ASTNode
-}
-
-func extractRuleDefs(ast *asts.AST) ([]ruleDef, error) {
-	if ast == nil || ast.RootNode == nil {
-		return nil, fmt.Errorf("nil AST")
-	}
-	if ast.RootNode.Type != parsers.EBNFParserNodeTypeGrammar {
-		return nil, fmt.Errorf("expected grammar root, got %q", ast.RootNode.Type)
-	}
-	var rules []ruleDef
-	for _, ruleNode := range ast.RootNode.Children {
-		if ruleNode.Type != parsers.EBNFParserNodeTypeRule {
-			return nil, fmt.Errorf("expected rule node, got %q", ruleNode.Type)
-		}
-		if err := ruleNode.CheckArity(2); err != nil {
-			return nil, err
-		}
-		nameNode := ruleNode.Children[0]
-		exprNode := ruleNode.Children[1]
-		if nameNode.Type != parsers.EBNFParserNodeTypeIdentifier || nameNode.Token == nil {
-			return nil, fmt.Errorf("rule name must be identifier")
-		}
-		ruleName := string(nameNode.Token.Lexeme)
-		rules = append(rules, ruleDef{name: ruleName, expr: exprNode})
-	}
-	return rules, nil
-}
-
-func selectLexerRuleNames(ruleDefs []ruleDef) []string {
-	var names []string
-	for _, rule := range ruleDefs {
-		if isLexerRuleName(rule.name) {
-			names = append(names, rule.name)
-		}
-	}
-	return names
-}
-
-func selectParserRuleNames(ruleDefs []ruleDef) []string {
-	var names []string
-	for _, rule := range ruleDefs {
-		if !isLexerRuleName(rule.name) {
-			names = append(names, rule.name)
-		}
-	}
-	return names
-}
-
-func selectStartSymbol(parserRuleNames []string) string {
-	for _, name := range parserRuleNames {
-		if name == "Root" {
-			return name
-		}
-	}
-	return parserRuleNames[0]
-}
-
-func isLexerRuleName(name string) bool {
-	if name == "" {
-		return false
-	}
-	first := []rune(name)[0]
-	if first == '!' {
-		return true
-	}
-	return first == '_' || unicode.IsLower(first)
-}
-
-type grammarBuilder struct {
-	parserRuleSet map[string]bool
-	lexerRuleSet  map[string]bool
-	usedNames     map[string]bool
-	productions   []Production
-	synthCounter  int
-}
-
-func newGrammarBuilder(parserRuleNames []string, lexerRuleSet map[string]bool) *grammarBuilder {
-	parserRuleSet := map[string]bool{}
-	usedNames := map[string]bool{}
-	for _, name := range parserRuleNames {
-		parserRuleSet[name] = true
-		usedNames[name] = true
-	}
-	for name := range lexerRuleSet {
-		usedNames[name] = true
-	}
-	return &grammarBuilder{
-		parserRuleSet: parserRuleSet,
-		lexerRuleSet:  lexerRuleSet,
-		usedNames:     usedNames,
-	}
-}
-
-type expandedAlternative struct {
-	symbols []Symbol
-	hint    *ASTHint
-}
-
-func (builder *grammarBuilder) addRule(ruleName string, expr *asts.ASTNode) error {
-	alts, err := builder.expandExpr(expr)
-	if err != nil {
-		return fmt.Errorf("rule %q: %w", ruleName, err)
+func marshalMapStringString(m map[string]string) ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
 	}
-	for _, alt := range alts {
-		builder.productions = append(builder.productions, Production{
-			LHS:  ruleName,
-			RHS:  alt.symbols,
-			Hint: alt.hint,
-		})
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
 	}
-	return nil
-}
-
-func (builder *grammarBuilder) expandExpr(node *asts.ASTNode) ([]expandedAlternative, error) {
-	switch node.Type {
-	case parsers.EBNFParserNodeTypeLiteral:
-		if node.Token == nil {
-			return nil, fmt.Errorf("literal node missing token")
-		}
-		text := string(node.Token.Lexeme)
-		unquoted, err := strconv.Unquote(text)
-		if err != nil {
-			return nil, fmt.Errorf("invalid literal %q: %w", text, err)
-		}
-		return []expandedAlternative{{symbols: []Symbol{{Name: unquoted, Terminal: true}}}}, nil
-	case parsers.EBNFParserNodeTypeRange:
-		return nil, fmt.Errorf("range expressions are only allowed in lexer rules")
-	case parsers.EBNFParserNodeTypeWildcard:
-		return nil, fmt.Errorf("wildcard '.' is only allowed in lexer rules")
-	case parsers.EBNFParserNodeTypeIdentifier:
-		if node.Token == nil {
-			return nil, fmt.Errorf("identifier node missing token")
-		}
-		identifier := string(node.Token.Lexeme)
-		if builder.lexerRuleSet[identifier] {
-			return []expandedAlternative{{symbols: []Symbol{{Name: identifier, Terminal: true}}}}, nil
-		}
-		if !builder.parserRuleSet[identifier] {
-			return nil, fmt.Errorf("undefined rule %q", identifier)
-		}
-		return []expandedAlternative{{symbols: []Symbol{{Name: identifier, Terminal: false}}}}, nil
-	case parsers.EBNFParserNodeTypeEmpty:
-		return []expandedAlternative{{symbols: []Symbol{}}}, nil
-	case parsers.EBNFParserNodeTypeSequence:
-		if len(node.Children) == 0 {
-			return []expandedAlternative{{symbols: []Symbol{}}}, nil
-		}
-		alts := []expandedAlternative{{symbols: []Symbol{}}}
-		for _, child := range node.Children {
-			childAlts, err := builder.expandExpr(child)
-			if err != nil {
-				return nil, err
-			}
-			var next []expandedAlternative
-			for _, alt := range alts {
-				for _, childAlt := range childAlts {
-					combined := make([]Symbol, 0, len(alt.symbols)+len(childAlt.symbols))
-					combined = append(combined, alt.symbols...)
-					combined = append(combined, childAlt.symbols...)
-					next = append(next, expandedAlternative{symbols: combined})
-				}
-			}
-			alts = next
-		}
-		return alts, nil
-	case parsers.EBNFParserNodeTypeAlternates:
-		var out []expandedAlternative
-		for _, child := range node.Children {
-			childAlts, err := builder.expandExpr(child)
-			if err != nil {
-				return nil, err
-			}
-			out = append(out, childAlts...)
-		}
-		return out, nil
-	case parsers.EBNFParserNodeTypeOptional:
-		if err := node.CheckArity(1); err != nil {
-			return nil, err
-		}
-		childAlts, err := builder.expandExpr(node.Children[0])
-		if err != nil {
-			return nil, err
-		}
-		return append(childAlts, expandedAlternative{symbols: []Symbol{}}), nil
-	case parsers.EBNFParserNodeTypeRepeat:
-		if err := node.CheckArity(1); err != nil {
-			return nil, err
-		}
-		childAlts, err := builder.expandExpr(node.Children[0])
-		if err != nil {
-			return nil, err
-		}
-		repeatName := builder.newSyntheticName("repeat")
-		builder.parserRuleSet[repeatName] = true
-		builder.usedNames[repeatName] = true
-		builder.productions = append(builder.productions, Production{
-			LHS: repeatName,
-			RHS: []Symbol{},
-		})
-		for _, childAlt := range childAlts {
-			if len(childAlt.symbols) == 0 {
-				continue
-			}
-			combined := make([]Symbol, 0, len(childAlt.symbols)+1)
-			combined = append(combined, childAlt.symbols...)
-			combined = append(combined, Symbol{Name: repeatName, Terminal: false})
-			builder.productions = append(builder.productions, Production{
-				LHS: repeatName,
-				RHS: combined,
-			})
-		}
-		return []expandedAlternative{{symbols: []Symbol{{Name: repeatName, Terminal: false}}}}, nil
-	case parsers.EBNFParserNodeTypeHintedSequence:
-		if err := node.CheckArity(2); err != nil {
-			return nil, err
-		}
-		seqNode := node.Children[0]
-		hintNode := node.Children[1]
+	sort.Strings(keys)
 
-		alts, err := builder.expandExpr(seqNode)
-		if err != nil {
-			return nil, err
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
 		}
-
-		hint, err := parseHintNode(hintNode)
+		buf.WriteString(strconv.Quote(key))
+		buf.WriteByte(':')
+		valueBytes, err := json.Marshal(m[key])
 		if err != nil {
 			return nil, err
 		}
-
-		for i := range alts {
-			alts[i].hint = hint
-		}
-		return alts, nil
-	default:
-		return nil, fmt.Errorf("unsupported node type %q", node.Type)
-	}
-}
-
-func parseHintNode(node *asts.ASTNode) (*ASTHint, error) {
-	if node.Type != parsers.EBNFParserNodeTypeHint {
-		return nil, fmt.Errorf("expected hint node, got %q", node.Type)
-	}
-	hint := &ASTHint{}
-	hasParent := false
-	hasChildren := false
-	hasWithAppendedChildren := false
-	hasWithPrependedChildren := false
-	hasWithAdoptedGrandchildren := false
-	hasParentLiteral := false
-	hasPassthrough := false
-	for _, field := range node.Children {
-		if field.Type != parsers.EBNFParserNodeTypeHintField || field.Token == nil {
-			return nil, fmt.Errorf("invalid hint field node")
-		}
-		key := string(field.Token.Lexeme)
-		// Strip quotes from key
-		unquoted, err := strconv.Unquote(key)
-		if err != nil {
-			return nil, fmt.Errorf("invalid hint key %q: %w", key, err)
-		}
-		if len(field.Children) != 1 {
-			return nil, fmt.Errorf("hint field %q must have exactly one value", unquoted)
-		}
-		valueNode := field.Children[0]
-		switch unquoted {
-		case "parent":
-			if valueNode.Type != parsers.EBNFParserNodeTypeHintInt || valueNode.Token == nil {
-				return nil, fmt.Errorf("hint \"parent\" must be an integer")
-			}
-			val, err := strconv.Atoi(string(valueNode.Token.Lexeme))
-			if err != nil {
-				return nil, fmt.Errorf("invalid hint parent value: %w", err)
-			}
-			hint.ParentIndex = val
-			hasParent = true
-		case "parent_literal":
-			if valueNode.Type != parsers.EBNFParserNodeTypeHintString || valueNode.Token == nil {
-				return nil, fmt.Errorf("hint \"parent_literal\" must be a string")
-			}
-			unquotedLiteral, err := strconv.Unquote(string(valueNode.Token.Lexeme))
-			if err != nil {
-				return nil, fmt.Errorf("invalid hint parent_literal value: %w", err)
-			}
-			hint.ParentLiteral = &unquotedLiteral
-			hasParentLiteral = true
-		case "children":
-			if valueNode.Type != parsers.EBNFParserNodeTypeHintArray {
-				return nil, fmt.Errorf("hint \"children\" must be an array")
-			}
-			indices := make([]int, 0, len(valueNode.Children))
-			for _, elem := range valueNode.Children {
-				if elem.Type != parsers.EBNFParserNodeTypeHintInt || elem.Token == nil {
-					return nil, fmt.Errorf("hint children elements must be integers")
-				}
-				val, err := strconv.Atoi(string(elem.Token.Lexeme))
-				if err != nil {
-					return nil, fmt.Errorf("invalid hint child index: %w", err)
-				}
-				indices = append(indices, val)
-			}
-			hint.ChildIndices = indices
-			hasChildren = true
-		case "with_appended_children":
-			if valueNode.Type != parsers.EBNFParserNodeTypeHintArray {
-				return nil, fmt.Errorf("hint \"with_appended_children\" must be an array")
-			}
-			indices := make([]int, 0, len(valueNode.Children))
-			for _, elem := range valueNode.Children {
-				if elem.Type != parsers.EBNFParserNodeTypeHintInt || elem.Token == nil {
-					return nil, fmt.Errorf("hint with_appended_children elements must be integers")
-				}
-				val, err := strconv.Atoi(string(elem.Token.Lexeme))
-				if err != nil {
-					return nil, fmt.Errorf("invalid hint with_appended_children index: %w", err)
-				}
-				indices = append(indices, val)
-			}
-			hint.WithAppendedChildren = indices
-			hasWithAppendedChildren = true
-		case "with_prepended_children":
-			if valueNode.Type != parsers.EBNFParserNodeTypeHintArray {
-				return nil, fmt.Errorf("hint \"with_prepended_children\" must be an array")
-			}
-			indices := make([]int, 0, len(valueNode.Children))
-			for _, elem := range valueNode.Children {
-				if elem.Type != parsers.EBNFParserNodeTypeHintInt || elem.Token == nil {
-					return nil, fmt.Errorf("hint with_prepended_children elements must be integers")
-				}
-				val, err := strconv.Atoi(string(elem.Token.Lexeme))
-				if err != nil {
-					return nil, fmt.Errorf("invalid hint with_prepended_children index: %w", err)
-				}
-				indices = append(indices, val)
-			}
-			hint.WithPrependedChildren = indices
-			hasWithPrependedChildren = true
-		case "with_adopted_grandchildren":
-			if valueNode.Type != parsers.EBNFParserNodeTypeHintArray {
-				return nil, fmt.Errorf("hint \"with_adopted_grandchildren\" must be an array")
-			}
-			indices := make([]int, 0, len(valueNode.Children))
-			for _, elem := range valueNode.Children {
-				if elem.Type != parsers.EBNFParserNodeTypeHintInt || elem.Token == nil {
-					return nil, fmt.Errorf("hint with_adopted_grandchildren elements must be integers")
-				}
-				val, err := strconv.Atoi(string(elem.Token.Lexeme))
-				if err != nil {
-					return nil, fmt.Errorf("invalid hint with_adopted_grandchildren index: %w", err)
-				}
-				indices = append(indices, val)
-			}
-			hint.WithAdoptedGrandchildren = indices
-			hasWithAdoptedGrandchildren = true
-		case "pass-through", "passthrough":
-			if valueNode.Type != parsers.EBNFParserNodeTypeHintInt || valueNode.Token == nil {
-				return nil, fmt.Errorf("hint \"pass-through\" must be an integer")
-			}
-			val, err := strconv.Atoi(string(valueNode.Token.Lexeme))
-			if err != nil {
-				return nil, fmt.Errorf("invalid hint pass-through value: %w", err)
-			}
-			hint.PassthroughIndex = &val
-			hasPassthrough = true
-		case "type":
-			if valueNode.Type != parsers.EBNFParserNodeTypeHintString || valueNode.Token == nil {
-				return nil, fmt.Errorf("hint \"type\" must be a string")
-			}
-			unquotedType, err := strconv.Unquote(string(valueNode.Token.Lexeme))
-			if err != nil {
-				return nil, fmt.Errorf("invalid hint type value: %w", err)
-			}
-			hint.NodeType = unquotedType
-		default:
-			return nil, fmt.Errorf("unknown hint field %q", unquoted)
-		}
-	}
-	if hasPassthrough {
-		if hasParent || hasParentLiteral || hasChildren || hasWithAppendedChildren || hasWithPrependedChildren || hasWithAdoptedGrandchildren || hint.NodeType != "" {
-			return nil, fmt.Errorf("hint \"passthrough\" cannot be combined with \"parent\", \"parent_literal\", \"children\", \"with_appended_children\", \"with_prepended_children\", \"with_adopted_grandchildren\", or \"type\"")
-		}
-		return hint, nil
-	}
-	if hasParent && hasParentLiteral {
-		return nil, fmt.Errorf("hint cannot set both \"parent\" and \"parent_literal\"")
-	}
-	if !hasParent && !hasParentLiteral {
-		return nil, fmt.Errorf("hint missing required \"parent\" or \"parent_literal\" field")
-	}
-	childVariantCount := 0
-	if hasChildren {
-		childVariantCount++
-	}
-	if hasWithAppendedChildren {
-		childVariantCount++
-	}
-	if hasWithPrependedChildren {
-		childVariantCount++
-	}
-	if hasWithAdoptedGrandchildren {
-		childVariantCount++
-	}
-	if childVariantCount > 1 {
-		return nil, fmt.Errorf("hint cannot set more than one of \"children\", \"with_appended_children\", \"with_prepended_children\", \"with_adopted_grandchildren\"")
-	}
-	if childVariantCount == 0 {
-		return nil, fmt.Errorf("hint missing required \"children\", \"with_appended_children\", \"with_prepended_children\", or \"with_adopted_grandchildren\" field")
+		buf.Write(valueBytes)
 	}
-	return hint, nil
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
 }
 
-func validateHints(productions []Production) error {
-	hasAnyHint := false
-	for _, prod := range productions {
-		if prod.Hint != nil {
-			hasAnyHint = true
-			break
-		}
-	}
-	if !hasAnyHint {
-		return nil
-	}
-	for _, prod := range productions {
-		if strings.HasPrefix(prod.LHS, "__pgpg_") {
-			continue
-		}
-		if prod.Hint == nil {
-			if len(prod.RHS) <= 1 {
-				continue
-			}
-			rhsNames := make([]string, len(prod.RHS))
-			for i, sym := range prod.RHS {
-				rhsNames[i] = sym.Name
-			}
-			return fmt.Errorf(
-				"production %s ::= %s has %d RHS symbols but no AST hint; "+
-					"in hint mode, multi-element productions require hints",
-				prod.LHS, strings.Join(rhsNames, " "), len(prod.RHS))
-		}
-		if prod.Hint.PassthroughIndex != nil {
-			if *prod.Hint.PassthroughIndex < 0 || *prod.Hint.PassthroughIndex >= len(prod.RHS) {
-				return fmt.Errorf("production %s: passthrough index %d out of range [0, %d)",
-					prod.LHS, *prod.Hint.PassthroughIndex, len(prod.RHS))
-			}
-			continue
-		}
-		if prod.Hint.ParentLiteral == nil {
-			if prod.Hint.ParentIndex < 0 || prod.Hint.ParentIndex >= len(prod.RHS) {
-				return fmt.Errorf("production %s: parent index %d out of range [0, %d)",
-					prod.LHS, prod.Hint.ParentIndex, len(prod.RHS))
-			}
-		}
-		for _, ci := range prod.Hint.ChildIndices {
-			if ci < 0 || ci >= len(prod.RHS) {
-				return fmt.Errorf("production %s: child index %d out of range [0, %d)",
-					prod.LHS, ci, len(prod.RHS))
-			}
-		}
-		for _, ci := range prod.Hint.WithAppendedChildren {
-			if ci < 0 || ci >= len(prod.RHS) {
-				return fmt.Errorf("production %s: with_appended_children index %d out of range [0, %d)",
-					prod.LHS, ci, len(prod.RHS))
-			}
-		}
-		for _, ci := range prod.Hint.WithPrependedChildren {
-			if ci < 0 || ci >= len(prod.RHS) {
-				return fmt.Errorf("production %s: with_prepended_children index %d out of range [0, %d)",
-					prod.LHS, ci, len(prod.RHS))
-			}
-		}
-		for _, ci := range prod.Hint.WithAdoptedGrandchildren {
-			if ci < 0 || ci >= len(prod.RHS) {
-				return fmt.Errorf("production %s: with_adopted_grandchildren index %d out of range [0, %d)",
-					prod.LHS, ci, len(prod.RHS))
-			}
-		}
-	}
-	return nil
+type grammar struct {
+	// startSymbols are the user's start symbols. Start symbol i has augmented production i
+	// and start state i.
+	startSymbols []string
+	productions  []Production
+	byLHS        map[string][]int
+	terminals    map[string]bool
+	nonterms     map[string]bool
+	// precedence holds declared terminal precedences, used to resolve shift/reduce conflicts.
+	precedence map[string]precedenceLevel
+	// records is the %records declaration, or nil if the input is a single record.
+	records *recordsDecl
 }
 
-func (builder *grammarBuilder) newSyntheticName(kind string) string {
-	for {
-		builder.synthCounter++
-		name := fmt.Sprintf("__pgpg_%s_%d", kind, builder.synthCounter)
-		if !builder.usedNames[name] {
-			builder.usedNames[name] = true
-			return name
-		}
+// newGrammar returns the augmented grammar: production i is __pgpg_start_N ::= StartSymbols[i],
+// for each start symbol, followed by the expanded grammar's productions.
+func newGrammar(expanded *bnf.Grammar) *grammar {
+	startSymbols := expanded.StartSymbols
+	var productions []Production
+	for _, startSymbol := range startSymbols {
+		productions = append(productions, Production{
+			LHS: expanded.NewSyntheticName("start"),
+			RHS: []Symbol{{Name: startSymbol, Terminal: false}},
+		})
 	}
-}
+	productions = append(productions, expanded.Productions...)
 
-type grammar struct {
-	startSymbol string
-	productions []Production
-	byLHS       map[string][]int
-	terminals   map[string]bool
-	nonterms    map[string]bool
-}
-
-func newGrammar(builder *grammarBuilder, startSymbol string) *grammar {
-	productions := append([]Production{}, builder.productions...)
 	byLHS := map[string][]int{}
 	nonterms := map[string]bool{}
 	terminals := map[string]bool{}
@@ -898,267 +605,253 @@ func newGrammar(builder *grammarBuilder, startSymbol string) *grammar {
 			}
 		}
 	}
-
-	augmented := builder.newSyntheticName("start")
-	productions = append([]Production{{
-		LHS: augmented,
-		RHS: []Symbol{{Name: startSymbol, Terminal: false}},
-	}}, productions...)
-
-	byLHS = map[string][]int{}
-	nonterms = map[string]bool{}
-	terminals = map[string]bool{}
-	for i, prod := range productions {
-		byLHS[prod.LHS] = append(byLHS[prod.LHS], i)
-		nonterms[prod.LHS] = true
-		for _, sym := range prod.RHS {
-			if sym.Terminal {
-				terminals[sym.Name] = true
-			} else {
-				nonterms[sym.Name] = true
-			}
-		}
-	}
 	terminals[eofSymbol] = true
 
 	return &grammar{
-		startSymbol: augmented,
-		productions: productions,
-		byLHS:       byLHS,
-		terminals:   terminals,
-		nonterms:    nonterms,
+		startSymbols: startSymbols,
+		productions:  productions,
+		byLHS:        byLHS,
+		terminals:    terminals,
+		nonterms:     nonterms,
 	}
 }
 
+// isStartProduction reports whether prod is an augmented start production, whose reduction
+// accepts the input.
+func (grammar *grammar) isStartProduction(prod int) bool {
+	return prod < len(grammar.startSymbols)
+}
+
+// lrBuildOptions configures LR automaton and table construction.
+type lrBuildOptions struct {
+	// lalr merges LR(1) states with identical cores, producing LALR(1) tables.
+	lalr bool
+}
+
 type item struct {
 	prod      int
 	dot       int
 	lookahead string
 }
 
-func buildLR1Tables(grammar *grammar) (map[int]map[string]Action, map[int]map[string]int, error) {
-	first := computeFirstSets(grammar)
+// lrTransition is an edge in the LR automaton: on symbol, go to state target.
+type lrTransition struct {
+	symbol Symbol
+	target int
+}
+
+// lrAutomaton holds the LR item sets and their transitions, before actions are assigned.
+type lrAutomaton struct {
+	states      []map[item]struct{}
+	transitions [][]lrTransition
+	labels      map[int]string
+	// mergeConflicts records (state, lookahead) pairs where LALR(1) core merging produced a
+	// reduce/reduce conflict that neither of the merged item sets had on its own.
+	mergeConflicts map[stateLookahead]bool
+}
+
+type stateLookahead struct {
+	state     int
+	lookahead string
+}
+
+// buildLRAutomaton computes the canonical LR(1) item sets for the grammar. When lalr is true,
+// item sets with identical cores (same productions and dot positions, ignoring lookaheads) are
+// merged as they are discovered, yielding the LALR(1) automaton. A merged state whose lookaheads
+// grow is re-processed so the new lookaheads propagate to its successors.
+func buildLRAutomaton(grammar *grammar, first *firstSets, lalr bool) *lrAutomaton {
+	automaton := &lrAutomaton{
+		labels:         map[int]string{},
+		mergeConflicts: map[stateLookahead]bool{},
+	}
 	stateMap := map[uint64][]int{} // hash → state IDs (for collision resolution)
-	var states []map[item]struct{}
-	stateLabels := map[int]string{}
 	var queue []int
+	inQueue := map[int]bool{}
 
-	userStart := grammar.productions[0].RHS[0].Name
-	startItem := item{prod: 0, dot: 0, lookahead: eofSymbol}
-	startSet := closure(grammar, first, map[item]struct{}{startItem: {}})
-	startHash := itemSetHash(startSet)
-	stateMap[startHash] = []int{0}
-	states = append(states, startSet)
-	stateLabels[0] = stateLabel(startSet, grammar)
-	queue = append(queue, 0)
+	// In LALR mode states are identified by the core of their kernel (the goto seed), which
+	// determines the core of the closure; this avoids recomputing closures for known states.
+	var kernelCores []map[itemCore]struct{}
 
-	actions := map[int]map[string]Action{}
-	gotos := map[int]map[string]int{}
+	// There is one start state per start symbol. With %records, a record may also be followed
+	// by the start of the next one.
+	for startID, startSymbol := range grammar.startSymbols {
+		startKernel := map[item]struct{}{{prod: startID, dot: 0, lookahead: eofSymbol}: {}}
+		for _, lookahead := range recordLookaheads(grammar, first, startSymbol) {
+			startKernel[item{prod: startID, dot: 0, lookahead: lookahead}] = struct{}{}
+		}
+		startSet := closure(grammar, first, startKernel)
+		if lalr {
+			startCore := itemSetCore(startKernel)
+			kernelCores = append(kernelCores, startCore)
+			stateMap[itemCoreHash(startCore)] = append(stateMap[itemCoreHash(startCore)], startID)
+		} else {
+			stateMap[itemSetHash(startSet)] = append(stateMap[itemSetHash(startSet)], startID)
+		}
+		automaton.states = append(automaton.states, startSet)
+		automaton.transitions = append(automaton.transitions, nil)
+		queue = append(queue, startID)
+		inQueue[startID] = true
+	}
 
 	for len(queue) > 0 {
 		stateID := queue[0]
 		queue = queue[1:]
-		itemSet := states[stateID]
+		inQueue[stateID] = false
+		itemSet := automaton.states[stateID]
 
-		transitions := map[Symbol]map[item]struct{}{}
-		orderedItems := sortedItems(itemSet)
-		for _, it := range orderedItems {
+		seeds := map[Symbol]map[item]struct{}{}
+		for _, it := range sortedItems(itemSet) {
 			prod := grammar.productions[it.prod]
 			if it.dot >= len(prod.RHS) {
 				continue
 			}
 			nextSym := prod.RHS[it.dot]
 			nextItem := item{prod: it.prod, dot: it.dot + 1, lookahead: it.lookahead}
-			set := transitions[nextSym]
+			set := seeds[nextSym]
 			if set == nil {
 				set = map[item]struct{}{}
-				transitions[nextSym] = set
+				seeds[nextSym] = set
 			}
 			set[nextItem] = struct{}{}
 		}
 
-		for _, sym := range sortedSymbols(transitions) {
-			seedSet := transitions[sym]
-			// When shifting lcurly from state 0, include (0,0,rcurly) so the target state gets (Value -> . Object, rcurly) and thus goto(7, Object).
-			if stateID == 0 && sym.Terminal && sym.Name == "lcurly" && grammar.terminals["rcurly"] {
-				augmented := make(map[item]struct{}, len(seedSet)+1)
-				for k, v := range seedSet {
-					augmented[k] = v
-				}
-				augmented[item{prod: 0, dot: 0, lookahead: "rcurly"}] = struct{}{}
-				seedSet = augmented
-			}
-			gotoSet := closure(grammar, first, seedSet)
-			hash := itemSetHash(gotoSet)
-			target := -1
-			for _, id := range stateMap[hash] {
-				if itemSetsEqual(states[id], gotoSet) {
-					target = id
-					break
-				}
-			}
-			if target < 0 {
-				target = len(states)
-				stateMap[hash] = append(stateMap[hash], target)
-				states = append(states, gotoSet)
-				stateLabels[target] = stateLabel(gotoSet, grammar)
-				queue = append(queue, target)
-			}
-			if sym.Terminal {
-				if err := setAction(actions, stateID, sym.Name, Action{Type: "shift", Target: target}, itemSet, grammar, stateLabels); err != nil {
-					return nil, nil, err
+		var transitions []lrTransition
+		for _, sym := range sortedSymbols(seeds) {
+			seedSet := seeds[sym]
+			var target int
+			if lalr {
+				var changed bool
+				target, changed = automaton.gotoLALR(grammar, first, seedSet, stateMap, &kernelCores)
+				if changed && !inQueue[target] {
+					queue = append(queue, target)
+					inQueue[target] = true
 				}
 			} else {
-				if gotos[stateID] == nil {
-					gotos[stateID] = map[string]int{}
+				target = -1
+				gotoSet := closure(grammar, first, seedSet)
+				hash := itemSetHash(gotoSet)
+				for _, id := range stateMap[hash] {
+					if itemSetsEqual(automaton.states[id], gotoSet) {
+						target = id
+						break
+					}
 				}
-				if existing, ok := gotos[stateID][sym.Name]; ok && existing != target {
-					return nil, nil, fmt.Errorf("goto conflict in state %d on %q", stateID, sym.Name)
+				if target < 0 {
+					target = len(automaton.states)
+					stateMap[hash] = append(stateMap[hash], target)
+					automaton.states = append(automaton.states, gotoSet)
+					automaton.transitions = append(automaton.transitions, nil)
+					queue = append(queue, target)
+					inQueue[target] = true
 				}
-				gotos[stateID][sym.Name] = target
-			}
-		}
-
-		for _, it := range orderedItems {
-			prod := grammar.productions[it.prod]
-			if it.dot < len(prod.RHS) {
-				continue
-			}
-			if it.prod == 0 && it.lookahead == eofSymbol {
-				if err := setAction(actions, stateID, eofSymbol, Action{Type: "accept"}, itemSet, grammar, stateLabels); err != nil {
-					return nil, nil, err
-				}
-				continue
-			}
-			// Do not add reduce for the production that produces the start symbol (e.g. Json -> Value)
-			// on non-EOF lookahead; accept_and_yield will be used instead for multi-object input.
-			if it.prod != 0 && prod.LHS == userStart && it.lookahead != eofSymbol {
-				continue
-			}
-			if err := setAction(actions, stateID, it.lookahead, Action{Type: "reduce", Target: it.prod}, itemSet, grammar, stateLabels); err != nil {
-				return nil, nil, err
 			}
+			transitions = append(transitions, lrTransition{symbol: sym, target: target})
 		}
+		automaton.transitions[stateID] = transitions
 	}
 
-	// Add accept_and_yield in every state that has the completed start production (prod 0)
-	// or the completed start rule (prod 1: startSymbol -> ...), for every non-EOF terminal
-	// without an action (enables ParseOne / multi-object).
-	startRHSLen := len(grammar.productions[0].RHS)
-	startRuleRHSLen := 0
-	if len(grammar.productions) > 1 {
-		startRuleRHSLen = len(grammar.productions[1].RHS)
+	for stateID, itemSet := range automaton.states {
+		automaton.labels[stateID] = stateLabel(itemSet, grammar)
 	}
-	for stateID, itemSet := range states {
-		hasCompletedStart := false
-		for it := range itemSet {
-			if it.prod == 0 && it.dot == startRHSLen {
-				hasCompletedStart = true
-				break
-			}
-			if it.prod == 1 && startRuleRHSLen > 0 && it.dot == startRuleRHSLen {
-				hasCompletedStart = true
+	return automaton
+}
+
+// gotoLALR finds or creates the LALR(1) state for a goto seed (kernel). A seed whose kernel
+// core matches an existing state is merged into it. It returns the target state and whether
+// that state is new or gained lookaheads, in which case it must be (re-)processed.
+func (automaton *lrAutomaton) gotoLALR(
+	grammar *grammar,
+	first *firstSets,
+	seedSet map[item]struct{},
+	stateMap map[uint64][]int,
+	kernelCores *[]map[itemCore]struct{},
+) (int, bool) {
+	core := itemSetCore(seedSet)
+	hash := itemCoreHash(core)
+	for _, id := range stateMap[hash] {
+		if !itemCoresEqual((*kernelCores)[id], core) {
+			continue
+		}
+		existing := automaton.states[id]
+		grows := false
+		for it := range seedSet {
+			if _, ok := existing[it]; !ok {
+				grows = true
 				break
 			}
 		}
-		if !hasCompletedStart {
-			continue
+		if !grows {
+			return id, false
 		}
-		stateActions := actions[stateID]
-		if stateActions == nil {
-			stateActions = map[string]Action{}
-			actions[stateID] = stateActions
-		}
-		for term := range grammar.terminals {
-			if term == eofSymbol {
-				continue
-			}
-			if _, ok := stateActions[term]; !ok {
-				stateActions[term] = Action{Type: "accept_and_yield"}
-			}
+		gotoSet := closure(grammar, first, seedSet)
+		for _, lookahead := range mergeIntroducedReduceConflicts(grammar, existing, gotoSet) {
+			automaton.mergeConflicts[stateLookahead{state: id, lookahead: lookahead}] = true
 		}
+		return id, mergeItemSet(existing, gotoSet)
 	}
+	target := len(automaton.states)
+	stateMap[hash] = append(stateMap[hash], target)
+	*kernelCores = append(*kernelCores, core)
+	automaton.states = append(automaton.states, closure(grammar, first, seedSet))
+	automaton.transitions = append(automaton.transitions, nil)
+	return target, true
+}
 
-	// Add reduce for productions that produce the start symbol's RHS (e.g. Value -> Object, Object -> lcurly rcurly)
-	// in states that have that completed item, for terminals that can start another top-level object.
-	if len(grammar.productions) >= 2 && len(grammar.productions[1].RHS) > 0 {
-		firstSymbol := grammar.productions[1].RHS[0].Name // RHS of start rule (e.g. "Value")
-		valueStartSymbols := map[string]bool{}
-		for _, p := range grammar.productions {
-			if p.LHS == firstSymbol && len(p.RHS) > 0 {
-				valueStartSymbols[p.RHS[0].Name] = true
-			}
-		}
-		for stateID, itemSet := range states {
-			for it := range itemSet {
-				prod := grammar.productions[it.prod]
-				if it.dot != len(prod.RHS) {
-					continue
-				}
-				if prod.LHS != firstSymbol && !valueStartSymbols[prod.LHS] {
-					continue
-				}
-				// Use First(start RHS), e.g. First(Value), so every such state gets reduce for all
-				// value-starting terminals (enables mixed-type multi-object e.g. [] {} and {} []).
-				valueFirst := first.terminals[firstSymbol]
-				if valueFirst == nil {
-					valueFirst = first.terminals[userStart]
-				}
-				if valueFirst == nil {
-					continue
+func buildLR1Tables(grammar *grammar, opts lrBuildOptions) (map[int]map[string]Action, map[int]map[string]int, []*Conflict, error) {
+	first := computeFirstSets(grammar)
+	automaton := buildLRAutomaton(grammar, first, opts.lalr)
+	states := automaton.states
+
+	table := newActionTable(grammar, automaton)
+	gotos := map[int]map[string]int{}
+
+	for stateID, itemSet := range states {
+		for _, tr := range automaton.transitions[stateID] {
+			if tr.symbol.Terminal {
+				table.set(stateID, tr.symbol.Name, Action{Type: "shift", Target: tr.target})
+			} else {
+				if gotos[stateID] == nil {
+					gotos[stateID] = map[string]int{}
 				}
-				stateActions := actions[stateID]
-				if stateActions == nil {
-					stateActions = map[string]Action{}
-					actions[stateID] = stateActions
+				if existing, ok := gotos[stateID][tr.symbol.Name]; ok && existing != tr.target {
+					return nil, nil, nil, fmt.Errorf("goto conflict in state %d on %q", stateID, tr.symbol.Name)
 				}
-				for term := range valueFirst {
-					if term == eofSymbol {
-						continue
-					}
-					if _, ok := stateActions[term]; !ok {
-						stateActions[term] = Action{Type: "reduce", Target: it.prod}
-					}
+				gotos[stateID][tr.symbol.Name] = tr.target
+			}
+		}
+
+		for _, it := range sortedItems(itemSet) {
+			prod := grammar.productions[it.prod]
+			if it.dot < len(prod.RHS) {
+				continue
+			}
+			if grammar.isStartProduction(it.prod) {
+				// Lookaheads other than EOF come from %records: the record is complete, and
+				// another follows.
+				if it.lookahead == eofSymbol {
+					table.set(stateID, eofSymbol, Action{Type: "accept"})
+				} else {
+					table.set(stateID, it.lookahead, Action{Type: "accept_and_yield"})
 				}
+				continue
 			}
+			table.set(stateID, it.lookahead, Action{Type: "reduce", Target: it.prod})
 		}
 	}
 
-	return actions, gotos, nil
-}
+	conflicts := table.sortedConflicts(gotos)
+	actions := table.actions
 
-func setAction(actions map[int]map[string]Action, state int, terminal string, action Action, itemSet map[item]struct{}, grammar *grammar, stateLabels map[int]string) error {
-	if actions[state] == nil {
-		actions[state] = map[string]Action{}
-	}
-	if existing, ok := actions[state][terminal]; ok {
-		if existing.Type != action.Type || existing.Target != action.Target {
-			return conflictError(state, terminal, existing, action, itemSet, grammar, stateLabels)
+	// Remove shift/reduce conflicts which %nonassoc resolved as syntax errors.
+	for _, stateActions := range actions {
+		for term, action := range stateActions {
+			if action.Type == actionTypeNonassocError {
+				delete(stateActions, term)
+			}
 		}
-		return nil
 	}
-	actions[state][terminal] = action
-	return nil
-}
 
-func conflictError(state int, terminal string, existing Action, next Action, itemSet map[item]struct{}, grammar *grammar, stateLabels map[int]string) error {
-	var b strings.Builder
-	fmt.Fprintf(&b, "Action conflict\n  State: %s\n  Lookahead: %q\n\n", formatStateLabel(state, stateLabels), terminal)
-	b.WriteString(formatAction("Existing", existing, grammar, stateLabels))
-	b.WriteString(formatAction("New", next, grammar, stateLabels))
-	if grammar != nil && itemSet != nil {
-		b.WriteString("\n  Items in state:\n")
-		for _, it := range sortedItems(itemSet) {
-			b.WriteString("    ")
-			b.WriteString(formatItem(it, grammar))
-			b.WriteByte('\n')
-		}
-	}
-	if hint := buildConflictHint(existing, next, grammar); hint != "" {
-		b.WriteString("\n  Hint:\n")
-		b.WriteString(hint)
-	}
-	return fmt.Errorf("%s", b.String())
+	return actions, gotos, conflicts, nil
 }
 
 func formatAction(label string, action Action, grammar *grammar, stateLabels map[int]string) string {
@@ -1172,6 +865,8 @@ func formatAction(label string, action Action, grammar *grammar, stateLabels map
 		return fmt.Sprintf("  %s action: accept\n", label)
 	case "accept_and_yield":
 		return fmt.Sprintf("  %s action: accept_and_yield\n", label)
+	case actionTypeNonassocError:
+		return fmt.Sprintf("  %s action: syntax error (%%nonassoc)\n", label)
 	default:
 		return fmt.Sprintf("  %s action: %s\n", label, action.Type)
 	}
@@ -1246,7 +941,7 @@ func buildConflictHint(existing Action, next Action, grammar *grammar) string {
 		hints = append(hints, reduceHint(next.Target, grammar)...)
 	}
 	if existing.Type == "shift" || next.Type == "shift" {
-		hints = append(hints, "- Shift/reduce conflicts often come from ambiguous operator precedence or unintended recursion")
+		hints = append(hints, "- Shift/reduce conflicts often come from ambiguous operator precedence or unintended recursion; operator precedence can be declared with %left, %right, and %nonassoc")
 	}
 	if len(hints) == 0 {
 		return ""
@@ -1260,9 +955,10 @@ func reduceHint(prodIndex int, grammar *grammar) []string {
 		return nil
 	}
 	var hints []string
-	userStart := userStartSymbol(grammar)
-	if userStart != "" && containsSymbol(prod.RHS, userStart) {
-		hints = append(hints, fmt.Sprintf("- Production reduces to %s via %s; check for cycles involving the start symbol", prod.LHS, userStart))
+	for _, startSymbol := range grammar.startSymbols {
+		if containsSymbol(prod.RHS, startSymbol) {
+			hints = append(hints, fmt.Sprintf("- Production reduces to %s via %s; check for cycles involving the start symbol", prod.LHS, startSymbol))
+		}
 	}
 	if containsSymbol(prod.RHS, prod.LHS) {
 		hints = append(hints, fmt.Sprintf("- Production %s ::= ... %s ... is directly recursive; verify it appears only where intended", prod.LHS, prod.LHS))
@@ -1277,13 +973,6 @@ func productionAt(index int, grammar *grammar) (Production, bool) {
 	return grammar.productions[index], true
 }
 
-func userStartSymbol(grammar *grammar) string {
-	if grammar == nil || len(grammar.productions) == 0 || len(grammar.productions[0].RHS) == 0 {
-		return ""
-	}
-	return grammar.productions[0].RHS[0].Name
-}
-
 func containsSymbol(symbols []Symbol, name string) bool {
 	for _, sym := range symbols {
 		if sym.Name == name {
@@ -1374,6 +1063,90 @@ func itemSetHash(items map[item]struct{}) uint64 {
 	return h
 }
 
+type itemCore struct {
+	prod int
+	dot  int
+}
+
+// itemSetCore returns the core of an item set: its distinct (production, dot) pairs,
+// ignoring lookaheads.
+func itemSetCore(items map[item]struct{}) map[itemCore]struct{} {
+	cores := make(map[itemCore]struct{}, len(items))
+	for it := range items {
+		cores[itemCore{prod: it.prod, dot: it.dot}] = struct{}{}
+	}
+	return cores
+}
+
+// itemCoreHash computes an order-independent hash of an item-set core.
+func itemCoreHash(cores map[itemCore]struct{}) uint64 {
+	var h uint64
+	for core := range cores {
+		h += uint64(core.prod)*2654435761 ^ uint64(core.dot)*40503
+	}
+	return h
+}
+
+// itemCoresEqual checks whether two item-set cores are the same.
+func itemCoresEqual(a, b map[itemCore]struct{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for core := range a {
+		if _, ok := b[core]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeItemSet adds the items of src to dst, returning true if dst grew.
+func mergeItemSet(dst, src map[item]struct{}) bool {
+	changed := false
+	for it := range src {
+		if _, ok := dst[it]; !ok {
+			dst[it] = struct{}{}
+			changed = true
+		}
+	}
+	return changed
+}
+
+// mergeIntroducedReduceConflicts returns the lookaheads on which merging item sets a and b
+// (which have the same core) yields a reduce/reduce conflict that neither set has on its own.
+func mergeIntroducedReduceConflicts(grammar *grammar, a, b map[item]struct{}) []string {
+	reducesA := completedProductionsByLookahead(grammar, a)
+	reducesB := completedProductionsByLookahead(grammar, b)
+	var out []string
+	for lookahead, prodsA := range reducesA {
+		prodsB, ok := reducesB[lookahead]
+		if !ok || len(prodsA) > 1 || len(prodsB) > 1 {
+			continue
+		}
+		for prodA := range prodsA {
+			if !prodsB[prodA] {
+				out = append(out, lookahead)
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func completedProductionsByLookahead(grammar *grammar, items map[item]struct{}) map[string]map[int]bool {
+	out := map[string]map[int]bool{}
+	for it := range items {
+		if it.dot < len(grammar.productions[it.prod].RHS) {
+			continue
+		}
+		if out[it.lookahead] == nil {
+			out[it.lookahead] = map[int]bool{}
+		}
+		out[it.lookahead][it.prod] = true
+	}
+	return out
+}
+
 // itemSetsEqual checks whether two item sets contain the same items.
 func itemSetsEqual(a, b map[item]struct{}) bool {
 	if len(a) != len(b) {
@@ -2,6 +2,10 @@ package parsegen
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -23,86 +27,725 @@ func TestGenerateTablesFromReader(t *testing.T) {
 	}
 }
 
-// Minimal JSON-like grammar: Json -> Value, Value -> Object, Object -> lcurly rcurly.
-// Multi-object fix should add reduce(Value->Object) for lcurly in states that have [Value -> Object .].
-const jsonLikeBNF = `
+// JSON-like grammar whose input is a sequence of records, of either type.
+const jsonLikeRecordsBNF = `
+%records ;
 !ws ::= " " ;
-lcurly ::= "{" ;
-rcurly ::= "}" ;
-Json  ::= Value ;
-Value ::= Object ;
+lcurly  ::= "{" ;
+rcurly  ::= "}" ;
+lbracket ::= "[" ;
+rbracket ::= "]" ;
+Json   ::= Value ;
+Value  ::= Object | Array ;
 Object ::= lcurly rcurly ;
+Array  ::= lbracket rbracket ;
 `
 
-func TestGenerateTablesMultiObjectAddsLcurlyReduce(t *testing.T) {
-	r := bytes.NewBufferString(jsonLikeBNF)
-	tables, err := GenerateTablesFromReader(r, &ParseTableOptions{SourceName: "test.bnf"})
+func TestGenerateTablesRecords(t *testing.T) {
+	for _, lalr := range []bool{false, true} {
+		tables, err := GenerateTables(jsonLikeRecordsBNF, &ParseTableOptions{LALR: lalr})
+		if err != nil {
+			t.Fatalf("GenerateTables (LALR %v): %v", lalr, err)
+		}
+		for input, expected := range map[string]string{
+			"lcurly rcurly":                                 "(lcurly rcurly)",
+			"lbracket rbracket lcurly rcurly":               "(lbracket rbracket) | (lcurly rcurly)",
+			"lcurly rcurly lcurly rcurly lbracket rbracket": "(lcurly rcurly) | (lcurly rcurly) | (lbracket rbracket)",
+		} {
+			got, err := parenthesize(tables, strings.Fields(input))
+			if err != nil {
+				t.Errorf("%q (LALR %v): %v", input, lalr, err)
+			} else if got != expected {
+				t.Errorf("%q (LALR %v): got %q, expected %q", input, lalr, got, expected)
+			}
+		}
+		if err := recognize(tables, strings.Fields("lcurly lcurly rcurly")); err == nil {
+			t.Errorf("LALR %v: expected a record to be a complete value", lalr)
+		}
+	}
+}
+
+func TestGenerateTablesWithoutRecords(t *testing.T) {
+	tables, err := GenerateTables(strings.Replace(jsonLikeRecordsBNF, "%records ;", "", 1), nil)
 	if err != nil {
-		t.Fatalf("GenerateTablesFromReader: %v", err)
+		t.Fatalf("GenerateTables: %v", err)
 	}
-	var found bool
-	for _, stateActions := range tables.Actions {
-		if act, ok := stateActions["lcurly"]; ok && act.Type == "reduce" {
+	for state, stateActions := range tables.Actions {
+		for term, action := range stateActions {
+			if action.Type == "accept_and_yield" {
+				t.Errorf("state %d on %q: unexpected accept_and_yield without %%records", state, term)
+			}
+		}
+	}
+	if err := recognize(tables, strings.Fields("lcurly rcurly lcurly rcurly")); err == nil {
+		t.Error("expected multiple records to be rejected without %records")
+	}
+}
+
+// A left-recursive start symbol, whose records only end at a separator.
+const sumRecordsBNF = `
+%records semi ;
+int ::= "0" ;
+plus ::= "+" ;
+semi ::= ";" ;
+Root ::= Root plus int | int ;
+`
+
+func TestGenerateTablesRecordSeparator(t *testing.T) {
+	tables, err := GenerateTables(sumRecordsBNF, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables: %v", err)
+	}
+	if tables.RecordSeparator != "semi" {
+		t.Errorf("RecordSeparator: got %q", tables.RecordSeparator)
+	}
+	for input, expected := range map[string]string{
+		"int plus int":               "(int plus int)",
+		"int plus int semi int":      "(int plus int) | int",
+		"int semi int plus int semi": "int | (int plus int)",
+	} {
+		got, err := parenthesize(tables, strings.Fields(input))
+		if err != nil {
+			t.Errorf("%q: %v", input, err)
+		} else if got != expected {
+			t.Errorf("%q: got %q, expected %q", input, got, expected)
+		}
+	}
+	if err := recognize(tables, strings.Fields("int int")); err == nil {
+		t.Error("expected records without a separator to be rejected")
+	}
+
+	// Without the separator, the next record starts at any int.
+	tables, err = GenerateTables(strings.Replace(sumRecordsBNF, "%records semi ;", "%records ;", 1), nil)
+	if err != nil {
+		t.Fatalf("GenerateTables: %v", err)
+	}
+	got, err := parenthesize(tables, strings.Fields("int plus int int"))
+	if err != nil || got != "(int plus int) | int" {
+		t.Errorf("got %q, %v", got, err)
+	}
+}
+
+func TestGenerateTablesRecordsConflict(t *testing.T) {
+	// After a record, minus could continue it or start the next one.
+	_, err := GenerateTables(`
+%records ;
+int ::= "0" ;
+minus ::= "-" ;
+Root ::= Root minus int | minus int | int ;
+`, nil)
+	var conflictsErr *ConflictsError
+	if !errors.As(err, &conflictsErr) {
+		t.Fatalf("expected ConflictsError, got %v", err)
+	}
+	if len(conflictsErr.Conflicts) != 1 || conflictsErr.Conflicts[0].Kind() != "shift/reduce" ||
+		conflictsErr.Conflicts[0].Resolution.Type != "shift" {
+		t.Errorf("unexpected conflicts: %v", err)
+	}
+}
+
+func TestGenerateTablesRecordsErrors(t *testing.T) {
+	for grammarText, expected := range map[string]string{
+		`%records ; %records ; a ::= "a" ; Root ::= a ;`:      "%records: declared more than once",
+		`%records a b ; a ::= "a" ; b ::= "b" ; Root ::= a ;`: "%records: expected at most one separator",
+		`%records Root ; a ::= "a" ; Root ::= a ;`:            `%records: separator "Root" is not a terminal`,
+		`%records ; a ::= "a" ; Root ::= a | empty ;`:         `%records: start symbol "Root" derives the empty string, so records need a separator`,
+	} {
+		_, err := GenerateTables(grammarText, nil)
+		if err == nil || err.Error() != expected {
+			t.Errorf("%s: got %v, expected %q", grammarText, err, expected)
+		}
+	}
+}
+
+func TestGenerateTablesDisplayNames(t *testing.T) {
+	tables, err := GenerateTables(`
+%display plus "'+'" int "integer" ;
+%display ";" "semicolon" ;
+int ::= "0" ; plus ::= "+" ;
+Root ::= int plus int ";" ;
+`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
+	}
+	expected := map[string]string{"plus": "'+'", "int": "integer", ";": "semicolon"}
+	if !reflect.DeepEqual(tables.DisplayNames, expected) {
+		t.Errorf("DisplayNames: got %v, expected %v", tables.DisplayNames, expected)
+	}
+
+	tables, err = GenerateTables(`int ::= "0" ; Root ::= int ;`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables() error: %v", err)
+	}
+	if tables.DisplayNames != nil {
+		t.Errorf("DisplayNames: got %v, expected nil", tables.DisplayNames)
+	}
+}
+
+func TestGenerateTablesDisplayNamesErrors(t *testing.T) {
+	for grammarText, expected := range map[string]string{
+		`%display a ; a ::= "a" ; Root ::= a ;`:                 "%display: expected pairs of terminal and display name",
+		`%display a b ; a ::= "a" ; b ::= "b" ; Root ::= a b ;`: `%display: display name of "a" must be a quoted literal`,
+		`%display a "A" a "B" ; a ::= "a" ; Root ::= a ;`:       `%display: "a" declared more than once`,
+		`%display Root "root" ; a ::= "a" ; Root ::= a ;`:       `%display: "Root" is not a terminal of the grammar`,
+		`%display b "B" ; a ::= "a" ; b ::= "b" ; Root ::= a ;`: `%display: "b" is not a terminal of the grammar`,
+	} {
+		_, err := GenerateTables(grammarText, nil)
+		if err == nil || err.Error() != expected {
+			t.Errorf("%s: got %v, expected %q", grammarText, err, expected)
+		}
+	}
+}
+
+// recognize runs tables over a sequence of terminal names, returning nil if the input is accepted.
+func recognize(tables *Tables, input []string) error {
+	_, err := parenthesize(tables, input)
+	return err
+}
+
+// parenthesize runs tables over a sequence of terminal names and returns the parse as a
+// parenthesized string: each reduction of more than one symbol is wrapped in parentheses.
+// Records, for %records grammars, are separated by " | ".
+func parenthesize(tables *Tables, input []string) (string, error) {
+	return parenthesizeFrom(tables, 0, input)
+}
+
+// parenthesizeFrom is parenthesize starting from the given start state.
+func parenthesizeFrom(tables *Tables, startState int, input []string) (string, error) {
+	stateStack := []int{startState}
+	valueStack := []string{}
+	var records []string
+	input = append(append([]string{}, input...), eofSymbol)
+	pos := 0
+	for {
+		state := stateStack[len(stateStack)-1]
+		action, ok := tables.Actions[state][input[pos]]
+		if !ok {
+			return "", fmt.Errorf("unexpected %s at position %d in state %d", input[pos], pos, state)
+		}
+		switch action.Type {
+		case "shift":
+			stateStack = append(stateStack, action.Target)
+			valueStack = append(valueStack, input[pos])
+			pos++
+		case "reduce":
+			prod := tables.Productions[action.Target]
+			n := len(prod.RHS)
+			value := strings.Join(valueStack[len(valueStack)-n:], " ")
+			if n > 1 {
+				value = "(" + value + ")"
+			}
+			stateStack = stateStack[:len(stateStack)-n]
+			valueStack = append(valueStack[:len(valueStack)-n], value)
+			nextState, ok := tables.Gotos[stateStack[len(stateStack)-1]][prod.LHS]
+			if !ok {
+				return "", fmt.Errorf("missing goto for %s", prod.LHS)
+			}
+			stateStack = append(stateStack, nextState)
+		case "accept":
+			return strings.Join(append(records, valueStack...), " | "), nil
+		case "accept_and_yield":
+			records = append(records, valueStack...)
+			stateStack = []int{startState}
+			valueStack = []string{}
+			if tables.RecordSeparator != "" && input[pos] == tables.RecordSeparator {
+				pos++
+				if input[pos] == eofSymbol {
+					return strings.Join(records, " | "), nil
+				}
+			}
+		default:
+			return "", fmt.Errorf("unexpected action %s", action.Type)
+		}
+	}
+}
+
+const exprBNF = `
+!ws ::= " " ;
+int ::= "0" | "1" ;
+plus ::= "+" ;
+times ::= "*" ;
+lparen ::= "(" ;
+rparen ::= ")" ;
+Root ::= Sum ;
+Sum ::= Sum plus Product | Product ;
+Product ::= Product times Factor | Factor ;
+Factor ::= int | lparen Sum rparen ;
+`
+
+func TestGenerateTablesLALRMergesStates(t *testing.T) {
+	lr1, err := GenerateTables(exprBNF, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables (LR(1)): %v", err)
+	}
+	lalr, err := GenerateTables(exprBNF, &ParseTableOptions{LALR: true})
+	if err != nil {
+		t.Fatalf("GenerateTables (LALR(1)): %v", err)
+	}
+	if len(lalr.Actions) >= len(lr1.Actions) {
+		t.Errorf("expected LALR(1) to have fewer states than LR(1): got %d vs %d", len(lalr.Actions), len(lr1.Actions))
+	}
+	inputs := []string{
+		"int",
+		"int plus int times int",
+		"lparen int plus int rparen times lparen lparen int rparen rparen",
+	}
+	for _, input := range inputs {
+		if err := recognize(lalr, strings.Fields(input)); err != nil {
+			t.Errorf("LALR(1) tables rejected %q: %v", input, err)
+		}
+	}
+	if err := recognize(lalr, strings.Fields("int plus rparen")); err == nil {
+		t.Errorf("LALR(1) tables accepted invalid input")
+	}
+}
+
+// A grammar which is LR(1) but not LALR(1): the states after "a c" and "b c" have the
+// same core but opposite lookaheads for A ::= c and B ::= c.
+const lr1NotLALRBNF = `
+a ::= "a" ; b ::= "b" ; c ::= "c" ; d ::= "d" ; e ::= "e" ;
+S ::= a A d | b B d | a B e | b A e ;
+A ::= c ;
+B ::= c ;
+`
+
+func TestGenerateTablesLALRReportsMergeConflict(t *testing.T) {
+	if _, err := GenerateTables(lr1NotLALRBNF, nil); err != nil {
+		t.Fatalf("GenerateTables (LR(1)): %v", err)
+	}
+	_, err := GenerateTables(lr1NotLALRBNF, &ParseTableOptions{LALR: true})
+	if err == nil {
+		t.Fatal("expected LALR(1) reduce/reduce conflict")
+	}
+	if !strings.Contains(err.Error(), "introduced by LALR(1) merging") {
+		t.Errorf("conflict error should mention LALR(1) merging; got:\n%v", err)
+	}
+}
+
+// A flat, ambiguous expression grammar disambiguated by precedence declarations.
+const flatExprBNF = `
+!ws ::= " " ;
+int ::= "0" | "1" ;
+plus ::= "+" ;
+minus ::= "-" ;
+times ::= "*" ;
+power ::= "**" ;
+eq ::= "==" ;
+
+%nonassoc eq ;
+%left plus minus ;
+%left times ;
+%right UNARY ;
+%right power ;
+
+Root ::= E ;
+E ::= E eq E
+    | E plus E
+    | E minus E
+    | E times E
+    | E power E
+    | minus E %prec UNARY
+    | int ;
+`
+
+func TestGenerateTablesPrecedence(t *testing.T) {
+	for _, lalr := range []bool{false, true} {
+		tables, err := GenerateTables(flatExprBNF, &ParseTableOptions{LALR: lalr})
+		if err != nil {
+			t.Fatalf("GenerateTables (LALR=%v): %v", lalr, err)
+		}
+		cases := []struct {
+			input    string
+			expected string
+		}{
+			{"int plus int times int", "(int plus (int times int))"},
+			{"int times int plus int", "((int times int) plus int)"},
+			{"int minus int minus int", "((int minus int) minus int)"},
+			{"int power int power int", "(int power (int power int))"},
+			{"minus int power int", "(minus (int power int))"},
+			{"minus int times int", "((minus int) times int)"},
+			{"int plus int eq int times int", "((int plus int) eq (int times int))"},
+		}
+		for _, tc := range cases {
+			actual, err := parenthesize(tables, strings.Fields(tc.input))
+			if err != nil {
+				t.Errorf("LALR=%v: %q: %v", lalr, tc.input, err)
+				continue
+			}
+			if actual != tc.expected {
+				t.Errorf("LALR=%v: %q: got %s, expected %s", lalr, tc.input, actual, tc.expected)
+			}
+		}
+		if err := recognize(tables, strings.Fields("int eq int eq int")); err == nil {
+			t.Errorf("LALR=%v: expected %%nonassoc to reject chained eq", lalr)
+		}
+	}
+}
+
+func TestGenerateTablesWithoutPrecedenceReportsConflict(t *testing.T) {
+	grammar := `int ::= "0" ; plus ::= "+" ; Root ::= E ; E ::= E plus E | int ;`
+	if _, err := GenerateTables(grammar, nil); err == nil {
+		t.Fatal("expected shift/reduce conflict without precedence declarations")
+	}
+	if _, err := GenerateTables("%left plus ;\n"+grammar, nil); err != nil {
+		t.Fatalf("GenerateTables with %%left: %v", err)
+	}
+}
+
+func TestGenerateTablesPrecedenceErrors(t *testing.T) {
+	grammar := `int ::= "0" ; minus ::= "-" ; E ::= minus E %prec UNARY | int ;`
+	if _, err := GenerateTables(grammar, nil); err == nil || !strings.Contains(err.Error(), "no declared precedence") {
+		t.Errorf("expected undeclared %%prec symbol error, got %v", err)
+	}
+	if _, err := GenerateTables("%left minus ;\n%right minus ;\n"+grammar, nil); err == nil || !strings.Contains(err.Error(), "already has a declared precedence") {
+		t.Errorf("expected duplicate precedence error, got %v", err)
+	}
+}
+
+const danglingElseBNF = `
+!ws ::= " " ;
+if ::= "if" ; then ::= "then" ; else ::= "else" ; cond ::= "c" ; stmt ::= "s" ;
+Root ::= S ;
+S ::= if cond then S | if cond then S else S | stmt ;
+`
+
+func TestGenerateTablesReportsAllConflicts(t *testing.T) {
+	grammar := `
+int ::= "0" ; plus ::= "+" ; times ::= "*" ;
+Root ::= E ;
+E ::= E plus E | E times E | int ;
+`
+	_, err := GenerateTables(grammar, nil)
+	var conflictsErr *ConflictsError
+	if !errors.As(err, &conflictsErr) {
+		t.Fatalf("expected ConflictsError, got %v", err)
+	}
+	// Each of the two states after "E op E" conflicts on both operators.
+	if len(conflictsErr.Conflicts) != 4 {
+		t.Errorf("expected 4 conflicts, got %d:\n%v", len(conflictsErr.Conflicts), err)
+	}
+	for _, conflict := range conflictsErr.Conflicts {
+		if conflict.Kind() != "shift/reduce" {
+			t.Errorf("expected shift/reduce conflict, got %s", conflict.Kind())
+		}
+		if len(conflict.Items) == 0 || conflict.StateLabel == "" {
+			t.Errorf("conflict should carry state label and items: %+v", conflict)
+		}
+	}
+	if !strings.Contains(err.Error(), "4 shift/reduce conflicts") {
+		t.Errorf("expected conflict count in error; got:\n%v", err)
+	}
+}
+
+func TestGenerateTablesExpectedConflicts(t *testing.T) {
+	tables, err := GenerateTables("%expect 1 ;\n"+danglingElseBNF, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables with %%expect 1: %v", err)
+	}
+	if len(tables.Conflicts) != 1 || tables.Conflicts[0].Lookahead != "else" {
+		t.Fatalf("expected one conflict on else, got %v", tables.Conflicts)
+	}
+	actual, err := parenthesize(tables, strings.Fields("if cond then if cond then stmt else stmt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "(if cond then (if cond then stmt else stmt))"
+	if actual != expected {
+		t.Errorf("dangling else: got %s, expected %s", actual, expected)
+	}
+
+	if _, err := GenerateTables(danglingElseBNF, nil); err == nil {
+		t.Errorf("expected conflict error without %%expect")
+	}
+	_, err = GenerateTables("%expect 2 ;\n"+danglingElseBNF, nil)
+	if err == nil || !strings.Contains(err.Error(), "(%expect 2)") {
+		t.Errorf("expected %%expect mismatch error, got %v", err)
+	}
+	_, err = GenerateTables(`%expect 3 ; a ::= "a" ; Root ::= a ;`, nil)
+	if err == nil || err.Error() != "no conflicts (%expect 3)" {
+		t.Errorf("expected %%expect mismatch error without conflicts, got %v", err)
+	}
+}
+
+func TestGenerateTablesNonassocReduceReduce(t *testing.T) {
+	// After "E eq E", %nonassoc makes eq an error rather than a shift or a reduce to E, and the
+	// reduce to A conflicts with the latter.
+	grammar := `
+int ::= "0" ; eq ::= "==" ;
+%nonassoc eq ;
+Root ::= E ;
+E ::= E eq E | A eq E | int ;
+A ::= E eq E ;
+`
+	_, err := GenerateTables(grammar, nil)
+	var conflictsErr *ConflictsError
+	if !errors.As(err, &conflictsErr) {
+		t.Fatalf("expected ConflictsError, got %v", err)
+	}
+	found := false
+	for _, conflict := range conflictsErr.Conflicts {
+		if conflict.Lookahead == "eq" && conflict.Kind() == "reduce/reduce" &&
+			conflict.Resolution.Type == actionTypeNonassocError {
 			found = true
-			break
+			if !strings.Contains(conflict.Summary(), "resolved as syntax error (%nonassoc)") {
+				t.Errorf("unexpected summary: %s", conflict.Summary())
+			}
 		}
 	}
 	if !found {
-		t.Error("expected some state to have lcurly: reduce (multi-object fix); got none")
+		t.Errorf("expected a reduce/reduce conflict on eq resolved by %%nonassoc, got:\n%v", err)
 	}
 }
 
-// JSON-like grammar with Value ::= Object | Array so multi-object must add reduce for both lcurly and lbracket.
-// Before the fix, states after [] had only lbracket reduce (so [] {} failed); after {} only lcurly (so {} [] failed).
-const jsonLikeMixedBNF = `
-!ws ::= " " ;
-lcurly  ::= "{" ;
-rcurly  ::= "}" ;
-lbracket ::= "[" ;
-rbracket ::= "]" ;
-Json   ::= Value ;
-Value  ::= Object | Array ;
-Object ::= lcurly rcurly ;
-Array  ::= lbracket rbracket ;
+func TestGenerateTablesResolveConflicts(t *testing.T) {
+	grammar := `
+a ::= "a" ;
+Root ::= S ;
+S ::= X | Y ;
+X ::= a ;
+Y ::= a ;
 `
+	if _, err := GenerateTables("%expect 0 ;\n"+grammar, nil); err == nil {
+		t.Errorf("expected reduce/reduce conflict to fail despite %%expect")
+	}
+	tables, err := GenerateTables(grammar, &ParseTableOptions{ResolveConflicts: true})
+	if err != nil {
+		t.Fatalf("GenerateTables with ResolveConflicts: %v", err)
+	}
+	if len(tables.Conflicts) != 1 {
+		t.Fatalf("expected one conflict, got %d", len(tables.Conflicts))
+	}
+	conflict := tables.Conflicts[0]
+	if conflict.Kind() != "reduce/reduce" {
+		t.Errorf("expected reduce/reduce, got %s", conflict.Kind())
+	}
+	if prod := tables.Productions[conflict.Resolution.Target]; conflict.Resolution.Type != "reduce" || prod.LHS != "X" {
+		t.Errorf("expected default resolution to reduce by the earlier production X ::= a, got %+v", conflict.Resolution)
+	}
+	if !strings.Contains(conflict.Summary(), "reduce/reduce conflict in state") {
+		t.Errorf("unexpected summary %q", conflict.Summary())
+	}
+}
 
-func TestGenerateTablesMultiObjectMixedTypeReduceTerminals(t *testing.T) {
-	r := bytes.NewBufferString(jsonLikeMixedBNF)
-	tables, err := GenerateTablesFromReader(r, &ParseTableOptions{SourceName: "test.bnf"})
+func TestGenerateTablesGLRKeepsConflictActions(t *testing.T) {
+	tables, err := GenerateTables(danglingElseBNF, &ParseTableOptions{GLR: true})
 	if err != nil {
-		t.Fatalf("GenerateTablesFromReader: %v", err)
+		t.Fatalf("GenerateTables with GLR: %v", err)
+	}
+	if len(tables.ConflictActions) != 1 {
+		t.Fatalf("expected conflict actions for one state, got %v", tables.ConflictActions)
+	}
+	conflict := tables.Conflicts[0]
+	actions := tables.ConflictActions[conflict.State]["else"]
+	if len(actions) != 2 {
+		t.Fatalf("expected shift and reduce on else, got %v", actions)
 	}
-	var stateWithLcurlyReduce, stateWithLbracketReduce int
-	foundLcurly := false
-	foundLbracket := false
-	for id, stateActions := range tables.Actions {
-		if act, ok := stateActions["lcurly"]; ok && act.Type == "reduce" {
-			stateWithLcurlyReduce = id
-			foundLcurly = true
+	if tables.Actions[conflict.State]["else"] != conflict.Resolution {
+		t.Errorf("action table should hold the default resolution %+v", conflict.Resolution)
+	}
+
+	jsonBytes, err := EncodeTables(tables, &EncodeOptions{Sort: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := DecodeTables(jsonBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.ConflictActions, tables.ConflictActions) {
+		t.Errorf("conflict actions did not round-trip: got %v, expected %v", decoded.ConflictActions, tables.ConflictActions)
+	}
+
+	tables, err = GenerateTables("%expect 1 ;\n"+danglingElseBNF, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tables.ConflictActions != nil {
+		t.Errorf("conflict actions should be kept only for GLR, got %v", tables.ConflictActions)
+	}
+}
+
+const entryPointsBNF = `
+%start Program Statement Expr ;
+int ::= "0" ;
+plus ::= "+" ;
+semi ::= ";" ;
+Program ::= Statement | Program Statement ;
+Statement ::= Expr semi ;
+Expr ::= Expr plus int | int ;
+`
+
+func TestGenerateTablesEntryPoints(t *testing.T) {
+	for _, lalr := range []bool{false, true} {
+		tables, err := GenerateTables(entryPointsBNF, &ParseTableOptions{LALR: lalr})
+		if err != nil {
+			t.Fatalf("GenerateTables (LALR %v): %v", lalr, err)
+		}
+		if tables.StartSymbol != "Program" {
+			t.Errorf("StartSymbol: got %q", tables.StartSymbol)
 		}
-		if act, ok := stateActions["lbracket"]; ok && act.Type == "reduce" {
-			stateWithLbracketReduce = id
-			foundLbracket = true
+		expected := []EntryPoint{{Symbol: "Program", State: 0}, {Symbol: "Statement", State: 1}, {Symbol: "Expr", State: 2}}
+		if !reflect.DeepEqual(tables.EntryPoints, expected) {
+			t.Fatalf("EntryPoints: got %v", tables.EntryPoints)
+		}
+		for _, tc := range []struct {
+			state    int
+			input    string
+			expected string
+		}{
+			{0, "int semi int plus int semi", "((int semi) ((int plus int) semi))"},
+			{1, "int plus int semi", "((int plus int) semi)"},
+			{2, "int plus int plus int", "((int plus int) plus int)"},
+		} {
+			got, err := parenthesizeFrom(tables, tc.state, strings.Fields(tc.input))
+			if err != nil {
+				t.Errorf("%q from state %d (LALR %v): %v", tc.input, tc.state, lalr, err)
+			} else if got != tc.expected {
+				t.Errorf("%q from state %d (LALR %v): got %q, expected %q", tc.input, tc.state, lalr, got, tc.expected)
+			}
+		}
+		if _, err := parenthesizeFrom(tables, 2, strings.Fields("int semi")); err == nil {
+			t.Errorf("LALR %v: expected Expr not to accept a statement", lalr)
 		}
 	}
-	if !foundLcurly {
-		t.Error("expected some state to have lcurly: reduce (multi-object mixed-type); got none")
+}
+
+func TestGenerateTablesStartSymbol(t *testing.T) {
+	// Without %start, Root is the start symbol, wherever it is; otherwise the first parser rule.
+	tables, err := GenerateTables(`a ::= "a" ; A ::= a ; Root ::= A a ;`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables: %v", err)
+	}
+	if tables.StartSymbol != "Root" || tables.EntryPoints != nil {
+		t.Errorf("got start symbol %q, entry points %v", tables.StartSymbol, tables.EntryPoints)
 	}
-	if !foundLbracket {
-		t.Error("expected some state to have lbracket: reduce (multi-object mixed-type); got none")
+	tables, err = GenerateTables(`%start A ; a ::= "a" ; A ::= a ; Root ::= A a ;`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables: %v", err)
+	}
+	if tables.StartSymbol != "A" || tables.EntryPoints != nil {
+		t.Errorf("got start symbol %q, entry points %v", tables.StartSymbol, tables.EntryPoints)
+	}
+	if err := recognize(tables, []string{"a"}); err != nil {
+		t.Errorf("recognize: %v", err)
+	}
+}
+
+func TestGenerateTablesStartSymbolErrors(t *testing.T) {
+	for grammarText, expected := range map[string]string{
+		`%start A ; %start A ; a ::= "a" ; A ::= a ;`: "%start: declared more than once",
+		`%start ; a ::= "a" ; A ::= a ;`:              "%start: expected one or more parser rule names",
+		`%start a ; a ::= "a" ; A ::= a ;`:            `%start: "a" is not a parser rule`,
+		`%start B ; a ::= "a" ; A ::= a ;`:            `%start: "B" is not a parser rule`,
+		`%start A A ; a ::= "a" ; A ::= a ;`:          `%start: "A" listed more than once`,
+	} {
+		_, err := GenerateTables(grammarText, nil)
+		if err == nil || err.Error() != expected {
+			t.Errorf("%s: got %v, expected %q", grammarText, err, expected)
+		}
 	}
-	// After fix: every state that can reduce to Value gets reduce for all of First(Value), so at least one
-	// state should have both (the state we reach after reducing Value -> Object or Value -> Array).
-	var hasBoth bool
+}
+
+func TestGenerateTablesErrorSymbol(t *testing.T) {
+	tables, err := GenerateTables(`
+int ::= "0" ;
+semi ::= ";" ;
+Program ::= Statement | Program Statement ;
+Statement ::= int semi | error semi ;
+`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables: %v", err)
+	}
+	shifts := 0
 	for _, stateActions := range tables.Actions {
-		lc, okLcurly := stateActions["lcurly"]
-		lb, okLbracket := stateActions["lbracket"]
-		if okLcurly && okLbracket && lc.Type == "reduce" && lb.Type == "reduce" {
-			hasBoth = true
-			break
+		if action, ok := stateActions[errorSymbol]; ok && action.Type == "shift" {
+			shifts++
 		}
 	}
-	if !hasBoth {
-		t.Errorf("multi-object mixed-type fix: expected at least one state to have both lcurly: reduce and lbracket: reduce (stateWithLcurly=%d stateWithLbracket=%d)", stateWithLcurlyReduce, stateWithLbracketReduce)
+	if shifts == 0 {
+		t.Error("expected states which shift error")
+	}
+	// The error terminal is shifted by the parser during recovery, as any other terminal.
+	if got, err := parenthesize(tables, strings.Fields("int semi error semi")); err != nil || got != "((int semi) (error semi))" {
+		t.Errorf("got %q, %v", got, err)
+	}
+
+	for grammarText, expected := range map[string]string{
+		`error ::= "e" ; Root ::= error ;`:   `rule "error": the name is reserved for error recovery`,
+		`Root ::= "error" ;`:                 `rule "Root": literal "error" is reserved for error recovery; define a lexer rule for it`,
+		`e ::= "error" ; Root ::= e error ;`: "",
+	} {
+		_, err := GenerateTables(grammarText, nil)
+		if expected == "" {
+			if err != nil {
+				t.Errorf("%s: %v", grammarText, err)
+			}
+		} else if err == nil || err.Error() != expected {
+			t.Errorf("%s: got %v, expected %q", grammarText, err, expected)
+		}
+	}
+}
+
+func TestGenerateTablesActions(t *testing.T) {
+	tables, err := GenerateTables(`
+<< import "strconv" >>
+int ::= "0" ; plus ::= "+" ;
+Root ::= Sum ;
+Sum ::= Sum plus int << $0.(int) + atoi($2), nil >> | int << atoi($0), nil >> ;
+List ::= { int } ;
+<< func atoi(value any) int { n, _ := strconv.Atoi(string(value.(*tokens.Token).Lexeme)); return n } >>
+`, nil)
+	if err != nil {
+		t.Fatalf("GenerateTables: %v", err)
+	}
+	if !strings.HasPrefix(tables.ActionHeader, `import "strconv"`) || !strings.Contains(tables.ActionHeader, "\nfunc atoi") {
+		t.Errorf("ActionHeader: got %q", tables.ActionHeader)
+	}
+	actions := map[string]string{}
+	for _, prod := range tables.Productions {
+		rhs := make([]string, len(prod.RHS))
+		for i, sym := range prod.RHS {
+			rhs[i] = sym.Name
+		}
+		actions[prod.LHS+" ::= "+strings.Join(rhs, " ")] = prod.Action
+	}
+	for production, expected := range map[string]string{
+		"Root ::= Sum":                            "",
+		"Sum ::= Sum plus int":                    "$0.(int) + atoi($2), nil",
+		"Sum ::= int":                             "atoi($0), nil",
+		"__pgpg_repeat_1 ::= ":                    "[]any{}, nil",
+		"__pgpg_repeat_1 ::= int __pgpg_repeat_1": "append([]any{$0}, $1.([]any)...), nil",
+	} {
+		if got, ok := actions[production]; !ok || got != expected {
+			t.Errorf("%s: got action %q, expected %q (productions %v)", production, got, expected, actions)
+		}
+	}
+}
+
+func TestGoActionCode(t *testing.T) {
+	for action, expected := range map[string]string{
+		"$0.(int) + $12.(int), nil":                   "X[0].(int) + X[12].(int), nil",
+		`fmt.Sprintf("$1 %v", $1), nil`:               `fmt.Sprintf("$1 %v", X[1]), nil`,
+		"fmt.Sprintf(`$1`, '$', \"\\\"$2\", $2), nil": "fmt.Sprintf(`$1`, '$', \"\\\"$2\", X[2]), nil",
+		"$0 /* $1 */, nil // $2\n":                    "X[0] /* $1 */, nil // $2\n",
+		`'\'', $0`:                                    `'\'', X[0]`,
+	} {
+		if got := goActionCode(action); got != expected {
+			t.Errorf("%s: got %s, expected %s", action, got, expected)
+		}
+	}
+}
+
+func TestGenerateTablesActionErrors(t *testing.T) {
+	for grammarText, expected := range map[string]string{
+		`a ::= "a" ; A ::= a << $1, nil >> ;`:                                "production A: action refers to $1, out of range [0, 1)",
+		`a ::= "a" ; A ::= a << fmt.Sprint("$1"), $9999999999999999999 >> ;`: "production A: action refers to $9999999999999999999, out of range [0, 1)",
+		`a ::= "a" ; A ::= (a | a a) << $0, nil >> ;`: `rule "A": semantic action << $0, nil >> is for alternatives of different lengths, ` +
+			"so its $ indices would not be well defined",
+	} {
+		_, err := GenerateTables(grammarText, nil)
+		if err == nil || err.Error() != expected {
+			t.Errorf("%s: got %v, expected %q", grammarText, err, expected)
+		}
 	}
 }
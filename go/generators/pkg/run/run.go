@@ -8,12 +8,18 @@ import (
 
 	"github.com/johnkerl/pgpg/go/generators/pkg/lexgen"
 	"github.com/johnkerl/pgpg/go/generators/pkg/parsegen"
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
 )
 
 // LexgenTablesOptions configures LexgenTables (BNF → JSON).
 type LexgenTablesOptions struct {
 	// SourceName is used in error messages (e.g. file path). If empty, the input path is used.
 	SourceName string
+	// Minimize merges equivalent DFA states; see lexgen.LexTableOptions.
+	Minimize bool
+	// RejectShadowed fails on token rules which never match; see lexgen.LexTableOptions.
+	RejectShadowed bool
 	// Encode controls JSON encoding. Nil means deterministic key order.
 	Encode *lexgen.EncodeOptions
 }
@@ -21,36 +27,62 @@ type LexgenTablesOptions struct {
 // LexgenTables reads a BNF grammar from inputPath, generates lexer tables, and writes JSON to outputPath.
 // If outputPath is "" or "-", writes to stdout. ctx is used for cancellation.
 func LexgenTables(ctx context.Context, inputPath, outputPath string, opts *LexgenTablesOptions) error {
-	if err := ctx.Err(); err != nil {
+	tables, err := generateLexTables(ctx, inputPath, opts)
+	if err != nil {
 		return err
 	}
+	encodeOpts := (*lexgen.EncodeOptions)(nil)
+	if opts != nil {
+		encodeOpts = opts.Encode
+	}
+	jsonBytes, err := lexgen.EncodeTables(tables, encodeOpts)
+	if err != nil {
+		return fmt.Errorf("encode tables: %w", err)
+	}
+	return writeOutput(ctx, outputPath, append(jsonBytes, '\n'))
+}
+
+// LexgenRuntimeTables reads a BNF grammar from inputPath and generates lexer tables for the dfa
+// package to run in process, without writing JSON or generating code. ctx is used for cancellation.
+func LexgenRuntimeTables(ctx context.Context, inputPath string, opts *LexgenTablesOptions) (*dfa.Tables, error) {
+	tables, err := generateLexTables(ctx, inputPath, opts)
+	if err != nil {
+		return nil, err
+	}
+	return lexgen.RuntimeTables(tables)
+}
+
+func generateLexTables(ctx context.Context, inputPath string, opts *LexgenTablesOptions) (*lexgen.Tables, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	grammar, err := os.ReadFile(inputPath)
 	if err != nil {
-		return fmt.Errorf("read grammar: %w", err)
+		return nil, fmt.Errorf("read grammar: %w", err)
 	}
 	sourceName := ""
+	minimize := false
+	rejectShadowed := false
 	if opts != nil {
 		sourceName = opts.SourceName
+		minimize = opts.Minimize
+		rejectShadowed = opts.RejectShadowed
 	}
 	if sourceName == "" {
 		sourceName, _ = filepath.Abs(inputPath)
 	}
-	tables, err := lexgen.GenerateTables(string(grammar), &lexgen.LexTableOptions{SourceName: sourceName})
+	tables, err := lexgen.GenerateTables(string(grammar), &lexgen.LexTableOptions{
+		SourceName:     sourceName,
+		Minimize:       minimize,
+		RejectShadowed: rejectShadowed,
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if err := ctx.Err(); err != nil {
-		return err
-	}
-	encodeOpts := (*lexgen.EncodeOptions)(nil)
-	if opts != nil {
-		encodeOpts = opts.Encode
+		return nil, err
 	}
-	jsonBytes, err := lexgen.EncodeTables(tables, encodeOpts)
-	if err != nil {
-		return fmt.Errorf("encode tables: %w", err)
-	}
-	return writeOutput(ctx, outputPath, append(jsonBytes, '\n'))
+	return tables, nil
 }
 
 // LexgenCode reads tables JSON from tablesPath, generates Go lexer code, and writes to outputPath.
@@ -83,41 +115,87 @@ type ParsegenTablesOptions struct {
 	SourceName string
 	// Encode controls JSON encoding. Nil means deterministic key order.
 	Encode *parsegen.EncodeOptions
+	// LALR selects LALR(1) rather than canonical LR(1) table construction.
+	LALR bool
+	// ResolveConflicts accepts conflicts, resolving them by default rather than failing.
+	ResolveConflicts bool
+	// GLR keeps all actions of conflicting entries, for GLR parsing. It implies ResolveConflicts.
+	GLR bool
+	// Messages, if non-empty, is the path of a messages file whose syntax error messages are
+	// applied to the tables; see parsegen.ApplyErrorMessages.
+	Messages string
 }
 
 // ParsegenTables reads a BNF grammar from inputPath, generates parser tables, and writes JSON to outputPath.
 // If outputPath is "" or "-", writes to stdout. ctx is used for cancellation.
 func ParsegenTables(ctx context.Context, inputPath, outputPath string, opts *ParsegenTablesOptions) error {
-	if err := ctx.Err(); err != nil {
-		return err
-	}
-	grammar, err := os.ReadFile(inputPath)
+	tables, err := generateParseTables(ctx, inputPath, opts)
 	if err != nil {
-		return fmt.Errorf("read grammar: %w", err)
+		return err
 	}
-	sourceName := ""
+	encodeOpts := (*parsegen.EncodeOptions)(nil)
 	if opts != nil {
-		sourceName = opts.SourceName
+		encodeOpts = opts.Encode
 	}
-	if sourceName == "" {
-		sourceName, _ = filepath.Abs(inputPath)
+	jsonBytes, err := parsegen.EncodeTables(tables, encodeOpts)
+	if err != nil {
+		return fmt.Errorf("encode tables: %w", err)
 	}
-	tables, err := parsegen.GenerateTables(string(grammar), &parsegen.ParseTableOptions{SourceName: sourceName})
+	return writeOutput(ctx, outputPath, append(jsonBytes, '\n'))
+}
+
+// ParsegenRuntimeTables reads a BNF grammar from inputPath and generates parser tables for the lr
+// package to run in process, without writing JSON or generating code. ctx is used for cancellation.
+func ParsegenRuntimeTables(ctx context.Context, inputPath string, opts *ParsegenTablesOptions) (*lr.Tables, error) {
+	tables, err := generateParseTables(ctx, inputPath, opts)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return parsegen.RuntimeTables(tables)
+}
+
+// ParsegenGenerateTables reads a BNF grammar from inputPath and returns its parser tables, for
+// callers which look at them, e.g. at their conflicts, before encoding them. ctx is used for
+// cancellation.
+func ParsegenGenerateTables(ctx context.Context, inputPath string, opts *ParsegenTablesOptions) (*parsegen.Tables, error) {
+	return generateParseTables(ctx, inputPath, opts)
+}
+
+func generateParseTables(ctx context.Context, inputPath string, opts *ParsegenTablesOptions) (*parsegen.Tables, error) {
 	if err := ctx.Err(); err != nil {
-		return err
+		return nil, err
 	}
-	encodeOpts := (*parsegen.EncodeOptions)(nil)
+	grammar, err := os.ReadFile(inputPath)
+	if err != nil {
+		return nil, fmt.Errorf("read grammar: %w", err)
+	}
+	tableOpts := &parsegen.ParseTableOptions{}
 	if opts != nil {
-		encodeOpts = opts.Encode
+		tableOpts.SourceName = opts.SourceName
+		tableOpts.LALR = opts.LALR
+		tableOpts.ResolveConflicts = opts.ResolveConflicts
+		tableOpts.GLR = opts.GLR
 	}
-	jsonBytes, err := parsegen.EncodeTables(tables, encodeOpts)
+	if tableOpts.SourceName == "" {
+		tableOpts.SourceName, _ = filepath.Abs(inputPath)
+	}
+	tables, err := parsegen.GenerateTables(string(grammar), tableOpts)
 	if err != nil {
-		return fmt.Errorf("encode tables: %w", err)
+		return nil, err
 	}
-	return writeOutput(ctx, outputPath, append(jsonBytes, '\n'))
+	if opts != nil && opts.Messages != "" {
+		messages, err := os.ReadFile(opts.Messages)
+		if err != nil {
+			return nil, fmt.Errorf("read messages: %w", err)
+		}
+		if err := parsegen.ApplyErrorMessages(tables, string(messages), opts.Messages); err != nil {
+			return nil, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return tables, nil
 }
 
 // ParsegenCode reads tables JSON from tablesPath, generates Go parser code, and writes to outputPath.
@@ -6,9 +6,12 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/johnkerl/pgpg/go/generators/pkg/lexgen"
 	"github.com/johnkerl/pgpg/go/generators/pkg/parsegen"
+	"github.com/johnkerl/pgpg/go/lib/pkg/dfa"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lr"
 )
 
 const minimalLexerBNF = `
@@ -112,3 +115,97 @@ func TestWriteOutputToStdout(t *testing.T) {
 		t.Fatalf("LexgenTables to stdout: %v", err)
 	}
 }
+
+func TestRuntimeTables(t *testing.T) {
+	ctx := context.Background()
+	bnfPath := filepath.Join(t.TempDir(), "grammar.bnf")
+	if err := os.WriteFile(bnfPath, []byte(minimalParserBNF), 0o644); err != nil {
+		t.Fatalf("write BNF: %v", err)
+	}
+
+	lexTables, err := LexgenRuntimeTables(ctx, bnfPath, nil)
+	if err != nil {
+		t.Fatalf("LexgenRuntimeTables: %v", err)
+	}
+	parseTables, err := ParsegenRuntimeTables(ctx, bnfPath, nil)
+	if err != nil {
+		t.Fatalf("ParsegenRuntimeTables: %v", err)
+	}
+	ast, err := lr.NewParser(parseTables).Parse(dfa.NewLexer(lexTables, strings.NewReader(" 1 ")), "")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if root := ast.RootNode; root.Type != "Root" || len(root.Children) != 1 {
+		t.Errorf("root: got %s with %d children, want Root with 1", root.Type, len(root.Children))
+	}
+	if _, err := lr.NewParser(parseTables).Parse(dfa.NewLexer(lexTables, strings.NewReader("1 1")), ""); err == nil {
+		t.Errorf("Parse of %q: expected syntax error", "1 1")
+	}
+
+	if _, err := ParsegenRuntimeTables(ctx, filepath.Join(t.TempDir(), "missing.bnf"), nil); err == nil {
+		t.Errorf("ParsegenRuntimeTables of missing file: expected error")
+	}
+}
+
+func TestParsegenRuntimeTablesMessages(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	bnfPath := filepath.Join(dir, "grammar.bnf")
+	messagesPath := filepath.Join(dir, "grammar.messages")
+	if err := os.WriteFile(bnfPath, []byte(minimalParserBNF), 0o644); err != nil {
+		t.Fatalf("write BNF: %v", err)
+	}
+	if err := os.WriteFile(messagesPath, []byte("Root: num num\n\nonly one number is allowed\n"), 0o644); err != nil {
+		t.Fatalf("write messages: %v", err)
+	}
+
+	lexTables, err := LexgenRuntimeTables(ctx, bnfPath, nil)
+	if err != nil {
+		t.Fatalf("LexgenRuntimeTables: %v", err)
+	}
+	parseTables, err := ParsegenRuntimeTables(ctx, bnfPath, &ParsegenTablesOptions{Messages: messagesPath})
+	if err != nil {
+		t.Fatalf("ParsegenRuntimeTables: %v", err)
+	}
+	_, err = lr.NewParser(parseTables).Parse(dfa.NewLexer(lexTables, strings.NewReader("1 1")), "")
+	if err == nil || !strings.Contains(err.Error(), "only one number is allowed") {
+		t.Errorf("Parse of %q: got %v, want the messages file's error", "1 1", err)
+	}
+
+	_, err = ParsegenRuntimeTables(ctx, bnfPath, &ParsegenTablesOptions{Messages: filepath.Join(dir, "missing.messages")})
+	if err == nil {
+		t.Errorf("ParsegenRuntimeTables with missing messages file: expected error")
+	}
+}
+
+func TestWatchFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "grammar.bnf")
+	if err := os.WriteFile(path, []byte(minimalLexerBNF), 0o644); err != nil {
+		t.Fatalf("write BNF: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := make(chan struct{}, 10)
+	done := make(chan error)
+	go func() {
+		done <- WatchFile(ctx, path, 5*time.Millisecond, func() { changes <- struct{}{} })
+	}()
+
+	waitForChange := func(what string) {
+		t.Helper()
+		select {
+		case <-changes:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no call for %s", what)
+		}
+	}
+	waitForChange("initial run")
+	if err := os.WriteFile(path, []byte(minimalParserBNF), 0o644); err != nil {
+		t.Fatalf("rewrite BNF: %v", err)
+	}
+	waitForChange("rewrite")
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("WatchFile: got %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,33 @@
+package run
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+// WatchFile calls onChange once, then again each time the file at path changes, until ctx is
+// cancelled. Changes are detected by polling the file's modification time and size every interval;
+// a file which is missing or unreadable is treated as unchanged until it reappears.
+func WatchFile(ctx context.Context, path string, interval time.Duration, onChange func()) error {
+	last, _ := os.Stat(path)
+	onChange()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if last != nil && info.ModTime().Equal(last.ModTime()) && info.Size() == last.Size() {
+			continue
+		}
+		last = info
+		onChange()
+	}
+}
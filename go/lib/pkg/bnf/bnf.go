@@ -0,0 +1,405 @@
+// Package bnf expands EBNF grammars, as ASTs from parsers.EBNFParser, into BNF productions: the
+// parser rules' alternatives, optionals, and repeats become plain productions, with synthetic
+// rules for the repeats. It is shared by parsegen's table generation and the earley package's
+// parser, so that both see the same productions and build the same ASTs.
+package bnf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+)
+
+// ErrorSymbol is the reserved terminal for yacc-style error recovery: a production such as
+// Statement ::= error semicolon matches the input skipped over to recover from a syntax error.
+const ErrorSymbol = "error"
+
+// SyntheticPrefix starts the names of the rules added by expansion, such as __pgpg_repeat_1.
+const SyntheticPrefix = "__pgpg_"
+
+// Symbol is a grammar symbol on a production's right-hand side. Terminal names are lexer rule
+// names or literal texts, matching the token types of lexers generated from the same grammar.
+type Symbol struct {
+	Name     string `json:"name"`
+	Terminal bool   `json:"terminal"`
+}
+
+// Production is one alternative of a parser rule, or of a synthetic rule.
+type Production struct {
+	LHS  string   `json:"lhs"`
+	RHS  []Symbol `json:"rhs"`
+	Hint *Hint    `json:"hint,omitempty"`
+	// Precedence is the %prec symbol overriding the production's precedence, if any.
+	Precedence string `json:"precedence,omitempty"`
+	// Action is the production's semantic action, Go code from the grammar's << >> block, if any.
+	Action string `json:"action,omitempty"`
+}
+
+// Grammar is the BNF form of an EBNF grammar.
+type Grammar struct {
+	// StartSymbols are the symbols of the grammar's %start directive; without one, the start
+	// symbol is Root if there is a rule of that name, else the first parser rule.
+	StartSymbols []string
+	// Productions are the parser rules' productions, in grammar order, with each synthetic
+	// rule's productions before those of the rule using it.
+	Productions []Production
+	// ParserRuleSet holds the parser rule names, including synthetic ones.
+	ParserRuleSet map[string]bool
+	// LexerRuleSet holds the lexer rule names.
+	LexerRuleSet map[string]bool
+
+	usedNames    map[string]bool
+	synthCounter int
+}
+
+type ruleDef struct {
+	name string
+	expr *asts.ASTNode
+}
+
+// FromAST expands the grammar in ast, which must come from parsers.EBNFParser. The grammar's AST
+// hints are checked, as described at HintMode.
+func FromAST(ast *asts.AST) (*Grammar, error) {
+	ruleDefs, err := extractRuleDefs(ast)
+	if err != nil {
+		return nil, err
+	}
+	g := &Grammar{
+		ParserRuleSet: map[string]bool{},
+		LexerRuleSet:  map[string]bool{},
+		usedNames:     map[string]bool{},
+	}
+	var parserRuleNames []string
+	for _, rule := range ruleDefs {
+		g.usedNames[rule.name] = true
+		if IsLexerRuleName(rule.name) {
+			g.LexerRuleSet[rule.name] = true
+		} else {
+			g.ParserRuleSet[rule.name] = true
+			parserRuleNames = append(parserRuleNames, rule.name)
+		}
+	}
+	if len(parserRuleNames) == 0 {
+		return nil, fmt.Errorf("no parser rules found")
+	}
+	g.StartSymbols, err = selectStartSymbols(ast, parserRuleNames, g.ParserRuleSet)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range ruleDefs {
+		if IsLexerRuleName(rule.name) {
+			continue
+		}
+		alts, err := g.expandExpr(rule.expr)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", rule.name, err)
+		}
+		for _, alt := range alts {
+			g.Productions = append(g.Productions, Production{
+				LHS:        rule.name,
+				RHS:        alt.symbols,
+				Hint:       alt.hint,
+				Precedence: alt.precedence,
+				Action:     alt.action,
+			})
+		}
+	}
+	if err := g.validateHints(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func extractRuleDefs(ast *asts.AST) ([]ruleDef, error) {
+	if ast == nil || ast.RootNode == nil {
+		return nil, fmt.Errorf("nil AST")
+	}
+	if ast.RootNode.Type != parsers.EBNFParserNodeTypeGrammar {
+		return nil, fmt.Errorf("expected grammar root, got %q", ast.RootNode.Type)
+	}
+	var rules []ruleDef
+	for _, ruleNode := range ast.RootNode.Children {
+		if ruleNode.Type == parsers.EBNFParserNodeTypeDirective || ruleNode.Type == parsers.EBNFParserNodeTypeAction {
+			continue
+		}
+		if ruleNode.Type != parsers.EBNFParserNodeTypeRule {
+			return nil, fmt.Errorf("expected rule node, got %q", ruleNode.Type)
+		}
+		if err := ruleNode.CheckArity(2); err != nil {
+			return nil, err
+		}
+		nameNode := ruleNode.Children[0]
+		if nameNode.Type != parsers.EBNFParserNodeTypeIdentifier || nameNode.Token == nil {
+			return nil, fmt.Errorf("rule name must be identifier")
+		}
+		ruleName := string(nameNode.Token.Lexeme)
+		if ruleName == ErrorSymbol {
+			return nil, fmt.Errorf("rule %q: the name is reserved for error recovery", ruleName)
+		}
+		rules = append(rules, ruleDef{name: ruleName, expr: ruleNode.Children[1]})
+	}
+	return rules, nil
+}
+
+// selectStartSymbols returns the symbols of the grammar's %start directive: the start symbol,
+// then any further entry points. Without one, the start symbol is Root if there is a rule of
+// that name, else the first parser rule.
+func selectStartSymbols(ast *asts.AST, parserRuleNames []string, parserRuleSet map[string]bool) ([]string, error) {
+	var startSymbols []string
+	declared := false
+	for _, node := range ast.RootNode.Children {
+		if node.Type != parsers.EBNFParserNodeTypeDirective || parsers.DirectiveName(node) != parsers.EBNFDirectiveStart {
+			continue
+		}
+		if declared {
+			return nil, fmt.Errorf("%%start: declared more than once")
+		}
+		declared = true
+		if len(node.Children) == 0 {
+			return nil, fmt.Errorf("%%start: expected one or more parser rule names")
+		}
+		for _, child := range node.Children {
+			name := ""
+			if child.Token != nil {
+				name = string(child.Token.Lexeme)
+			}
+			if child.Type != parsers.EBNFParserNodeTypeIdentifier || !parserRuleSet[name] {
+				return nil, fmt.Errorf("%%start: %q is not a parser rule", name)
+			}
+			for _, prior := range startSymbols {
+				if prior == name {
+					return nil, fmt.Errorf("%%start: %q listed more than once", name)
+				}
+			}
+			startSymbols = append(startSymbols, name)
+		}
+	}
+	if declared {
+		return startSymbols, nil
+	}
+	for _, name := range parserRuleNames {
+		if name == "Root" {
+			return []string{name}, nil
+		}
+	}
+	return []string{parserRuleNames[0]}, nil
+}
+
+// IsLexerRuleName follows the grammar convention: lexer rules start with a lowercase letter,
+// '_', or '!'; parser rules with an uppercase letter.
+func IsLexerRuleName(name string) bool {
+	if name == "" {
+		return false
+	}
+	first := []rune(name)[0]
+	if first == '!' {
+		return true
+	}
+	return first == '_' || unicode.IsLower(first)
+}
+
+// PrecedenceSymbolName returns the name of a symbol in a precedence declaration or %prec: a
+// lexer rule name, the text of a quoted literal, or a pseudo-symbol used only with %prec.
+func PrecedenceSymbolName(node *asts.ASTNode) (string, error) {
+	if node.Token == nil {
+		return "", fmt.Errorf("precedence symbol missing token")
+	}
+	text := string(node.Token.Lexeme)
+	switch node.Type {
+	case parsers.EBNFParserNodeTypeIdentifier:
+		return text, nil
+	case parsers.EBNFParserNodeTypeLiteral:
+		unquoted, err := strconv.Unquote(text)
+		if err != nil {
+			return "", fmt.Errorf("invalid literal %q: %w", text, err)
+		}
+		return unquoted, nil
+	default:
+		return "", fmt.Errorf("expected identifier or literal, got %q", text)
+	}
+}
+
+// NewSyntheticName returns a name for a rule added to the grammar, such as __pgpg_start_2 for
+// kind "start", which no other rule has.
+func (g *Grammar) NewSyntheticName(kind string) string {
+	for {
+		g.synthCounter++
+		name := fmt.Sprintf("%s%s_%d", SyntheticPrefix, kind, g.synthCounter)
+		if !g.usedNames[name] {
+			g.usedNames[name] = true
+			return name
+		}
+	}
+}
+
+type alternative struct {
+	symbols    []Symbol
+	hint       *Hint
+	precedence string
+	action     string
+}
+
+func (g *Grammar) expandExpr(node *asts.ASTNode) ([]alternative, error) {
+	switch node.Type {
+	case parsers.EBNFParserNodeTypeLiteral:
+		if node.Token == nil {
+			return nil, fmt.Errorf("literal node missing token")
+		}
+		text := string(node.Token.Lexeme)
+		unquoted, err := strconv.Unquote(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid literal %q: %w", text, err)
+		}
+		if unquoted == ErrorSymbol {
+			return nil, fmt.Errorf("literal %q is reserved for error recovery; define a lexer rule for it", unquoted)
+		}
+		return []alternative{{symbols: []Symbol{{Name: unquoted, Terminal: true}}}}, nil
+	case parsers.EBNFParserNodeTypeRange:
+		return nil, fmt.Errorf("range expressions are only allowed in lexer rules")
+	case parsers.EBNFParserNodeTypeWildcard:
+		return nil, fmt.Errorf("wildcard '.' is only allowed in lexer rules")
+	case parsers.EBNFParserNodeTypeTrailingContext:
+		return nil, fmt.Errorf("trailing context '/' is only allowed in lexer rules")
+	case parsers.EBNFParserNodeTypeLineStart:
+		return nil, fmt.Errorf("line anchor '^' is only allowed in lexer rules")
+	case parsers.EBNFParserNodeTypeDifference:
+		return nil, fmt.Errorf("character set difference '-' is only allowed in lexer rules")
+	case parsers.EBNFParserNodeTypeComplement:
+		return nil, fmt.Errorf("character set complement '~' is only allowed in lexer rules")
+	case parsers.EBNFParserNodeTypeCaseless:
+		return nil, fmt.Errorf("case-insensitive literals are only allowed in lexer rules")
+	case parsers.EBNFParserNodeTypeIdentifier:
+		if node.Token == nil {
+			return nil, fmt.Errorf("identifier node missing token")
+		}
+		identifier := string(node.Token.Lexeme)
+		if g.LexerRuleSet[identifier] || identifier == ErrorSymbol {
+			return []alternative{{symbols: []Symbol{{Name: identifier, Terminal: true}}}}, nil
+		}
+		if !g.ParserRuleSet[identifier] {
+			return nil, fmt.Errorf("undefined rule %q", identifier)
+		}
+		return []alternative{{symbols: []Symbol{{Name: identifier}}}}, nil
+	case parsers.EBNFParserNodeTypeEmpty:
+		return []alternative{{symbols: []Symbol{}}}, nil
+	case parsers.EBNFParserNodeTypeSequence:
+		alts := []alternative{{symbols: []Symbol{}}}
+		for _, child := range node.Children {
+			childAlts, err := g.expandExpr(child)
+			if err != nil {
+				return nil, err
+			}
+			var next []alternative
+			for _, alt := range alts {
+				for _, childAlt := range childAlts {
+					combined := make([]Symbol, 0, len(alt.symbols)+len(childAlt.symbols))
+					combined = append(combined, alt.symbols...)
+					combined = append(combined, childAlt.symbols...)
+					precedence := alt.precedence
+					if childAlt.precedence != "" {
+						precedence = childAlt.precedence
+					}
+					next = append(next, alternative{symbols: combined, precedence: precedence})
+				}
+			}
+			alts = next
+		}
+		return alts, nil
+	case parsers.EBNFParserNodeTypeAlternates:
+		var out []alternative
+		for _, child := range node.Children {
+			childAlts, err := g.expandExpr(child)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, childAlts...)
+		}
+		return out, nil
+	case parsers.EBNFParserNodeTypeOptional:
+		if err := node.CheckArity(1); err != nil {
+			return nil, err
+		}
+		childAlts, err := g.expandExpr(node.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		return append(childAlts, alternative{symbols: []Symbol{}}), nil
+	case parsers.EBNFParserNodeTypeRepeat:
+		if err := node.CheckArity(1); err != nil {
+			return nil, err
+		}
+		childAlts, err := g.expandExpr(node.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		repeatName := g.NewSyntheticName("repeat")
+		g.ParserRuleSet[repeatName] = true
+		g.Productions = append(g.Productions, Production{LHS: repeatName, RHS: []Symbol{}})
+		for _, childAlt := range childAlts {
+			if len(childAlt.symbols) == 0 {
+				continue
+			}
+			combined := make([]Symbol, 0, len(childAlt.symbols)+1)
+			combined = append(combined, childAlt.symbols...)
+			combined = append(combined, Symbol{Name: repeatName})
+			g.Productions = append(g.Productions, Production{LHS: repeatName, RHS: combined})
+		}
+		return []alternative{{symbols: []Symbol{{Name: repeatName}}}}, nil
+	case parsers.EBNFParserNodeTypeHintedSequence:
+		if err := node.CheckArity(2); err != nil {
+			return nil, err
+		}
+		alts, err := g.expandExpr(node.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		hint, err := parseHint(node.Children[1])
+		if err != nil {
+			return nil, err
+		}
+		for i := range alts {
+			alts[i].hint = hint
+		}
+		return alts, nil
+	case parsers.EBNFParserNodeTypeActionSequence:
+		if err := node.CheckArity(2); err != nil {
+			return nil, err
+		}
+		alts, err := g.expandExpr(node.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		action := strings.TrimSpace(string(node.Children[1].Token.Lexeme))
+		for i := range alts {
+			if len(alts[i].symbols) != len(alts[0].symbols) {
+				return nil, fmt.Errorf("semantic action << %s >> is for alternatives of different lengths, "+
+					"so its $ indices would not be well defined", action)
+			}
+			alts[i].action = action
+		}
+		return alts, nil
+	case parsers.EBNFParserNodeTypePrecSequence:
+		if err := node.CheckArity(2); err != nil {
+			return nil, err
+		}
+		alts, err := g.expandExpr(node.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		name, err := PrecedenceSymbolName(node.Children[1])
+		if err != nil {
+			return nil, fmt.Errorf("%%prec: %w", err)
+		}
+		for i := range alts {
+			alts[i].precedence = name
+		}
+		return alts, nil
+	default:
+		return nil, fmt.Errorf("unsupported node type %q", node.Type)
+	}
+}
@@ -0,0 +1,108 @@
+package bnf
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+	"github.com/stretchr/testify/assert"
+)
+
+func expand(t *testing.T, grammarText string) (*Grammar, error) {
+	t.Helper()
+	ast, err := parsers.NewEBNFParser().Parse(strings.NewReader(grammarText))
+	if err != nil {
+		t.Fatalf("grammar %q: %v", grammarText, err)
+	}
+	return FromAST(ast)
+}
+
+func formatProductions(productions []Production) []string {
+	var lines []string
+	for _, prod := range productions {
+		var rhs []string
+		for _, sym := range prod.RHS {
+			rhs = append(rhs, sym.Name)
+		}
+		line := fmt.Sprintf("%s ::= %s", prod.LHS, strings.Join(rhs, " "))
+		if prod.Precedence != "" {
+			line += " %prec " + prod.Precedence
+		}
+		if prod.Action != "" {
+			line += " << " + prod.Action + " >>"
+		}
+		lines = append(lines, strings.TrimSpace(line))
+	}
+	return lines
+}
+
+func TestFromAST(t *testing.T) {
+	g, err := expand(t, `
+int ::= "0"-"9" ;
+minus ::= "-" ;
+Root ::= Expr { ";" Expr } ;
+Expr ::= [ minus ] int | minus Expr %prec UNARY | error << nil, nil >> ;
+`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Root"}, g.StartSymbols)
+	assert.Equal(t, []string{
+		"__pgpg_repeat_1 ::=",
+		"__pgpg_repeat_1 ::= ; Expr __pgpg_repeat_1",
+		"Root ::= Expr __pgpg_repeat_1",
+		"Expr ::= minus int",
+		"Expr ::= int",
+		"Expr ::= minus Expr %prec UNARY",
+		"Expr ::= error << nil, nil >>",
+	}, formatProductions(g.Productions))
+	assert.True(t, g.ParserRuleSet["__pgpg_repeat_1"])
+	assert.True(t, g.LexerRuleSet["minus"])
+	assert.False(t, g.HintMode())
+	assert.Equal(t, "__pgpg_start_2", g.NewSyntheticName("start"))
+}
+
+func TestFromASTStartSymbols(t *testing.T) {
+	g, err := expand(t, `%start Expr Root ; a ::= "a" ; Root ::= Expr ; Expr ::= a ;`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Expr", "Root"}, g.StartSymbols)
+
+	g, err = expand(t, `a ::= "a" ; Expr ::= a ; Stmt ::= Expr ;`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Expr"}, g.StartSymbols)
+}
+
+func TestFromASTHints(t *testing.T) {
+	g, err := expand(t, `
+a ::= "a" ; plus ::= "+" ;
+Root ::= Root plus a -> { "parent": 1, "children": [0, 2], "type": "sum" } | a ;
+`)
+	assert.NoError(t, err)
+	assert.True(t, g.HintMode())
+	assert.Equal(t, &Hint{ParentIndex: 1, ChildIndices: []int{0, 2}, NodeType: "sum"}, g.Productions[0].Hint)
+	assert.Nil(t, g.Productions[1].Hint)
+}
+
+func TestFromASTErrors(t *testing.T) {
+	for grammarText, expected := range map[string]string{
+		`a ::= "a" ;`:                              "no parser rules found",
+		`Root ::= Missing ;`:                       `rule "Root": undefined rule "Missing"`,
+		`error ::= "e" ; Root ::= error ;`:         `rule "error": the name is reserved for error recovery`,
+		`Root ::= "error" ;`:                       `rule "Root": literal "error" is reserved for error recovery; define a lexer rule for it`,
+		`Root ::= "a"-"z" ;`:                       `rule "Root": range expressions are only allowed in lexer rules`,
+		`Root ::= "a"i ;`:                          `rule "Root": case-insensitive literals are only allowed in lexer rules`,
+		`%start A ; Root ::= "a" ;`:                `%start: "A" is not a parser rule`,
+		`Root ::= "a" << x >> | "a" "b" << x >> ;`: "",
+		`Root ::= ( "a" | "a" "b" ) << x >> ;`:     `rule "Root": semantic action << x >> is for alternatives of different lengths, so its $ indices would not be well defined`,
+		`a ::= "a" ; Root ::= a a | a -> { "parent": 0, "children": [0] } ;`: "production Root ::= a a has 2 RHS symbols but no AST hint; in hint mode, multi-element productions require hints",
+		`a ::= "a" ; Root ::= a a -> { "parent": 2, "children": [0] } ;`:     "production Root: parent index 2 out of range [0, 2)",
+		`a ::= "a" ; Root ::= a a -> { "pass-through": 0, "type": "x" } ;`:   `rule "Root": hint "passthrough" cannot be combined with "parent", "parent_literal", "children", "with_appended_children", "with_prepended_children", "with_adopted_grandchildren", or "type"`,
+		`a ::= "a" ; Root ::= a a -> { "parent": 0 } ;`:                      `rule "Root": hint missing required "children", "with_appended_children", "with_prepended_children", or "with_adopted_grandchildren" field`,
+	} {
+		_, err := expand(t, grammarText)
+		if expected == "" {
+			assert.NoError(t, err, grammarText)
+		} else {
+			assert.EqualError(t, err, expected, grammarText)
+		}
+	}
+}
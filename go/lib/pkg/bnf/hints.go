@@ -0,0 +1,207 @@
+package bnf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+)
+
+// Hint captures AST-construction directives for a production, from the grammar's "-> { ... }"
+// block.
+type Hint struct {
+	ParentIndex              int     `json:"parent"`
+	ChildIndices             []int   `json:"children"`
+	WithAppendedChildren     []int   `json:"with_appended_children,omitempty"`
+	WithPrependedChildren    []int   `json:"with_prepended_children,omitempty"`
+	WithAdoptedGrandchildren []int   `json:"with_adopted_grandchildren,omitempty"`
+	ParentLiteral            *string `json:"parent_literal,omitempty"`
+	PassthroughIndex         *int    `json:"pass-through,omitempty"`
+	NodeType                 string  `json:"type,omitempty"`
+}
+
+// HintMode tells whether any production has an AST hint. If so, every other production of a
+// parser rule with more than one right-hand-side symbol must have one too.
+func (g *Grammar) HintMode() bool {
+	for _, prod := range g.Productions {
+		if prod.Hint != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func parseHint(node *asts.ASTNode) (*Hint, error) {
+	if node.Type != parsers.EBNFParserNodeTypeHint {
+		return nil, fmt.Errorf("expected hint node, got %q", node.Type)
+	}
+	hint := &Hint{}
+	seen := map[string]bool{}
+	for _, field := range node.Children {
+		if field.Type != parsers.EBNFParserNodeTypeHintField || field.Token == nil {
+			return nil, fmt.Errorf("invalid hint field node")
+		}
+		key, err := strconv.Unquote(string(field.Token.Lexeme))
+		if err != nil {
+			return nil, fmt.Errorf("invalid hint key %q: %w", string(field.Token.Lexeme), err)
+		}
+		if len(field.Children) != 1 {
+			return nil, fmt.Errorf("hint field %q must have exactly one value", key)
+		}
+		value := field.Children[0]
+		switch key {
+		case "parent":
+			hint.ParentIndex, err = hintInt(key, value)
+		case "pass-through", "passthrough":
+			var index int
+			index, err = hintInt(key, value)
+			hint.PassthroughIndex = &index
+			key = "passthrough"
+		case "parent_literal":
+			var literal string
+			literal, err = hintString(key, value)
+			hint.ParentLiteral = &literal
+		case "type":
+			hint.NodeType, err = hintString(key, value)
+		case "children":
+			hint.ChildIndices, err = hintIntArray(key, value)
+		case "with_appended_children":
+			hint.WithAppendedChildren, err = hintIntArray(key, value)
+		case "with_prepended_children":
+			hint.WithPrependedChildren, err = hintIntArray(key, value)
+		case "with_adopted_grandchildren":
+			hint.WithAdoptedGrandchildren, err = hintIntArray(key, value)
+		default:
+			return nil, fmt.Errorf("unknown hint field %q", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+		seen[key] = true
+	}
+
+	if seen["passthrough"] {
+		if len(seen) > 1 {
+			return nil, fmt.Errorf("hint \"passthrough\" cannot be combined with \"parent\", \"parent_literal\", \"children\", \"with_appended_children\", \"with_prepended_children\", \"with_adopted_grandchildren\", or \"type\"")
+		}
+		return hint, nil
+	}
+	if seen["parent"] && seen["parent_literal"] {
+		return nil, fmt.Errorf("hint cannot set both \"parent\" and \"parent_literal\"")
+	}
+	if !seen["parent"] && !seen["parent_literal"] {
+		return nil, fmt.Errorf("hint missing required \"parent\" or \"parent_literal\" field")
+	}
+	childVariants := 0
+	for _, key := range []string{"children", "with_appended_children", "with_prepended_children", "with_adopted_grandchildren"} {
+		if seen[key] {
+			childVariants++
+		}
+	}
+	if childVariants > 1 {
+		return nil, fmt.Errorf("hint cannot set more than one of \"children\", \"with_appended_children\", \"with_prepended_children\", \"with_adopted_grandchildren\"")
+	}
+	if childVariants == 0 {
+		return nil, fmt.Errorf("hint missing required \"children\", \"with_appended_children\", \"with_prepended_children\", or \"with_adopted_grandchildren\" field")
+	}
+	return hint, nil
+}
+
+func hintInt(key string, node *asts.ASTNode) (int, error) {
+	if node.Type != parsers.EBNFParserNodeTypeHintInt || node.Token == nil {
+		return 0, fmt.Errorf("hint %q must be an integer", key)
+	}
+	value, err := strconv.Atoi(string(node.Token.Lexeme))
+	if err != nil {
+		return 0, fmt.Errorf("invalid hint %s value: %w", key, err)
+	}
+	return value, nil
+}
+
+func hintString(key string, node *asts.ASTNode) (string, error) {
+	if node.Type != parsers.EBNFParserNodeTypeHintString || node.Token == nil {
+		return "", fmt.Errorf("hint %q must be a string", key)
+	}
+	value, err := strconv.Unquote(string(node.Token.Lexeme))
+	if err != nil {
+		return "", fmt.Errorf("invalid hint %s value: %w", key, err)
+	}
+	return value, nil
+}
+
+func hintIntArray(key string, node *asts.ASTNode) ([]int, error) {
+	if node.Type != parsers.EBNFParserNodeTypeHintArray {
+		return nil, fmt.Errorf("hint %q must be an array", key)
+	}
+	values := make([]int, 0, len(node.Children))
+	for _, elem := range node.Children {
+		value, err := hintInt(key, elem)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// validateHints checks, in hint mode, that every user production with more than one
+// right-hand-side symbol has a hint, and that hint indices are in range.
+func (g *Grammar) validateHints() error {
+	if !g.HintMode() {
+		return nil
+	}
+	for _, prod := range g.Productions {
+		if strings.HasPrefix(prod.LHS, SyntheticPrefix) {
+			continue
+		}
+		if prod.Hint == nil {
+			if len(prod.RHS) <= 1 {
+				continue
+			}
+			names := make([]string, len(prod.RHS))
+			for i, sym := range prod.RHS {
+				names[i] = sym.Name
+			}
+			return fmt.Errorf(
+				"production %s ::= %s has %d RHS symbols but no AST hint; "+
+					"in hint mode, multi-element productions require hints",
+				prod.LHS, strings.Join(names, " "), len(prod.RHS))
+		}
+		hint := prod.Hint
+		check := func(what string, index int) error {
+			if index < 0 || index >= len(prod.RHS) {
+				return fmt.Errorf("production %s: %s index %d out of range [0, %d)", prod.LHS, what, index, len(prod.RHS))
+			}
+			return nil
+		}
+		if hint.PassthroughIndex != nil {
+			if err := check("passthrough", *hint.PassthroughIndex); err != nil {
+				return err
+			}
+			continue
+		}
+		if hint.ParentLiteral == nil {
+			if err := check("parent", hint.ParentIndex); err != nil {
+				return err
+			}
+		}
+		for _, indices := range []struct {
+			what   string
+			values []int
+		}{
+			{"child", hint.ChildIndices},
+			{"with_appended_children", hint.WithAppendedChildren},
+			{"with_prepended_children", hint.WithPrependedChildren},
+			{"with_adopted_grandchildren", hint.WithAdoptedGrandchildren},
+		} {
+			for _, index := range indices.values {
+				if err := check(indices.what, index); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
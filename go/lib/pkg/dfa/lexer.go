@@ -0,0 +1,228 @@
+package dfa
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+const bufSize = 4096
+
+// Lexer scans tokens from a reader by running DFA tables, taking the longest match at each point.
+type Lexer struct {
+	tables        *Tables
+	reader        *bufio.Reader
+	buf           []byte
+	tokenStart    int
+	tokenLocation *tokens.TokenLocation
+	atEOF         bool
+	// kinds are those last given to SetTokenKinds, and stateKinds the kind of each accepting
+	// state's token type among them.
+	kinds      *tokens.Kinds
+	stateKinds []int
+	// modes is the mode stack, of indexes into the tables' Modes, with the current mode on top;
+	// it is empty for tables without modes.
+	modes []int
+	// atLineStart is set at the start of input and after a token ending in a newline.
+	atLineStart bool
+}
+
+var _ liblexers.AbstractLexer = (*Lexer)(nil)
+var _ liblexers.KindSetter = (*Lexer)(nil)
+
+// NewLexer returns a lexer running the given tables over r.
+func NewLexer(tables *Tables, r io.Reader) *Lexer {
+	reader, ok := r.(*bufio.Reader)
+	if !ok {
+		reader = bufio.NewReader(r)
+	}
+	lexer := &Lexer{
+		tables:        tables,
+		reader:        reader,
+		buf:           make([]byte, 0, bufSize),
+		tokenLocation: tokens.NewTokenLocation(),
+		atLineStart:   true,
+	}
+	if len(tables.Modes) > 0 {
+		lexer.modes = []int{0}
+	}
+	return lexer
+}
+
+// Mode returns the name of the lexer's current mode, or "" for tables without modes.
+func (lexer *Lexer) Mode() string {
+	if len(lexer.modes) == 0 {
+		return ""
+	}
+	return lexer.tables.Modes[lexer.modes[len(lexer.modes)-1]]
+}
+
+// changeMode makes the mode change for a token of the given type, returning an error token for
+// a pop with no push to return from.
+func (lexer *Lexer) changeMode(action ModeAction, tokenType tokens.TokenType, location *tokens.TokenLocation) *tokens.Token {
+	top := len(lexer.modes) - 1
+	switch action.Kind {
+	case ModePush:
+		lexer.modes = append(lexer.modes, action.Mode)
+	case ModePop:
+		if top == 0 {
+			return tokens.NewErrorToken(
+				fmt.Sprintf("lexer: %s leaves mode %q, which was not entered by a push", tokenType, lexer.Mode()),
+				location)
+		}
+		lexer.modes = lexer.modes[:top]
+	case ModeSwitch:
+		lexer.modes[top] = action.Mode
+	}
+	return nil
+}
+
+// SetTokenKinds makes the lexer set the Kind of each token it scans from kinds.
+func (lexer *Lexer) SetTokenKinds(kinds *tokens.Kinds) {
+	if kinds == lexer.kinds {
+		return
+	}
+	lexer.kinds = kinds
+	lexer.stateKinds = make([]int, len(lexer.tables.Actions))
+	for state, tokenType := range lexer.tables.Actions {
+		lexer.stateKinds[state] = kinds.IDs[tokenType]
+	}
+}
+
+func (lexer *Lexer) ensureFill(needBytes int) {
+	for needBytes > len(lexer.buf) && !lexer.atEOF {
+		chunk := make([]byte, bufSize)
+		n, err := lexer.reader.Read(chunk)
+		if n > 0 {
+			lexer.buf = append(lexer.buf, chunk[:n]...)
+		}
+		if err != nil {
+			lexer.atEOF = true
+			return
+		}
+	}
+}
+
+func (lexer *Lexer) peekRuneAt(byteOffset int) (rune, int) {
+	lexer.ensureFill(byteOffset + utf8.UTFMax)
+	if byteOffset >= len(lexer.buf) {
+		return 0, 0
+	}
+	if b := lexer.buf[byteOffset]; b < utf8.RuneSelf {
+		return rune(b), 1
+	}
+	r, width := utf8.DecodeRune(lexer.buf[byteOffset:])
+	if width == 0 {
+		return 0, 0
+	}
+	return r, width
+}
+
+func (lexer *Lexer) Scan() *tokens.Token {
+	for {
+		lexer.ensureFill(lexer.tokenStart + 1)
+		if lexer.tokenStart >= len(lexer.buf) {
+			return tokens.NewEOFToken(lexer.tokenLocation)
+		}
+
+		startLocation := *lexer.tokenLocation
+		scanOffset := lexer.tokenStart
+		state := lexer.tables.StartState
+		mode := 0
+		if len(lexer.modes) > 0 {
+			mode = lexer.modes[len(lexer.modes)-1]
+			state = lexer.tables.ModeStarts[mode]
+		}
+		if lexer.atLineStart && len(lexer.tables.LineStarts) > 0 {
+			state = lexer.tables.LineStarts[mode]
+		}
+		lastAcceptState := -1
+		lastAcceptOffset := scanOffset
+
+		for {
+			r, width := lexer.peekRuneAt(scanOffset)
+			if width == 0 {
+				break
+			}
+			nextState, ok := lexer.tables.Next(state, r)
+			if !ok {
+				break
+			}
+			scanOffset += width
+			state = nextState
+			if _, ok := lexer.tables.accepts(state); ok {
+				lastAcceptState = state
+				lastAcceptOffset = scanOffset
+			}
+		}
+
+		if lastAcceptState < 0 {
+			r, _ := lexer.peekRuneAt(lexer.tokenStart)
+			return tokens.NewErrorToken(fmt.Sprintf("lexer: unrecognized input %q", r), lexer.tokenLocation)
+		}
+
+		lastAcceptOffset = lexer.trimTrailingContext(lastAcceptState, lastAcceptOffset)
+		lexer.atLineStart = lexer.buf[lastAcceptOffset-1] == '\n'
+
+		lexemeBytes := lexer.buf[lexer.tokenStart:lastAcceptOffset]
+		ignored := lexer.tables.ignored(lastAcceptState)
+		var lexeme []rune
+		if !ignored {
+			lexeme = make([]rune, 0, len(lexemeBytes))
+		}
+		for len(lexemeBytes) > 0 {
+			r, w := rune(lexemeBytes[0]), 1
+			if r >= utf8.RuneSelf {
+				r, w = utf8.DecodeRune(lexemeBytes)
+			}
+			lexer.tokenLocation.LocateRune(r, w)
+			if !ignored {
+				lexeme = append(lexeme, r)
+			}
+			lexemeBytes = lexemeBytes[w:]
+		}
+		lexer.buf = lexer.buf[lastAcceptOffset:]
+		lexer.tokenStart = 0
+		if action := lexer.tables.modeAction(lastAcceptState); action.Kind != ModeNone {
+			if errorToken := lexer.changeMode(action, lexer.tables.Actions[lastAcceptState], &startLocation); errorToken != nil {
+				return errorToken
+			}
+		}
+		if ignored {
+			continue
+		}
+		token := tokens.NewToken(lexeme, lexer.tables.Actions[lastAcceptState], &startLocation)
+		if lexer.stateKinds != nil {
+			token.Kind = lexer.stateKinds[lastAcceptState]
+		}
+		return token
+	}
+}
+
+// trimTrailingContext returns where the token accepted by state ends, when the match ending at
+// matchEnd includes trailing context which is left to be scanned again.
+func (lexer *Lexer) trimTrailingContext(state int, matchEnd int) int {
+	context := lexer.tables.trailingContext(state)
+	switch {
+	case context.Head > 0:
+		end := lexer.tokenStart
+		for i := 0; i < context.Head; i++ {
+			_, width := lexer.peekRuneAt(end)
+			end += width
+		}
+		return end
+	case context.Tail > 0:
+		end := matchEnd
+		for i := 0; i < context.Tail; i++ {
+			_, width := utf8.DecodeLastRune(lexer.buf[lexer.tokenStart:end])
+			end -= width
+		}
+		return end
+	default:
+		return matchEnd
+	}
+}
@@ -0,0 +1,211 @@
+package dfa
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+	"github.com/stretchr/testify/assert"
+)
+
+// intTablesJSON are hand-built DFA tables for the lexer rules
+//
+//	int ::= "0"-"9" { "0"-"9" } ;  !ws ::= " " ;
+const intTablesJSON = `{
+  "start_state": 0,
+  "transitions": {
+    "0": [{"from": 48, "to": 57, "next": 1}, {"from": 32, "to": 32, "next": 2}],
+    "1": [{"from": 48, "to": 57, "next": 1}]
+  },
+  "actions": {"1": "int", "2": "!ws"}
+}`
+
+func TestLexer(t *testing.T) {
+	tables, err := DecodeTables([]byte(intTablesJSON))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 3, tables.ASCIIClassCount, "digits, space, and all else")
+	assert.Equal(t, []tokens.TokenType{"", "int", "!ws"}, tables.Actions)
+	assert.Equal(t, []bool{false, false, true}, tables.Ignored)
+
+	lexer := NewLexer(tables, strings.NewReader("12  3"))
+	token := lexer.Scan()
+	assert.Equal(t, tokens.TokenType("int"), token.Type)
+	assert.Equal(t, "12", string(token.Lexeme))
+	token = lexer.Scan()
+	assert.Equal(t, "3", string(token.Lexeme))
+	assert.Equal(t, 5, token.Location.ColumnNumber)
+	assert.True(t, lexer.Scan().IsEOF())
+
+	lexer = NewLexer(tables, strings.NewReader("1x"))
+	assert.Equal(t, "1", string(lexer.Scan().Lexeme))
+	token = lexer.Scan()
+	assert.True(t, token.IsError())
+	assert.Equal(t, `lexer: unrecognized input 'x'`, string(token.Lexeme))
+}
+
+// wordTablesJSON are hand-built DFA tables for the lexer rule
+//
+//	word ::= ( "a"-"z" | "\u00e0"-"\u00ff" | "\u0100"-"\u017f" ) { "a"-"\u017f" } ;
+const wordTablesJSON = `{
+  "start_state": 0,
+  "transitions": {
+    "0": [{"from": 256, "to": 383, "next": 1}, {"from": 97, "to": 122, "next": 1}, {"from": 224, "to": 255, "next": 1}],
+    "1": [{"from": 97, "to": 383, "next": 1}]
+  },
+  "actions": {"1": "word"}
+}`
+
+func TestTablesNext(t *testing.T) {
+	tables, err := DecodeTables([]byte(wordTablesJSON))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []Transition{{From: 224, To: 255, Next: 1}, {From: 256, To: 383, Next: 1}}, tables.NonASCII[0])
+	assert.Equal(t, []Transition{{From: 128, To: 383, Next: 1}}, tables.NonASCII[1])
+	for _, tc := range []struct {
+		state int
+		r     rune
+		next  int
+		ok    bool
+	}{
+		{0, 'a', 1, true},
+		{0, '{', 0, false},
+		{0, 'A', 0, false},
+		{0, 'é', 1, true},
+		{0, 'ő', 1, true},
+		{0, 0x80, 0, false},
+		{0, 0x180, 0, false},
+		{1, '{', 1, true},
+		{1, 0x80, 1, true},
+		{1, 'A', 0, false},
+		{1, 0x180, 0, false},
+	} {
+		next, ok := tables.Next(tc.state, tc.r)
+		assert.Equal(t, tc.ok, ok, "state %d, rune %q", tc.state, tc.r)
+		assert.Equal(t, tc.next, next, "state %d, rune %q", tc.state, tc.r)
+	}
+
+	lexer := NewLexer(tables, strings.NewReader("façade"))
+	assert.Equal(t, "façade", string(lexer.Scan().Lexeme))
+}
+
+func TestLexerTokenKinds(t *testing.T) {
+	tables, err := DecodeTables([]byte(intTablesJSON))
+	if !assert.NoError(t, err) {
+		return
+	}
+	lexer := NewLexer(tables, strings.NewReader("1 2"))
+	assert.Equal(t, 0, lexer.Scan().Kind)
+	lexer.SetTokenKinds(tokens.NewKinds("plus", "int"))
+	assert.Equal(t, 2, lexer.Scan().Kind)
+	assert.Equal(t, 0, lexer.Scan().Kind, "EOF is not a kind of these")
+}
+
+// modeTablesJSON are hand-built DFA tables for the lexer rules
+//
+//	%mode string close text ;  %push string open ;  %pop close rparen ;
+//	open ::= "\"" ;  id ::= "a"-"z" { "a"-"z" } ;  rparen ::= ")" ;
+//	close ::= "\"" ;  text ::= ( "a"-"z" | " " ) { "a"-"z" | " " } ;
+const modeTablesJSON = `{
+  "start_state": 0,
+  "transitions": {
+    "0": [{"from": 34, "to": 34, "next": 2}, {"from": 41, "to": 41, "next": 3}, {"from": 97, "to": 122, "next": 4}],
+    "1": [{"from": 32, "to": 32, "next": 5}, {"from": 34, "to": 34, "next": 6}, {"from": 97, "to": 122, "next": 5}],
+    "4": [{"from": 97, "to": 122, "next": 4}],
+    "5": [{"from": 32, "to": 32, "next": 5}, {"from": 97, "to": 122, "next": 5}]
+  },
+  "actions": {"2": "open", "3": "rparen", "4": "id", "5": "text", "6": "close"},
+  "modes": [{"name": "default", "start_state": 0}, {"name": "string", "start_state": 1}],
+  "mode_actions": {"close": {"pop": true}, "open": {"push": "string"}, "rparen": {"pop": true}}
+}`
+
+func TestLexerModes(t *testing.T) {
+	tables, err := DecodeTables([]byte(modeTablesJSON))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []string{"default", "string"}, tables.Modes)
+	assert.Equal(t, []int{0, 1}, tables.ModeStarts)
+	assert.Equal(t, ModeAction{Kind: ModePush, Mode: 1}, tables.ModeActions[2])
+	assert.Equal(t, ModeAction{Kind: ModePop}, tables.ModeActions[6])
+
+	lexer := NewLexer(tables, strings.NewReader(`ab"c d"e`))
+	assert.Equal(t, "default", lexer.Mode())
+	for _, want := range []struct {
+		tokenType tokens.TokenType
+		lexeme    string
+		mode      string
+	}{
+		{"id", "ab", "default"},
+		{"open", `"`, "string"},
+		{"text", "c d", "string"},
+		{"close", `"`, "default"},
+		{"id", "e", "default"},
+	} {
+		token := lexer.Scan()
+		assert.Equal(t, want.tokenType, token.Type)
+		assert.Equal(t, want.lexeme, string(token.Lexeme))
+		assert.Equal(t, want.mode, lexer.Mode())
+	}
+	assert.True(t, lexer.Scan().IsEOF())
+
+	lexer = NewLexer(tables, strings.NewReader("a)"))
+	assert.Equal(t, "a", string(lexer.Scan().Lexeme))
+	token := lexer.Scan()
+	assert.True(t, token.IsError())
+	assert.Equal(t, `lexer: rparen leaves mode "default", which was not entered by a push`, string(token.Lexeme))
+	assert.Equal(t, 2, token.Location.ColumnNumber)
+
+	_, err = DecodeTables([]byte(strings.Replace(modeTablesJSON, `"push": "string"`, `"push": "heredoc"`, 1)))
+	assert.Error(t, err)
+}
+
+// anchorTablesJSON are hand-built DFA tables for the lexer rules
+//
+//	label ::= "a"-"z" { "a"-"z" } / ":" ;  word ::= "a"-"z" { "a"-"z" } ;  colon ::= ":" ;
+//	indent ::= ^ " " ;  !ws ::= " " ;  nl ::= "\n" ;
+const anchorTablesJSON = `{
+  "start_state": 0,
+  "transitions": {
+    "0": [{"from": 10, "to": 10, "next": 5}, {"from": 32, "to": 32, "next": 4}, {"from": 58, "to": 58, "next": 3}, {"from": 97, "to": 122, "next": 1}],
+    "1": [{"from": 58, "to": 58, "next": 2}, {"from": 97, "to": 122, "next": 1}],
+    "6": [{"from": 10, "to": 10, "next": 5}, {"from": 32, "to": 32, "next": 7}, {"from": 58, "to": 58, "next": 3}, {"from": 97, "to": 122, "next": 1}]
+  },
+  "actions": {"1": "word", "2": "label", "3": "colon", "4": "!ws", "5": "nl", "7": "indent"},
+  "trailing_contexts": {"2": {"tail": 1}},
+  "line_start_states": [6]
+}`
+
+func TestLexerAnchors(t *testing.T) {
+	tables, err := DecodeTables([]byte(anchorTablesJSON))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []int{6}, tables.LineStarts)
+	assert.Equal(t, TrailingContext{Tail: 1}, tables.TrailingContexts[2])
+
+	lexer := NewLexer(tables, strings.NewReader("ab:c d\n x:"))
+	for _, want := range []struct {
+		tokenType tokens.TokenType
+		lexeme    string
+	}{
+		{"label", "ab"},
+		{"colon", ":"},
+		{"word", "c"},
+		{"word", "d"},
+		{"nl", "\n"},
+		{"indent", " "},
+		{"label", "x"},
+		{"colon", ":"},
+	} {
+		token := lexer.Scan()
+		assert.Equal(t, want.tokenType, token.Type)
+		assert.Equal(t, want.lexeme, string(token.Lexeme))
+	}
+	assert.True(t, lexer.Scan().IsEOF())
+
+	_, err = DecodeTables([]byte(strings.Replace(anchorTablesJSON, `"2": {"tail": 1}`, `"0": {"tail": 1}`, 1)))
+	assert.Error(t, err)
+}
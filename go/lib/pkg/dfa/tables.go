@@ -0,0 +1,365 @@
+// Package dfa runs lexer DFA tables, as generated by lexgen-tables, to scan tokens from input.
+// The tables can be decoded from their JSON encoding, or declared in Go, as the lexers generated
+// by lexgen-code do; either way all scanning logic lives here.
+package dfa
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+// Transition is a DFA transition to state Next on any rune from From through To.
+type Transition struct {
+	From rune
+	To   rune
+	Next int
+}
+
+// asciiLimit is the first rune past ASCII. Transitions on ASCII runes are looked up in a flat
+// table, and those on other runes by binary search.
+const asciiLimit = 128
+
+// Tables are a lexer DFA's tables.
+//
+// ASCII runes are grouped into classes, two runes being in the same class if every state has the
+// same transition on both, so that the ASCII transition table needs a column per class rather than
+// per rune. Slices indexed by state may end before the last state, for states after which they
+// hold nothing.
+type Tables struct {
+	StartState int
+	// ASCIIClasses holds the class of each ASCII rune.
+	ASCIIClasses []uint8
+	// ASCIIClassCount is the number of classes, the width of each state's row of ASCIINext.
+	ASCIIClassCount int
+	// ASCIINext[s*ASCIIClassCount+c] is the state reached from state s on a rune of class c, or -1
+	// if there is no transition.
+	ASCIINext []int32
+	// NonASCII holds each state's transitions on non-ASCII runes, sorted by From, with disjoint
+	// ranges.
+	NonASCII [][]Transition
+	// Actions holds the token type of each accepting state, and is empty for other states.
+	Actions []tokens.TokenType
+	// Ignored is set for accepting states whose token type is for ignored input, such as
+	// whitespace: those starting with "!", which Scan skips.
+	Ignored []bool
+	// Modes names the lexer modes, for tables with more than one, and ModeStarts holds the start
+	// state of each. The lexer starts in the first, whose start state is StartState.
+	Modes      []string
+	ModeStarts []int
+	// ModeActions holds the mode change made after scanning each accepting state's token.
+	ModeActions []ModeAction
+	// LineStarts holds, for tables with rules anchored to the start of a line, the state scanning
+	// begins in at the start of a line, for each mode or for the one start state.
+	LineStarts []int
+	// TrailingContexts holds, for accepting states of rules with trailing context, how much of the
+	// match is the token.
+	TrailingContexts []TrailingContext
+}
+
+// TrailingContext gives how much of a match with trailing context is the token: its first Head
+// runes, or, if Head is zero, all but its last Tail runes. Both are zero for matches without
+// trailing context.
+type TrailingContext struct {
+	Head int
+	Tail int
+}
+
+// ModeActionKind is the kind of a ModeAction.
+type ModeActionKind uint8
+
+const (
+	ModeNone   ModeActionKind = iota
+	ModePush                  // enter the mode, returning to the current one on a pop
+	ModePop                   // return to the mode before the last push
+	ModeSwitch                // replace the current mode
+)
+
+// ModeAction is a change of lexer mode, to Mode, an index into Tables.Modes, for pushes and
+// switches.
+type ModeAction struct {
+	Kind ModeActionKind
+	Mode int
+}
+
+// jsonTables is the JSON encoding of Tables written by lexgen-tables.
+type jsonTables struct {
+	StartState  int                         `json:"start_state"`
+	Transitions map[string][]jsonTransition `json:"transitions"`
+	Actions     map[string]string           `json:"actions"`
+	Modes       []jsonMode                  `json:"modes"`
+	ModeActions map[string]jsonModeAction   `json:"mode_actions"`
+	// TrailingContexts and LineStartStates are only present for grammars using "/" and "^".
+	TrailingContexts map[string]jsonTrailingContext `json:"trailing_contexts"`
+	LineStartStates  []int                          `json:"line_start_states"`
+}
+
+type jsonTrailingContext struct {
+	Head int `json:"head"`
+	Tail int `json:"tail"`
+}
+
+type jsonMode struct {
+	Name       string `json:"name"`
+	StartState int    `json:"start_state"`
+}
+
+type jsonModeAction struct {
+	Push   string `json:"push"`
+	Pop    bool   `json:"pop"`
+	Switch string `json:"switch"`
+}
+
+type jsonTransition struct {
+	From rune `json:"from"`
+	To   rune `json:"to"`
+	Next int  `json:"next"`
+}
+
+// DecodeTables reads tables from their JSON encoding, as written by lexgen-tables.
+func DecodeTables(data []byte) (*Tables, error) {
+	var encoded jsonTables
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, fmt.Errorf("decode lexer tables: %w", err)
+	}
+	transitions := make(map[int][]Transition, len(encoded.Transitions))
+	for key, stateTransitions := range encoded.Transitions {
+		state, err := decodeState(key)
+		if err != nil {
+			return nil, err
+		}
+		decoded := make([]Transition, len(stateTransitions))
+		for i, transition := range stateTransitions {
+			decoded[i] = Transition{From: transition.From, To: transition.To, Next: transition.Next}
+		}
+		transitions[state] = decoded
+	}
+	actions := make(map[int]tokens.TokenType, len(encoded.Actions))
+	for key, tokenType := range encoded.Actions {
+		state, err := decodeState(key)
+		if err != nil {
+			return nil, err
+		}
+		actions[state] = tokens.TokenType(tokenType)
+	}
+	tables := newTables(encoded.StartState, transitions, actions)
+	if err := tables.setModes(encoded.Modes, encoded.ModeActions); err != nil {
+		return nil, err
+	}
+	if err := tables.setAnchors(encoded.LineStartStates, encoded.TrailingContexts); err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+// setAnchors sets the tables' line start states and the trailing context of each accepting state
+// which has one.
+func (tables *Tables) setAnchors(lineStarts []int, trailingContexts map[string]jsonTrailingContext) error {
+	if len(lineStarts) > 0 && len(lineStarts) != max(len(tables.Modes), 1) {
+		return fmt.Errorf("decode lexer tables: %d line start states for %d modes", len(lineStarts), len(tables.Modes))
+	}
+	tables.LineStarts = lineStarts
+	for key, encoded := range trailingContexts {
+		state, err := decodeState(key)
+		if err != nil {
+			return err
+		}
+		if _, ok := tables.accepts(state); !ok {
+			return fmt.Errorf("decode lexer tables: trailing context for non-accepting state %d", state)
+		}
+		for state >= len(tables.TrailingContexts) {
+			tables.TrailingContexts = append(tables.TrailingContexts, TrailingContext{})
+		}
+		tables.TrailingContexts[state] = TrailingContext{Head: encoded.Head, Tail: encoded.Tail}
+	}
+	return nil
+}
+
+// setModes sets the tables' modes, and the mode action of each accepting state whose token type
+// changes mode.
+func (tables *Tables) setModes(modes []jsonMode, modeActions map[string]jsonModeAction) error {
+	modeIndex := map[string]int{}
+	for i, mode := range modes {
+		tables.Modes = append(tables.Modes, mode.Name)
+		tables.ModeStarts = append(tables.ModeStarts, mode.StartState)
+		modeIndex[mode.Name] = i
+	}
+	if len(modes) > 0 && tables.ModeStarts[0] != tables.StartState {
+		return fmt.Errorf("decode lexer tables: first mode %q does not start at the start state", modes[0].Name)
+	}
+	byType := map[tokens.TokenType]ModeAction{}
+	for tokenType, encoded := range modeActions {
+		var action ModeAction
+		target := ""
+		switch {
+		case encoded.Pop:
+			action.Kind = ModePop
+		case encoded.Push != "":
+			action.Kind, target = ModePush, encoded.Push
+		case encoded.Switch != "":
+			action.Kind, target = ModeSwitch, encoded.Switch
+		default:
+			return fmt.Errorf("decode lexer tables: empty mode action for %q", tokenType)
+		}
+		if action.Kind != ModePop {
+			mode, ok := modeIndex[target]
+			if !ok {
+				return fmt.Errorf("decode lexer tables: %q changes to unknown mode %q", tokenType, target)
+			}
+			action.Mode = mode
+		}
+		byType[tokens.TokenType(tokenType)] = action
+	}
+	for state, tokenType := range tables.Actions {
+		if action, ok := byType[tokenType]; ok {
+			for state >= len(tables.ModeActions) {
+				tables.ModeActions = append(tables.ModeActions, ModeAction{})
+			}
+			tables.ModeActions[state] = action
+		}
+	}
+	return nil
+}
+
+// newTables builds tables from each state's transitions and each accepting state's token type.
+func newTables(startState int, transitions map[int][]Transition, actions map[int]tokens.TokenType) *Tables {
+	numStates := startState + 1
+	for state, stateTransitions := range transitions {
+		numStates = max(numStates, state+1)
+		for _, transition := range stateTransitions {
+			numStates = max(numStates, transition.Next+1)
+		}
+	}
+	for state := range actions {
+		numStates = max(numStates, state+1)
+	}
+
+	// Each state's transition on each ASCII rune, from which the classes are those of runes with
+	// the same column.
+	columns := make([][]int32, asciiLimit)
+	for r := range columns {
+		columns[r] = make([]int32, numStates)
+		for state := range columns[r] {
+			columns[r][state] = -1
+		}
+	}
+	tables := &Tables{StartState: startState, ASCIIClasses: make([]uint8, asciiLimit)}
+	for state, stateTransitions := range transitions {
+		for _, transition := range stateTransitions {
+			for r := max(transition.From, 0); r <= transition.To && r < asciiLimit; r++ {
+				columns[r][state] = int32(transition.Next)
+			}
+			if transition.To >= asciiLimit {
+				for state >= len(tables.NonASCII) {
+					tables.NonASCII = append(tables.NonASCII, nil)
+				}
+				tables.NonASCII[state] = append(tables.NonASCII[state],
+					Transition{From: max(transition.From, asciiLimit), To: transition.To, Next: transition.Next})
+			}
+		}
+	}
+	for _, stateTransitions := range tables.NonASCII {
+		sort.Slice(stateTransitions, func(i, j int) bool { return stateTransitions[i].From < stateTransitions[j].From })
+	}
+
+	var classColumns [][]int32
+	for r, column := range columns {
+		class := slices.IndexFunc(classColumns, func(classColumn []int32) bool { return slices.Equal(classColumn, column) })
+		if class < 0 {
+			class = len(classColumns)
+			classColumns = append(classColumns, column)
+		}
+		tables.ASCIIClasses[r] = uint8(class)
+	}
+	tables.ASCIIClassCount = len(classColumns)
+	tables.ASCIINext = make([]int32, numStates*tables.ASCIIClassCount)
+	for class, column := range classColumns {
+		for state, next := range column {
+			tables.ASCIINext[state*tables.ASCIIClassCount+class] = next
+		}
+	}
+
+	for state, tokenType := range actions {
+		for state >= len(tables.Actions) {
+			tables.Actions = append(tables.Actions, "")
+		}
+		tables.Actions[state] = tokenType
+		if strings.HasPrefix(string(tokenType), "!") {
+			for state >= len(tables.Ignored) {
+				tables.Ignored = append(tables.Ignored, false)
+			}
+			tables.Ignored[state] = true
+		}
+	}
+	return tables
+}
+
+func decodeState(key string) (int, error) {
+	state, err := strconv.Atoi(key)
+	if err != nil {
+		return 0, fmt.Errorf("decode lexer tables: state %q is not an integer", key)
+	}
+	return state, nil
+}
+
+// Next returns the state reached from state on r, if any.
+func (tables *Tables) Next(state int, r rune) (int, bool) {
+	if r >= 0 && r < asciiLimit {
+		next := tables.ASCIINext[state*tables.ASCIIClassCount+int(tables.ASCIIClasses[r])]
+		if next < 0 {
+			return 0, false
+		}
+		return int(next), true
+	}
+	if state >= len(tables.NonASCII) {
+		return 0, false
+	}
+	ranges := tables.NonASCII[state]
+	low, high := 0, len(ranges)
+	for low < high {
+		mid := (low + high) / 2
+		if ranges[mid].To < r {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	if low < len(ranges) && ranges[low].From <= r {
+		return ranges[low].Next, true
+	}
+	return 0, false
+}
+
+// accepts returns the token type of state, if it is an accepting state.
+func (tables *Tables) accepts(state int) (tokens.TokenType, bool) {
+	if state >= len(tables.Actions) || tables.Actions[state] == "" {
+		return "", false
+	}
+	return tables.Actions[state], true
+}
+
+// modeAction returns the mode change made after scanning state's token.
+func (tables *Tables) modeAction(state int) ModeAction {
+	if state >= len(tables.ModeActions) {
+		return ModeAction{}
+	}
+	return tables.ModeActions[state]
+}
+
+// trailingContext returns state's trailing context, zero if it has none.
+func (tables *Tables) trailingContext(state int) TrailingContext {
+	if state >= len(tables.TrailingContexts) {
+		return TrailingContext{}
+	}
+	return tables.TrailingContexts[state]
+}
+
+// ignored tells whether state accepts ignored input.
+func (tables *Tables) ignored(state int) bool {
+	return state < len(tables.Ignored) && tables.Ignored[state]
+}
@@ -0,0 +1,384 @@
+// Package earley is an Earley parser which interprets an EBNF grammar directly, from the AST
+// produced by parsers.EBNFParser, with no table generation. It accepts any context-free grammar,
+// including ambiguous and left- or right-recursive ones, which makes it suited to trying out
+// grammar changes before generating an LR parser.
+//
+// Tokens come from a lexer whose token types are the grammar's lexer rule names and literal
+// texts, such as one generated by lexgen from the same grammar. ASTs are built with the same AST
+// hint semantics, and the same ast modes, as parsers generated by parsegen. There is no error
+// recovery: productions using the reserved error terminal never match.
+package earley
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/bnf"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+// Parser is an Earley parser for one grammar. It holds no per-parse state, so one Parser may be
+// used for any number of parses.
+type Parser struct {
+	grammar *grammar
+}
+
+// NewParser returns a parser for the grammar in ast, which must come from parsers.EBNFParser.
+// The start symbol is the first symbol of the grammar's %start directive if it has one, else
+// Root if there is a rule of that name, else the first parser rule.
+func NewParser(ast *asts.AST) (*Parser, error) {
+	g, err := newGrammarFromAST(ast)
+	if err != nil {
+		return nil, err
+	}
+	return &Parser{grammar: g}, nil
+}
+
+// StartSymbol returns the grammar's start symbol.
+func (parser *Parser) StartSymbol() string {
+	return parser.grammar.StartSymbols[0]
+}
+
+// item is an Earley item: a production with a dot position, started at input position origin.
+type item struct {
+	prod   int
+	dot    int
+	origin int
+}
+
+// itemSet is the Earley set for one input position.
+type itemSet struct {
+	items []item
+	seen  map[item]bool
+	// completed records, per nonterminal and origin, the productions completed at this position.
+	completed map[spanStart][]int
+}
+
+type spanStart struct {
+	lhs    string
+	origin int
+}
+
+func newItemSet() *itemSet {
+	return &itemSet{seen: map[item]bool{}, completed: map[spanStart][]int{}}
+}
+
+func (set *itemSet) add(it item) {
+	if !set.seen[it] {
+		set.seen[it] = true
+		set.items = append(set.items, it)
+	}
+}
+
+// Parse parses the lexer's tokens and returns the AST. astMode is "" to apply the grammar's AST
+// hints, "fullast" to ignore them, or "noast" to only check syntax, returning a nil AST.
+//
+// For ambiguous input one parse is returned: at each nonterminal, the earliest production in
+// grammar order which derives its span is chosen, and earlier children take the longest spans
+// they can, so that for example E ::= E plus E groups to the left.
+func (parser *Parser) Parse(lexer lexers.AbstractLexer, astMode string) (*asts.AST, error) {
+	return parser.ParseFrom(parser.grammar.StartSymbols[0], lexer, astMode)
+}
+
+// ParseFrom is Parse with symbol, which may be any parser rule, in place of the start symbol.
+func (parser *Parser) ParseFrom(symbol string, lexer lexers.AbstractLexer, astMode string) (*asts.AST, error) {
+	if lexer == nil {
+		return nil, fmt.Errorf("parser: nil lexer")
+	}
+	g := parser.grammar
+	if !g.ParserRuleSet[symbol] {
+		return nil, fmt.Errorf("parser: %q is not a parser rule", symbol)
+	}
+	sets := []*itemSet{newItemSet()}
+	for _, prodIndex := range g.byLHS[symbol] {
+		sets[0].add(item{prod: prodIndex})
+	}
+	var input []*tokens.Token
+	for {
+		position := len(input)
+		parser.closeSet(sets, position)
+
+		lookahead := lexer.Scan()
+		if lookahead == nil {
+			return nil, fmt.Errorf("parser: lexer returned nil token")
+		}
+		if lookahead.Type == tokens.TokenTypeError {
+			return nil, lexers.NewLexError(lookahead, "")
+		}
+		if lookahead.Type == tokens.TokenTypeEOF {
+			if len(sets[position].completed[spanStart{lhs: symbol, origin: 0}]) == 0 {
+				return nil, parser.syntaxError(sets[position], lookahead, "unexpected end of input")
+			}
+			break
+		}
+
+		next := newItemSet()
+		for _, it := range sets[position].items {
+			prod := g.Productions[it.prod]
+			if it.dot < len(prod.RHS) && prod.RHS[it.dot].Terminal && prod.RHS[it.dot].Name == string(lookahead.Type) {
+				next.add(item{prod: it.prod, dot: it.dot + 1, origin: it.origin})
+			}
+		}
+		if len(next.items) == 0 {
+			return nil, parser.syntaxError(sets[position], lookahead, fmt.Sprintf("unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme)))
+		}
+		input = append(input, lookahead)
+		sets = append(sets, next)
+	}
+
+	if astMode == "noast" {
+		return nil, nil
+	}
+	builder := &treeBuilder{
+		grammar:   g,
+		sets:      sets,
+		input:     input,
+		astMode:   astMode,
+		splitMemo: map[splitKey][]int{},
+		active:    map[nodeKey]bool{},
+	}
+	root := builder.build(symbol, 0, len(input))
+	if root == nil {
+		return nil, fmt.Errorf("parse error: no derivation found")
+	}
+	return asts.NewAST(root), nil
+}
+
+// closeSet runs prediction and completion over the set at position until no items are added.
+// Nullable nonterminals are stepped over when predicted (Aycock and Horspool), since their
+// completions at this position may already have been processed.
+func (parser *Parser) closeSet(sets []*itemSet, position int) {
+	g := parser.grammar
+	set := sets[position]
+	predicted := map[string]bool{}
+	for i := 0; i < len(set.items); i++ {
+		it := set.items[i]
+		prod := g.Productions[it.prod]
+		if it.dot == len(prod.RHS) {
+			key := spanStart{lhs: prod.LHS, origin: it.origin}
+			set.completed[key] = appendUnique(set.completed[key], it.prod)
+			for _, waiting := range sets[it.origin].items {
+				waitingProd := g.Productions[waiting.prod]
+				if waiting.dot < len(waitingProd.RHS) && !waitingProd.RHS[waiting.dot].Terminal &&
+					waitingProd.RHS[waiting.dot].Name == prod.LHS {
+					set.add(item{prod: waiting.prod, dot: waiting.dot + 1, origin: waiting.origin})
+				}
+			}
+			continue
+		}
+		next := prod.RHS[it.dot]
+		if next.Terminal {
+			continue
+		}
+		if !predicted[next.Name] {
+			predicted[next.Name] = true
+			for _, prodIndex := range g.byLHS[next.Name] {
+				set.add(item{prod: prodIndex, origin: position})
+			}
+		}
+		if g.nullable[next.Name] {
+			set.add(item{prod: it.prod, dot: it.dot + 1, origin: it.origin})
+		}
+	}
+}
+
+// syntaxError returns the ParseError for an unexpected lookahead, expecting the terminals after
+// the dots of the set's items.
+func (parser *Parser) syntaxError(set *itemSet, lookahead *tokens.Token, message string) error {
+	seen := map[string]bool{}
+	expected := make([]string, 0)
+	for _, it := range set.items {
+		prod := parser.grammar.Productions[it.prod]
+		if it.dot < len(prod.RHS) && prod.RHS[it.dot].Terminal && !seen[prod.RHS[it.dot].Name] {
+			seen[prod.RHS[it.dot].Name] = true
+			expected = append(expected, prod.RHS[it.dot].Name)
+		}
+	}
+	sort.Strings(expected)
+	err := parsers.NewParseError(lookahead, "", message)
+	err.Expected = expected
+	return err
+}
+
+func appendUnique(values []int, value int) []int {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+// treeBuilder recovers a derivation from the completed Earley sets, top-down.
+type treeBuilder struct {
+	grammar   *grammar
+	sets      []*itemSet
+	input     []*tokens.Token
+	astMode   string
+	splitMemo map[splitKey][]int
+	// active guards against cyclic derivations such as A ::= A, which are skipped.
+	active map[nodeKey]bool
+}
+
+type nodeKey struct {
+	lhs        string
+	start, end int
+}
+
+type splitKey struct {
+	prod, from int
+	start, end int
+}
+
+// build returns the AST node for nonterminal lhs spanning input[start:end], or nil if there is
+// no acyclic derivation.
+func (builder *treeBuilder) build(lhs string, start, end int) *asts.ASTNode {
+	key := nodeKey{lhs: lhs, start: start, end: end}
+	if builder.active[key] {
+		return nil
+	}
+	builder.active[key] = true
+	defer delete(builder.active, key)
+
+	for _, prodIndex := range builder.grammar.byLHS[lhs] {
+		if !builder.completes(prodIndex, start, end) {
+			continue
+		}
+		ends := builder.split(prodIndex, 0, start, end)
+		if ends == nil {
+			continue
+		}
+		prod := builder.grammar.Productions[prodIndex]
+		rhsNodes := make([]*asts.ASTNode, len(prod.RHS))
+		from := start
+		ok := true
+		for i, sym := range prod.RHS {
+			if sym.Terminal {
+				token := builder.input[from]
+				rhsNodes[i] = asts.NewASTNodeTerminal(token, asts.NodeType(token.Type))
+			} else if rhsNodes[i] = builder.build(sym.Name, from, ends[i]); rhsNodes[i] == nil {
+				ok = false
+				break
+			}
+			from = ends[i]
+		}
+		if ok {
+			return builder.buildNode(prod, rhsNodes)
+		}
+	}
+	return nil
+}
+
+func (builder *treeBuilder) completes(prodIndex, start, end int) bool {
+	key := spanStart{lhs: builder.grammar.Productions[prodIndex].LHS, origin: start}
+	for _, completed := range builder.sets[end].completed[key] {
+		if completed == prodIndex {
+			return true
+		}
+	}
+	return false
+}
+
+// split returns the end positions of prod's right-hand-side symbols from index from onward,
+// given that they span input[start:end], or nil if they cannot. Longer spans are tried first.
+func (builder *treeBuilder) split(prodIndex, from, start, end int) []int {
+	key := splitKey{prod: prodIndex, from: from, start: start, end: end}
+	if ends, ok := builder.splitMemo[key]; ok {
+		return ends
+	}
+	var result []int
+	prod := builder.grammar.Productions[prodIndex]
+	if from == len(prod.RHS) {
+		if start == end {
+			result = []int{}
+		}
+	} else if sym := prod.RHS[from]; sym.Terminal {
+		if start < end && string(builder.input[start].Type) == sym.Name {
+			if rest := builder.split(prodIndex, from+1, start+1, end); rest != nil {
+				result = append([]int{start + 1}, rest...)
+			}
+		}
+	} else {
+		for mid := end; mid >= start && result == nil; mid-- {
+			if len(builder.sets[mid].completed[spanStart{lhs: sym.Name, origin: start}]) == 0 {
+				continue
+			}
+			if rest := builder.split(prodIndex, from+1, mid, end); rest != nil {
+				result = append([]int{mid}, rest...)
+			}
+		}
+	}
+	builder.splitMemo[key] = result
+	return result
+}
+
+// buildNode applies the production's AST hint, as generated parsers do.
+func (builder *treeBuilder) buildNode(prod bnf.Production, rhsNodes []*asts.ASTNode) *asts.ASTNode {
+	lhs := asts.NodeType(prod.LHS)
+	if !builder.grammar.hintMode {
+		return asts.NewASTNode(nil, lhs, rhsNodes)
+	}
+	h := prod.Hint
+	if h == nil || builder.astMode == "fullast" {
+		switch len(rhsNodes) {
+		case 0:
+			return asts.NewASTNode(nil, lhs, []*asts.ASTNode{})
+		case 1:
+			return rhsNodes[0]
+		default:
+			return asts.NewASTNode(nil, lhs, rhsNodes)
+		}
+	}
+	if h.PassthroughIndex != nil {
+		return rhsNodes[*h.PassthroughIndex]
+	}
+
+	var parent *asts.ASTNode
+	var parentToken *tokens.Token
+	parentType := lhs
+	if h.ParentLiteral != nil {
+		parentToken = tokens.NewToken([]rune(*h.ParentLiteral), tokens.TokenType(*h.ParentLiteral), tokens.NewTokenLocation())
+		parentType = asts.NodeType(*h.ParentLiteral)
+	} else {
+		parent = rhsNodes[h.ParentIndex]
+		parentToken = parent.Token
+		parentType = parent.Type
+	}
+	nodeType := asts.NodeType(h.NodeType)
+
+	children := make([]*asts.ASTNode, 0)
+	switch {
+	case len(h.WithAppendedChildren) > 0:
+		if parent != nil {
+			children = append(children, parent.Children...)
+		}
+		for _, index := range h.WithAppendedChildren {
+			children = append(children, rhsNodes[index])
+		}
+	case len(h.WithPrependedChildren) > 0:
+		for _, index := range h.WithPrependedChildren {
+			children = append(children, rhsNodes[index])
+		}
+		if parent != nil {
+			children = append(children, parent.Children...)
+		}
+	case len(h.WithAdoptedGrandchildren) > 0:
+		for _, index := range h.WithAdoptedGrandchildren {
+			children = append(children, rhsNodes[index].Children...)
+		}
+	default:
+		// A plain "children" hint types the node by the production's LHS, not its parent.
+		parentType = lhs
+		children = make([]*asts.ASTNode, len(h.ChildIndices))
+		for i, index := range h.ChildIndices {
+			children[i] = rhsNodes[index]
+		}
+	}
+	if nodeType == "" {
+		nodeType = parentType
+	}
+	return asts.NewASTNode(parentToken, nodeType, children)
+}
@@ -0,0 +1,204 @@
+package earley
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+	"github.com/stretchr/testify/assert"
+)
+
+// sliceLexer returns one token per whitespace-separated field, of that type. A field written
+// type:lexeme sets the lexeme too.
+type sliceLexer struct {
+	fields []string
+}
+
+func (lexer *sliceLexer) Scan() *tokens.Token {
+	if len(lexer.fields) == 0 {
+		return tokens.NewEOFToken(tokens.NewTokenLocation())
+	}
+	field := lexer.fields[0]
+	lexer.fields = lexer.fields[1:]
+	tokenType, lexeme, found := strings.Cut(field, ":")
+	if !found {
+		lexeme = tokenType
+	}
+	return tokens.NewToken([]rune(lexeme), tokens.TokenType(tokenType), tokens.NewTokenLocation())
+}
+
+func newTestParser(t *testing.T, grammarText string) *Parser {
+	t.Helper()
+	ast, err := parsers.NewEBNFParser().Parse(strings.NewReader(grammarText))
+	if err != nil {
+		t.Fatalf("grammar: %v", err)
+	}
+	parser, err := NewParser(ast)
+	if err != nil {
+		t.Fatalf("NewParser: %v", err)
+	}
+	return parser
+}
+
+func parse(t *testing.T, parser *Parser, input string, astMode string) string {
+	t.Helper()
+	ast, err := parser.Parse(&sliceLexer{fields: strings.Fields(input)}, astMode)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", input, err)
+	}
+	return sexpr(ast.RootNode)
+}
+
+// sexpr renders a node as its lexeme if it is a leaf, else as (type children...).
+func sexpr(node *asts.ASTNode) string {
+	if node.Children == nil {
+		return string(node.Token.Lexeme)
+	}
+	parts := []string{string(node.Type)}
+	for _, child := range node.Children {
+		parts = append(parts, sexpr(child))
+	}
+	return "(" + strings.Join(parts, " ") + ")"
+}
+
+func TestParseAmbiguousLeftRecursive(t *testing.T) {
+	parser := newTestParser(t, `
+int ::= "0" ; plus ::= "+" ;
+Root ::= E ;
+E ::= E plus E | int ;
+`)
+	assert.Equal(t, "(Root (E (E (E 1) + (E 2)) + (E 3)))", parse(t, parser, "int:1 plus:+ int:2 plus:+ int:3", ""))
+}
+
+func TestParseRightRecursiveAndRepeat(t *testing.T) {
+	parser := newTestParser(t, `
+a ::= "a" ; b ::= "b" ;
+Root ::= List { b } ;
+List ::= a List | a ;
+`)
+	assert.Equal(t, "(Root (List a (List a (List a))) (__pgpg_repeat_1 b (__pgpg_repeat_1 b (__pgpg_repeat_1))))",
+		parse(t, parser, "a a a b b", ""))
+	assert.Equal(t, "(Root (List a) (__pgpg_repeat_1))", parse(t, parser, "a", ""))
+}
+
+func TestParseNullable(t *testing.T) {
+	parser := newTestParser(t, `
+x ::= "x" ;
+Root ::= A A x A ;
+A ::= [ x ] B ;
+B ::= empty ;
+`)
+	assert.Equal(t, "(Root (A (B)) (A (B)) x (A (B)))", parse(t, parser, "x", ""))
+	assert.Equal(t, "(Root (A x (B)) (A (B)) x (A (B)))", parse(t, parser, "x x", ""))
+}
+
+// The grammar is not LR(k) for any k: whether the first a is an X or a Y depends on the last token.
+func TestParseNonLR(t *testing.T) {
+	parser := newTestParser(t, `
+a ::= "a" ; b ::= "b" ; c ::= "c" ;
+Root ::= X As b | Y As c ;
+X ::= a ;
+Y ::= a ;
+As ::= a As | empty ;
+`)
+	assert.Equal(t, "(Root (X a) (As a (As a (As))) b)", parse(t, parser, "a a a b", ""))
+	assert.Equal(t, "(Root (Y a) (As a (As a (As))) c)", parse(t, parser, "a a a c", ""))
+}
+
+func TestParseHints(t *testing.T) {
+	parser := newTestParser(t, `
+int ::= "0" ; plus ::= "+" ; times ::= "*" ; lparen ::= "(" ; rparen ::= ")" ;
+Root ::= Sum ;
+Sum ::= Sum plus Product -> { "parent": 1, "children": [0, 2] }
+      | Product ;
+Product ::= Product times Term -> { "parent": 1, "children": [0, 2] }
+          | Term ;
+Term ::= lparen Sum rparen -> { "passthrough": 1 }
+       | int ;
+`)
+	assert.Equal(t, "(Sum 1 (Product 2 (Sum 3 4)))", parse(t, parser, "int:1 plus int:2 times lparen int:3 plus int:4 rparen", ""))
+	// As in generated parsers, fullast ignores hints but still collapses single-child productions.
+	assert.Equal(t, "(Sum 1 + 2)", parse(t, parser, "int:1 plus:+ int:2", "fullast"))
+}
+
+func TestParseHintVariants(t *testing.T) {
+	parser := newTestParser(t, `
+id ::= "x" ; comma ::= "," ;
+Root ::= List ;
+List ::= id -> { "parent_literal": "list", "children": [0] }
+       | List comma id -> { "parent": 0, "with_appended_children": [2] } ;
+`)
+	assert.Equal(t, "(List a b c)", parse(t, parser, "id:a comma id:b comma id:c", ""))
+}
+
+func TestParseNoAST(t *testing.T) {
+	parser := newTestParser(t, `a ::= "a" ; Root ::= a ;`)
+	ast, err := parser.Parse(&sliceLexer{fields: []string{"a"}}, "noast")
+	assert.NoError(t, err)
+	assert.Nil(t, ast)
+}
+
+func TestParseErrors(t *testing.T) {
+	parser := newTestParser(t, `a ::= "a" ; b ::= "b" ; Root ::= a b ;`)
+	_, err := parser.Parse(&sliceLexer{fields: []string{"a", "a"}}, "")
+	assert.EqualError(t, err, `line 1, column 1: unexpected a ("a")`)
+	var parseError *parsers.ParseError
+	if assert.ErrorAs(t, err, &parseError) {
+		assert.Equal(t, "a", string(parseError.Token.Type))
+		assert.Equal(t, []string{"b"}, parseError.Expected)
+		assert.Equal(t, -1, parseError.State)
+	}
+	_, err = parser.Parse(&sliceLexer{fields: []string{"a"}}, "")
+	assert.EqualError(t, err, "line 1, column 1: unexpected end of input")
+	_, err = parser.Parse(&sliceLexer{fields: []string{"a", "ERROR:bad"}}, "")
+	assert.EqualError(t, err, "line 1, column 1: bad")
+	var lexError *lexers.LexError
+	assert.ErrorAs(t, err, &lexError)
+}
+
+func TestNewParserErrors(t *testing.T) {
+	for grammarText, expected := range map[string]string{
+		`a ::= "a" ;`:                        "no parser rules found",
+		`Root ::= Missing ;`:                 `rule "Root": undefined rule "Missing"`,
+		`a ::= "a" ; Root ::= a a ;`:         "",
+		`a ::= "a" ; Root ::= a | error a ;`: "",
+		`a ::= "a" ; Root ::= a a | a -> { "parent": 0, "children": [0] } ;`: "production Root ::= a a has 2 RHS symbols but no AST hint; in hint mode, multi-element productions require hints",
+		`a ::= "a" ; Root ::= a a -> { "parent": 2, "children": [0] } ;`:     "production Root: parent index 2 out of range [0, 2)",
+	} {
+		ast, err := parsers.NewEBNFParser().Parse(strings.NewReader(grammarText))
+		if err != nil {
+			t.Fatalf("grammar %q: %v", grammarText, err)
+		}
+		_, err = NewParser(ast)
+		if expected == "" {
+			assert.NoError(t, err, grammarText)
+		} else {
+			assert.EqualError(t, err, expected, grammarText)
+		}
+	}
+}
+
+func TestParseStartSymbols(t *testing.T) {
+	parser := newTestParser(t, `
+%start Statement Expr ;
+int ::= "0" ; plus ::= "+" ; semi ::= ";" ;
+Root ::= Statement Statement ;
+Statement ::= Expr semi ;
+Expr ::= Expr plus int | int ;
+`)
+	assert.Equal(t, "Statement", parser.StartSymbol())
+	assert.Equal(t, "(Statement (Expr (Expr 1) + 2) ;)", parse(t, parser, "int:1 plus:+ int:2 semi:;", ""))
+
+	ast, err := parser.ParseFrom("Expr", &sliceLexer{fields: strings.Fields("int:1 plus:+ int:2")}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "(Expr (Expr 1) + 2)", sexpr(ast.RootNode))
+	ast, err = parser.ParseFrom("Root", &sliceLexer{fields: strings.Fields("int:1 semi:; int:2 semi:;")}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "(Root (Statement (Expr 1) ;) (Statement (Expr 2) ;))", sexpr(ast.RootNode))
+
+	_, err = parser.ParseFrom("int", &sliceLexer{}, "")
+	assert.EqualError(t, err, `parser: "int" is not a parser rule`)
+}
@@ -0,0 +1,55 @@
+package earley
+
+import (
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/bnf"
+)
+
+// grammar is the BNF form of an EBNF grammar, expanded by the bnf package as for parsegen, so
+// that synthetic rules for repeats and the resulting ASTs match those of generated parsers.
+type grammar struct {
+	*bnf.Grammar
+	byLHS    map[string][]int
+	nullable map[string]bool
+	hintMode bool
+}
+
+func newGrammarFromAST(ast *asts.AST) (*grammar, error) {
+	expanded, err := bnf.FromAST(ast)
+	if err != nil {
+		return nil, err
+	}
+	g := &grammar{
+		Grammar:  expanded,
+		byLHS:    map[string][]int{},
+		nullable: map[string]bool{},
+		hintMode: expanded.HintMode(),
+	}
+	for i, prod := range g.Productions {
+		g.byLHS[prod.LHS] = append(g.byLHS[prod.LHS], i)
+	}
+	g.computeNullable()
+	return g, nil
+}
+
+func (g *grammar) computeNullable() {
+	for changed := true; changed; {
+		changed = false
+		for _, prod := range g.Productions {
+			if g.nullable[prod.LHS] {
+				continue
+			}
+			nullable := true
+			for _, sym := range prod.RHS {
+				if sym.Terminal || !g.nullable[sym.Name] {
+					nullable = false
+					break
+				}
+			}
+			if nullable {
+				g.nullable[prod.LHS] = true
+				changed = true
+			}
+		}
+	}
+}
@@ -0,0 +1,378 @@
+// Package glr is a generalized LR (GLR) parser driver. It runs LR tables in which a state and
+// lookahead may have several actions, following all of them using a graph-structured stack,
+// and returns every parse of the input.
+//
+// Grammar-specific tables and AST construction are supplied through the Tables interface,
+// which generated parsers implement.
+package glr
+
+import (
+	"fmt"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+type ActionKind int
+
+const (
+	ActionShift ActionKind = iota
+	ActionReduce
+	ActionAccept
+)
+
+// Action is a shift to state Target, a reduce by production Target, or an accept.
+type Action struct {
+	Kind   ActionKind
+	Target int
+}
+
+// Tables is the grammar-specific part of a GLR parser.
+type Tables interface {
+	// Actions returns all actions for the state and lookahead terminal.
+	Actions(state int, lookahead tokens.TokenType) []Action
+	// Goto returns the state reached from state on the nonterminal lhs.
+	Goto(state int, lhs asts.NodeType) (int, bool)
+	// Production returns the left-hand side and right-hand-side length of a production.
+	Production(prod int) (lhs asts.NodeType, rhsCount int)
+	// BuildNode builds the AST node for a reduction by prod from its right-hand-side nodes.
+	BuildNode(prod int, rhsNodes []*asts.ASTNode) *asts.ASTNode
+}
+
+// SelectFunc is called wherever a nonterminal spans the same input in more than one way, with
+// the alternative AST nodes for that span. It returns the alternatives to keep: for example,
+// one of them to disambiguate, or all of them to keep every parse.
+type SelectFunc func(lhs asts.NodeType, alternatives []*asts.ASTNode) []*asts.ASTNode
+
+// Parser is a GLR parser over Tables.
+type Parser struct {
+	Tables Tables
+	// Select, if non-nil, prunes alternatives at each ambiguity. If nil, all are kept.
+	Select SelectFunc
+	// MaxParses, if positive, bounds the number of parses built for any one span, since a
+	// highly ambiguous input can have exponentially many.
+	MaxParses int
+}
+
+// NewParser returns a GLR parser over the given tables.
+func NewParser(tables Tables) *Parser {
+	return &Parser{Tables: tables}
+}
+
+// forestNode is a node of the shared packed parse forest: a terminal token, or a nonterminal
+// over a span of input with one family per distinct derivation.
+type forestNode struct {
+	token    *tokens.Token
+	lhs      asts.NodeType
+	families []*forestFamily
+}
+
+type forestFamily struct {
+	prod     int
+	children []*forestNode
+}
+
+// gssNode is a node of the graph-structured stack: an LR state at an input position.
+type gssNode struct {
+	state int
+	links []*gssLink
+}
+
+// gssLink connects a stack node to a predecessor, labeled with the forest node for the symbol
+// between them.
+type gssLink struct {
+	head  *gssNode
+	tail  *gssNode
+	value *forestNode
+}
+
+type gssPath struct {
+	end      *gssNode
+	children []*forestNode
+}
+
+// level holds the stack nodes for one input position, at most one per state.
+type level struct {
+	nodes map[int]*gssNode
+	order []*gssNode
+}
+
+func newLevel() *level {
+	return &level{nodes: map[int]*gssNode{}}
+}
+
+func (lvl *level) node(state int) (*gssNode, bool) {
+	node, ok := lvl.nodes[state]
+	if !ok {
+		node = &gssNode{state: state}
+		lvl.nodes[state] = node
+		lvl.order = append(lvl.order, node)
+	}
+	return node, !ok
+}
+
+type pendingReduction struct {
+	node *gssNode
+	prod int
+	// via, if non-nil, restricts the reduction to paths through this link.
+	via *gssLink
+}
+
+// ParseAll parses the lexer's tokens from startState, which is 0 or the state of one of the
+// tables' entry points, and returns every parse, as one AST each.
+func (parser *Parser) ParseAll(startState int, lexer lexers.AbstractLexer) ([]*asts.AST, error) {
+	if lexer == nil {
+		return nil, fmt.Errorf("parser: nil lexer")
+	}
+	if parser.Tables == nil {
+		return nil, fmt.Errorf("parser: nil tables")
+	}
+	current := newLevel()
+	bottom, _ := current.node(startState)
+	for {
+		lookahead := lexer.Scan()
+		if lookahead == nil {
+			return nil, fmt.Errorf("parser: lexer returned nil token")
+		}
+		if lookahead.Type == tokens.TokenTypeError {
+			return nil, lexers.NewLexError(lookahead, "")
+		}
+		accepted, err := parser.reduceAll(current, lookahead, bottom)
+		if err != nil {
+			return nil, err
+		}
+		if lookahead.Type == tokens.TokenTypeEOF {
+			if len(accepted) == 0 {
+				return nil, parsers.NewParseError(lookahead, "", "unexpected end of input")
+			}
+			return parser.buildASTs(accepted), nil
+		}
+		next := parser.shiftAll(current, lookahead)
+		if len(next.order) == 0 {
+			return nil, parsers.NewParseError(lookahead, "", fmt.Sprintf("unexpected %s (%q)", lookahead.Type, string(lookahead.Lexeme)))
+		}
+		current = next
+	}
+}
+
+// reduceAll performs all reductions on the lookahead within a level, adding stack nodes and
+// links as it goes. At end of input it returns the forest nodes of accepted parses: those of
+// the start symbol spanning the whole input, down to the bottom of the stack.
+func (parser *Parser) reduceAll(lvl *level, lookahead *tokens.Token, bottom *gssNode) ([]*forestNode, error) {
+	var pending []pendingReduction
+	var accepted []*forestNode
+	processed := 0
+	enqueueNode := func(node *gssNode, via *gssLink) {
+		for _, action := range parser.Tables.Actions(node.state, lookahead.Type) {
+			if action.Kind != ActionReduce {
+				continue
+			}
+			if _, rhsCount := parser.Tables.Production(action.Target); via != nil && rhsCount == 0 {
+				continue
+			}
+			pending = append(pending, pendingReduction{node: node, prod: action.Target, via: via})
+		}
+	}
+
+	for {
+		for processed < len(lvl.order) {
+			enqueueNode(lvl.order[processed], nil)
+			processed++
+		}
+		if len(pending) == 0 {
+			break
+		}
+		reduction := pending[0]
+		pending = pending[1:]
+		lhs, rhsCount := parser.Tables.Production(reduction.prod)
+		for _, path := range findPaths(reduction.node, rhsCount, reduction.via) {
+			target, ok := parser.Tables.Goto(path.end.state, lhs)
+			if !ok {
+				return nil, fmt.Errorf("parse error: missing goto for %s", lhs)
+			}
+			family := &forestFamily{prod: reduction.prod, children: path.children}
+			head, created := lvl.node(target)
+			if link := findLink(head, path.end); link != nil {
+				link.value.addFamily(family)
+				continue
+			}
+			link := &gssLink{head: head, tail: path.end, value: &forestNode{lhs: lhs, families: []*forestFamily{family}}}
+			head.links = append(head.links, link)
+			if created {
+				continue
+			}
+			// A new link into an existing node opens new reduction paths, for the node itself and
+			// for nodes above it in this level.
+			for _, node := range lvl.order[:processed] {
+				enqueueNode(node, link)
+			}
+		}
+	}
+
+	if lookahead.Type == tokens.TokenTypeEOF {
+		for _, node := range lvl.order {
+			for _, action := range parser.Tables.Actions(node.state, lookahead.Type) {
+				if action.Kind != ActionAccept {
+					continue
+				}
+				for _, link := range node.links {
+					if link.tail == bottom {
+						accepted = append(accepted, link.value)
+					}
+				}
+			}
+		}
+	}
+	return accepted, nil
+}
+
+func (parser *Parser) shiftAll(lvl *level, lookahead *tokens.Token) *level {
+	next := newLevel()
+	value := &forestNode{token: lookahead}
+	for _, node := range lvl.order {
+		for _, action := range parser.Tables.Actions(node.state, lookahead.Type) {
+			if action.Kind != ActionShift {
+				continue
+			}
+			head, _ := next.node(action.Target)
+			if findLink(head, node) == nil {
+				head.links = append(head.links, &gssLink{head: head, tail: node, value: value})
+			}
+		}
+	}
+	return next
+}
+
+// findPaths returns the paths of length n down the stack from node, with the forest nodes along
+// each in left-to-right order. If via is non-nil, only paths through that link are returned.
+func findPaths(node *gssNode, n int, via *gssLink) []gssPath {
+	var paths []gssPath
+	var walk func(current *gssNode, remaining int, children []*forestNode, usedVia bool)
+	walk = func(current *gssNode, remaining int, children []*forestNode, usedVia bool) {
+		if remaining == 0 {
+			if via != nil && !usedVia {
+				return
+			}
+			reversed := make([]*forestNode, len(children))
+			for i, child := range children {
+				reversed[len(children)-1-i] = child
+			}
+			paths = append(paths, gssPath{end: current, children: reversed})
+			return
+		}
+		for _, link := range current.links {
+			walk(link.tail, remaining-1, append(children, link.value), usedVia || link == via)
+		}
+	}
+	walk(node, n, nil, false)
+	return paths
+}
+
+func findLink(head *gssNode, tail *gssNode) *gssLink {
+	for _, link := range head.links {
+		if link.tail == tail {
+			return link
+		}
+	}
+	return nil
+}
+
+// addFamily packs another derivation into a forest node, unless it is already present.
+func (node *forestNode) addFamily(family *forestFamily) {
+	for _, existing := range node.families {
+		if existing.prod != family.prod || len(existing.children) != len(family.children) {
+			continue
+		}
+		same := true
+		for i := range existing.children {
+			if existing.children[i] != family.children[i] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return
+		}
+	}
+	node.families = append(node.families, family)
+}
+
+// buildASTs expands the accepted forest nodes into one AST per parse.
+func (parser *Parser) buildASTs(accepted []*forestNode) []*asts.AST {
+	builder := &forestBuilder{parser: parser, memo: map[*forestNode][]*asts.ASTNode{}, active: map[*forestNode]bool{}}
+	var roots []*asts.ASTNode
+	for _, node := range accepted {
+		roots = append(roots, builder.build(node)...)
+	}
+	if len(accepted) > 1 {
+		roots = builder.selectAlternatives(accepted[0].lhs, roots)
+	}
+	out := make([]*asts.AST, len(roots))
+	for i, root := range roots {
+		out[i] = asts.NewAST(root)
+	}
+	return out
+}
+
+type forestBuilder struct {
+	parser *Parser
+	memo   map[*forestNode][]*asts.ASTNode
+	// active guards against cyclic derivations such as A ::= A.
+	active map[*forestNode]bool
+}
+
+func (builder *forestBuilder) build(node *forestNode) []*asts.ASTNode {
+	if node.token != nil {
+		return []*asts.ASTNode{asts.NewASTNodeTerminal(node.token, asts.NodeType(node.token.Type))}
+	}
+	if alternatives, ok := builder.memo[node]; ok {
+		return alternatives
+	}
+	if builder.active[node] {
+		return nil
+	}
+	builder.active[node] = true
+	defer delete(builder.active, node)
+
+	var alternatives []*asts.ASTNode
+	for _, family := range node.families {
+		combinations := [][]*asts.ASTNode{{}}
+		for _, child := range family.children {
+			childAlternatives := builder.build(child)
+			var next [][]*asts.ASTNode
+			for _, prefix := range combinations {
+				for _, childNode := range childAlternatives {
+					combined := make([]*asts.ASTNode, len(prefix), len(prefix)+1)
+					copy(combined, prefix)
+					next = append(next, append(combined, childNode))
+				}
+			}
+			combinations = limitParses(next, builder.parser.MaxParses)
+		}
+		for _, rhsNodes := range combinations {
+			alternatives = append(alternatives, builder.parser.Tables.BuildNode(family.prod, rhsNodes))
+		}
+		alternatives = limitParses(alternatives, builder.parser.MaxParses)
+	}
+	if len(alternatives) > 1 {
+		alternatives = builder.selectAlternatives(node.lhs, alternatives)
+	}
+	builder.memo[node] = alternatives
+	return alternatives
+}
+
+func (builder *forestBuilder) selectAlternatives(lhs asts.NodeType, alternatives []*asts.ASTNode) []*asts.ASTNode {
+	if builder.parser.Select == nil {
+		return alternatives
+	}
+	return builder.parser.Select(lhs, alternatives)
+}
+
+func limitParses[T any](values []T, max int) []T {
+	if max > 0 && len(values) > max {
+		return values[:max]
+	}
+	return values
+}
@@ -0,0 +1,139 @@
+package glr
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+	"github.com/stretchr/testify/assert"
+)
+
+// sumTables are hand-built LR tables for the ambiguous grammar
+//
+//	S' ::= E ;  E ::= E plus E | int ;
+//
+// with both shift and reduce on plus in the state after "E plus E".
+type sumTables struct{}
+
+var sumActions = map[int]map[tokens.TokenType][]Action{
+	0: {"int": {{Kind: ActionShift, Target: 2}}},
+	1: {"plus": {{Kind: ActionShift, Target: 3}}, tokens.TokenTypeEOF: {{Kind: ActionAccept}}},
+	2: {"plus": {{Kind: ActionReduce, Target: 2}}, tokens.TokenTypeEOF: {{Kind: ActionReduce, Target: 2}}},
+	3: {"int": {{Kind: ActionShift, Target: 2}}},
+	4: {
+		"plus":              {{Kind: ActionShift, Target: 3}, {Kind: ActionReduce, Target: 1}},
+		tokens.TokenTypeEOF: {{Kind: ActionReduce, Target: 1}},
+	},
+}
+
+var sumGotos = map[int]map[asts.NodeType]int{0: {"E": 1}, 3: {"E": 4}}
+
+var sumProductions = []struct {
+	lhs      asts.NodeType
+	rhsCount int
+}{{"S'", 1}, {"E", 3}, {"E", 1}}
+
+func (sumTables) Actions(state int, lookahead tokens.TokenType) []Action {
+	return sumActions[state][lookahead]
+}
+
+func (sumTables) Goto(state int, lhs asts.NodeType) (int, bool) {
+	target, ok := sumGotos[state][lhs]
+	return target, ok
+}
+
+func (sumTables) Production(prod int) (asts.NodeType, int) {
+	return sumProductions[prod].lhs, sumProductions[prod].rhsCount
+}
+
+func (sumTables) BuildNode(prod int, rhsNodes []*asts.ASTNode) *asts.ASTNode {
+	if len(rhsNodes) == 1 {
+		return rhsNodes[0]
+	}
+	return asts.NewASTNode(rhsNodes[1].Token, "sum", []*asts.ASTNode{rhsNodes[0], rhsNodes[2]})
+}
+
+type sliceLexer struct {
+	types []string
+}
+
+func (lexer *sliceLexer) Scan() *tokens.Token {
+	if len(lexer.types) == 0 {
+		return tokens.NewEOFToken(tokens.NewTokenLocation())
+	}
+	tokenType := lexer.types[0]
+	lexer.types = lexer.types[1:]
+	return tokens.NewToken([]rune(tokenType), tokens.TokenType(tokenType), tokens.NewTokenLocation())
+}
+
+func parenthesize(node *asts.ASTNode) string {
+	if len(node.Children) == 0 {
+		return string(node.Token.Lexeme)
+	}
+	return "(" + parenthesize(node.Children[0]) + " + " + parenthesize(node.Children[1]) + ")"
+}
+
+func parseAll(t *testing.T, parser *Parser, input string) []string {
+	t.Helper()
+	trees, err := parser.ParseAll(0, &sliceLexer{types: strings.Fields(input)})
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	var out []string
+	for _, tree := range trees {
+		out = append(out, parenthesize(tree.RootNode))
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestGLRUnambiguous(t *testing.T) {
+	parser := NewParser(sumTables{})
+	assert.Equal(t, []string{"int"}, parseAll(t, parser, "int"))
+	assert.Equal(t, []string{"(int + int)"}, parseAll(t, parser, "int plus int"))
+}
+
+func TestGLRAllParses(t *testing.T) {
+	parser := NewParser(sumTables{})
+	assert.Equal(t, []string{
+		"((int + int) + int)",
+		"(int + (int + int))",
+	}, parseAll(t, parser, "int plus int plus int"))
+	// Catalan number C(3) of ways to bracket four operands.
+	assert.Len(t, parseAll(t, parser, "int plus int plus int plus int"), 5)
+}
+
+func TestGLRSelect(t *testing.T) {
+	parser := NewParser(sumTables{})
+	calls := 0
+	parser.Select = func(lhs asts.NodeType, alternatives []*asts.ASTNode) []*asts.ASTNode {
+		calls++
+		assert.Equal(t, asts.NodeType("E"), lhs)
+		// Prefer left-associative parses: the left operand is the larger subtree.
+		best := alternatives[0]
+		for _, alternative := range alternatives[1:] {
+			if len(alternative.Children[0].Children) > len(best.Children[0].Children) {
+				best = alternative
+			}
+		}
+		return []*asts.ASTNode{best}
+	}
+	assert.Equal(t, []string{"(((int + int) + int) + int)"}, parseAll(t, parser, "int plus int plus int plus int"))
+	assert.Greater(t, calls, 0)
+}
+
+func TestGLRMaxParses(t *testing.T) {
+	parser := NewParser(sumTables{})
+	parser.MaxParses = 1
+	assert.Len(t, parseAll(t, parser, "int plus int plus int plus int"), 1)
+}
+
+func TestGLRSyntaxError(t *testing.T) {
+	parser := NewParser(sumTables{})
+	_, err := parser.ParseAll(0, &sliceLexer{types: []string{"int", "plus"}})
+	assert.Error(t, err)
+	_, err = parser.ParseAll(0, &sliceLexer{types: []string{"int", "int"}})
+	assert.Error(t, err)
+}
@@ -9,3 +9,9 @@ type AbstractLexer interface {
 	// On error, the token-type will be Error, with Lexeme slot having the errortext.
 	Scan() (token *tokens.Token)
 }
+
+// KindSetter is implemented by lexers which can set the Kind of the tokens they scan. Parsers
+// indexing their tables by kind hand such lexers their terminals' Kinds before scanning.
+type KindSetter interface {
+	SetTokenKinds(kinds *tokens.Kinds)
+}
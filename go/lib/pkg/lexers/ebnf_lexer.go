@@ -29,7 +29,16 @@ const (
 	EBNFLexerTypeArrow      tokens.TokenType = "->"
 	EBNFLexerTypeColon      tokens.TokenType = ":"
 	EBNFLexerTypeComma      tokens.TokenType = ","
+	EBNFLexerTypeSlash      tokens.TokenType = "/"
+	EBNFLexerTypeCaret      tokens.TokenType = "^"
+	EBNFLexerTypeTilde      tokens.TokenType = "~"
 	EBNFLexerTypeInteger    tokens.TokenType = "integer"
+	EBNFLexerTypeDirective  tokens.TokenType = "directive"
+	EBNFLexerTypeAction     tokens.TokenType = "action"
+
+	// EBNFLexerTypeCaselessString is a string literal with an "i" suffix, as in "end"i, whose
+	// lexeme includes the suffix.
+	EBNFLexerTypeCaselessString tokens.TokenType = "caseless_string"
 )
 
 // EBNFLexer tokenizes a common EBNF dialect with identifiers, string literals,
@@ -38,7 +47,6 @@ const (
 type EBNFLexer struct {
 	reader        *bufio.Reader
 	tokenLocation *tokens.TokenLocation
-	sourceName    string
 	// One-rune peek for lookahead without consuming.
 	hasPeek bool
 	peekR   rune
@@ -48,11 +56,6 @@ type EBNFLexer struct {
 
 // NewEBNFLexer returns a lexer that reads from r (streaming). For string input use NewEBNFLexerFromString.
 func NewEBNFLexer(r io.Reader) AbstractLexer {
-	return NewEBNFLexerWithSourceName(r, "")
-}
-
-// NewEBNFLexerWithSourceName returns a lexer that reads from r with a source name for error messages.
-func NewEBNFLexerWithSourceName(r io.Reader, sourceName string) AbstractLexer {
 	reader, ok := r.(*bufio.Reader)
 	if !ok {
 		reader = bufio.NewReader(r)
@@ -60,7 +63,6 @@ func NewEBNFLexerWithSourceName(r io.Reader, sourceName string) AbstractLexer {
 	return &EBNFLexer{
 		reader:        reader,
 		tokenLocation: tokens.NewTokenLocation(),
-		sourceName:    sourceName,
 	}
 }
 
@@ -69,9 +71,20 @@ func NewEBNFLexerFromString(s string) AbstractLexer {
 	return NewEBNFLexer(strings.NewReader(s))
 }
 
-// NewEBNFLexerFromStringWithSourceName is like NewEBNFLexerFromString with a source name.
+// NewEBNFLexerWithSourceName returns a lexer that reads from r.
+//
+// Deprecated: error tokens no longer include their location in their text, so the lexer has no
+// use for the source name. Use NewEBNFLexer, and NewLexError to describe error tokens with the
+// source name, as EBNFParser does.
+func NewEBNFLexerWithSourceName(r io.Reader, sourceName string) AbstractLexer {
+	return NewEBNFLexer(r)
+}
+
+// NewEBNFLexerFromStringWithSourceName is like NewEBNFLexerFromString.
+//
+// Deprecated: use NewEBNFLexerFromString, as for NewEBNFLexerWithSourceName.
 func NewEBNFLexerFromStringWithSourceName(s string, sourceName string) AbstractLexer {
-	return NewEBNFLexerWithSourceName(strings.NewReader(s), sourceName)
+	return NewEBNFLexerFromString(s)
 }
 
 func (lexer *EBNFLexer) isAtEOF() bool {
@@ -160,12 +173,8 @@ func (lexer *EBNFLexer) Scan() (token *tokens.Token) {
 		nextRune, nextWidth = lexer.peekRune()
 		if nextRune != '=' {
 			return tokens.NewErrorToken(
-				fmt.Sprintf(
-					"EBNF lexer: expected '::=' but found '::%c' at %s",
-					nextRune,
-					lexer.formatLocation(&startLocation),
-				),
-				lexer.tokenLocation,
+				fmt.Sprintf("EBNF lexer: expected '::=' but found '::%c'", nextRune),
+				&startLocation,
 			)
 		}
 		lexer.tokenLocation.LocateRune(nextRune, nextWidth)
@@ -235,11 +244,47 @@ func (lexer *EBNFLexer) Scan() (token *tokens.Token) {
 		lexer.consumePeek()
 		return tokens.NewToken([]rune{r}, EBNFLexerTypeComma, &startLocation)
 
+	} else if r == '/' {
+		lexer.tokenLocation.LocateRune(r, runeWidth)
+		lexer.consumePeek()
+		return tokens.NewToken([]rune{r}, EBNFLexerTypeSlash, &startLocation)
+
+	} else if r == '^' {
+		lexer.tokenLocation.LocateRune(r, runeWidth)
+		lexer.consumePeek()
+		return tokens.NewToken([]rune{r}, EBNFLexerTypeCaret, &startLocation)
+
+	} else if r == '~' {
+		lexer.tokenLocation.LocateRune(r, runeWidth)
+		lexer.consumePeek()
+		return tokens.NewToken([]rune{r}, EBNFLexerTypeTilde, &startLocation)
+
 	} else if r == '.' {
 		lexer.tokenLocation.LocateRune(r, runeWidth)
 		lexer.consumePeek()
 		return tokens.NewToken([]rune{r}, EBNFLexerTypeDot, &startLocation)
 
+	} else if r == '%' {
+		lexer.tokenLocation.LocateRune(r, runeWidth)
+		lexer.consumePeek()
+		runes := []rune{r}
+		for !lexer.isAtEOF() {
+			nextR, nextW := lexer.peekRune()
+			if !isEBNFIdentifierContinue(nextR) || nextR == '!' {
+				break
+			}
+			lexer.tokenLocation.LocateRune(nextR, nextW)
+			lexer.consumePeek()
+			runes = append(runes, nextR)
+		}
+		if len(runes) == 1 {
+			return tokens.NewErrorToken(
+				"EBNF lexer: expected directive name after '%'",
+				&startLocation,
+			)
+		}
+		return tokens.NewToken(runes, EBNFLexerTypeDirective, &startLocation)
+
 	} else if unicode.IsDigit(r) {
 		lexer.tokenLocation.LocateRune(r, runeWidth)
 		lexer.consumePeek()
@@ -256,6 +301,9 @@ func (lexer *EBNFLexer) Scan() (token *tokens.Token) {
 		}
 		return tokens.NewToken(runes, EBNFLexerTypeInteger, &startLocation)
 
+	} else if r == '<' {
+		return lexer.scanAction(r, runeWidth, &startLocation)
+
 	} else if r == '"' || r == '\'' {
 		return lexer.scanStringLiteral(r, runeWidth, &startLocation)
 
@@ -279,8 +327,8 @@ func (lexer *EBNFLexer) Scan() (token *tokens.Token) {
 
 	} else {
 		return tokens.NewErrorToken(
-			fmt.Sprintf("EBNF lexer: unrecognized token %q (%U) at %s", r, r, lexer.formatLocation(&startLocation)),
-			lexer.tokenLocation,
+			fmt.Sprintf("EBNF lexer: unrecognized token %q (%U)", r, r),
+			&startLocation,
 		)
 	}
 }
@@ -298,8 +346,8 @@ func (lexer *EBNFLexer) scanStringLiteral(
 	for {
 		if lexer.isAtEOF() {
 			return tokens.NewErrorToken(
-				fmt.Sprintf("EBNF lexer: unterminated string literal at %s", lexer.formatLocation(startLocation)),
-				lexer.tokenLocation,
+				"EBNF lexer: unterminated string literal",
+				startLocation,
 			)
 		}
 		r, runeWidth := lexer.peekRune()
@@ -310,8 +358,8 @@ func (lexer *EBNFLexer) scanStringLiteral(
 		if r == '\\' {
 			if lexer.isAtEOF() {
 				return tokens.NewErrorToken(
-					fmt.Sprintf("EBNF lexer: unterminated escape in string literal at %s", lexer.formatLocation(startLocation)),
-					lexer.tokenLocation,
+					"EBNF lexer: unterminated escape in string literal",
+					startLocation,
 				)
 			}
 			r, runeWidth = lexer.peekRune()
@@ -325,9 +373,126 @@ func (lexer *EBNFLexer) scanStringLiteral(
 		}
 	}
 
+	if r, runeWidth := lexer.peekRune(); r == 'i' {
+		lexer.tokenLocation.LocateRune(r, runeWidth)
+		lexer.consumePeek()
+		runes = append(runes, r)
+		if next, _ := lexer.peekRune(); isEBNFIdentifierContinue(next) {
+			return tokens.NewErrorToken(
+				"EBNF lexer: identifier directly after string literal; separate them with a space",
+				startLocation,
+			)
+		}
+		return tokens.NewToken(runes, EBNFLexerTypeCaselessString, startLocation)
+	}
 	return tokens.NewToken(runes, EBNFLexerTypeString, startLocation)
 }
 
+// scanAction scans a semantic action block, "<< code >>". The token's lexeme is the Go code between
+// the delimiters. The block ends at the first ">>" outside the code's string and rune literals,
+// comments, and parentheses, brackets, and braces, so that a right shift in an action must be
+// parenthesized, as in << ($0.(int) >> 1), nil >>.
+func (lexer *EBNFLexer) scanAction(
+	first rune,
+	firstWidth int,
+	startLocation *tokens.TokenLocation,
+) *tokens.Token {
+	lexer.tokenLocation.LocateRune(first, firstWidth)
+	lexer.consumePeek()
+	if lexer.isAtEOF() {
+		return tokens.NewErrorToken("EBNF lexer: expected '<<'", startLocation)
+	}
+	r, runeWidth := lexer.peekRune()
+	if r != '<' {
+		return tokens.NewErrorToken(fmt.Sprintf("EBNF lexer: expected '<<' but found '<%c'", r), startLocation)
+	}
+	lexer.tokenLocation.LocateRune(r, runeWidth)
+	lexer.consumePeek()
+
+	runes := make([]rune, 0, ebnfLexerInitialCapacity)
+	depth := 0
+	for {
+		if lexer.isAtEOF() {
+			return tokens.NewErrorToken(
+				"EBNF lexer: unterminated action block",
+				startLocation,
+			)
+		}
+		r := lexer.readRune()
+		runes = append(runes, r)
+		switch r {
+		case '"', '\'', '`':
+			var ok bool
+			if runes, ok = lexer.scanActionLiteral(r, runes); !ok {
+				return tokens.NewErrorToken(
+					"EBNF lexer: unterminated literal in action block",
+					startLocation,
+				)
+			}
+		case '/':
+			if next, _ := lexer.peekRune(); next == '/' || next == '*' {
+				var ok bool
+				if runes, ok = lexer.scanActionComment(lexer.readRune(), runes); !ok {
+					return tokens.NewErrorToken(
+						"EBNF lexer: unterminated comment in action block",
+						startLocation,
+					)
+				}
+			}
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth > 0 {
+				depth--
+			}
+		case '>':
+			if next, _ := lexer.peekRune(); next == '>' && depth == 0 {
+				lexer.readRune()
+				return tokens.NewToken(runes[:len(runes)-1], EBNFLexerTypeAction, startLocation)
+			}
+		}
+	}
+}
+
+// scanActionLiteral appends to runes the rest of a Go string or rune literal in an action block,
+// after its opening quote, and tells whether the literal is terminated.
+func (lexer *EBNFLexer) scanActionLiteral(quote rune, runes []rune) ([]rune, bool) {
+	for !lexer.isAtEOF() {
+		r := lexer.readRune()
+		runes = append(runes, r)
+		switch {
+		case r == quote:
+			return runes, true
+		case r == '\\' && quote != '`' && !lexer.isAtEOF():
+			runes = append(runes, lexer.readRune())
+		case r == '\n' && quote != '`':
+			return runes, false
+		}
+	}
+	return runes, false
+}
+
+// scanActionComment appends to runes the rest of a Go comment in an action block, after its
+// opening "/", and tells whether the comment is terminated. A line comment ends at the end of its
+// line, which must be in the block.
+func (lexer *EBNFLexer) scanActionComment(second rune, runes []rune) ([]rune, bool) {
+	runes = append(runes, second)
+	for !lexer.isAtEOF() {
+		r := lexer.readRune()
+		runes = append(runes, r)
+		if second == '/' && r == '\n' {
+			return runes, true
+		}
+		if second == '*' && r == '*' {
+			if next, _ := lexer.peekRune(); next == '/' {
+				runes = append(runes, lexer.readRune())
+				return runes, true
+			}
+		}
+	}
+	return runes, false
+}
+
 func (lexer *EBNFLexer) ignoreNextRuneIf(predicate RunePredicateFunc) bool {
 	if lexer.isAtEOF() {
 		return false
@@ -358,21 +523,6 @@ func (lexer *EBNFLexer) readRune() rune {
 	return r
 }
 
-func (lexer *EBNFLexer) formatLocation(location *tokens.TokenLocation) string {
-	if location == nil {
-		return "unknown location"
-	}
-	if lexer.sourceName != "" {
-		return fmt.Sprintf(
-			"%s, line %d, column %d",
-			lexer.sourceName,
-			location.LineNumber,
-			location.ColumnNumber,
-		)
-	}
-	return fmt.Sprintf("line %d, column %d", location.LineNumber, location.ColumnNumber)
-}
-
 func isEBNFIdentifierStart(r rune) bool {
 	return r == '!' || r == '_' || (r >= 'A' && r <= 'Z') || (r >= 'a' && r <= 'z')
 }
@@ -1,6 +1,7 @@
 package lexers
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
@@ -52,6 +53,36 @@ func TestEBNFLexerTableDriven(t *testing.T) {
 				{"", tokens.TokenTypeEOF},
 			},
 		},
+		{
+			name:  "line anchor and trailing context",
+			input: `x ::= ^ "a" / "b" ;`,
+			want: []ebnfExpectedToken{
+				{"x", EBNFLexerTypeIdentifier},
+				{"::=", EBNFLexerTypeAssign},
+				{"^", EBNFLexerTypeCaret},
+				{`"a"`, EBNFLexerTypeString},
+				{"/", EBNFLexerTypeSlash},
+				{`"b"`, EBNFLexerTypeString},
+				{";", EBNFLexerTypeSemicolon},
+				{"", tokens.TokenTypeEOF},
+			},
+		},
+		{
+			name:  "character set difference and complement",
+			input: `x ::= . - "a" | ~ "b" ;`,
+			want: []ebnfExpectedToken{
+				{"x", EBNFLexerTypeIdentifier},
+				{"::=", EBNFLexerTypeAssign},
+				{".", EBNFLexerTypeDot},
+				{"-", EBNFLexerTypeDash},
+				{`"a"`, EBNFLexerTypeString},
+				{"|", EBNFLexerTypeOr},
+				{"~", EBNFLexerTypeTilde},
+				{`"b"`, EBNFLexerTypeString},
+				{";", EBNFLexerTypeSemicolon},
+				{"", tokens.TokenTypeEOF},
+			},
+		},
 		{
 			name:  "standalone colon",
 			input: "x :=",
@@ -157,6 +188,18 @@ func TestEBNFLexer1(t *testing.T) {
 	assert.True(t, token.IsEOF())
 }
 
+func TestEBNFLexerWithSourceName(t *testing.T) {
+	lexer := NewEBNFLexerFromStringWithSourceName("a ::= $", "test.bnf")
+	lexer.Scan()
+	lexer.Scan()
+	token := lexer.Scan()
+	assert.True(t, token.IsError())
+	assert.EqualError(t, NewLexError(token, "test.bnf"), `test.bnf, line 1, column 7: EBNF lexer: unrecognized token '$' (U+0024)`)
+
+	token = NewEBNFLexerWithSourceName(strings.NewReader("a"), "test.bnf").Scan()
+	assert.Equal(t, "a", token.LexemeText())
+}
+
 func TestEBNFLexer2(t *testing.T) {
 	lexer := NewEBNFLexerFromString("rule ::= \"a\" | 'b' ;")
 
@@ -326,3 +369,101 @@ func TestEBNFLexerCommaAndIntegers(t *testing.T) {
 	token = lexer.Scan()
 	assert.True(t, token.IsEOF())
 }
+
+func TestEBNFLexerDirective(t *testing.T) {
+	lexer := NewEBNFLexerFromString("%left plus ;")
+
+	token := lexer.Scan()
+	assert.Equal(t, "%left", token.LexemeText())
+	assert.Equal(t, EBNFLexerTypeDirective, token.Type)
+
+	token = lexer.Scan()
+	assert.Equal(t, "plus", token.LexemeText())
+	assert.Equal(t, EBNFLexerTypeIdentifier, token.Type)
+
+	token = lexer.Scan()
+	assert.Equal(t, EBNFLexerTypeSemicolon, token.Type)
+
+	token = lexer.Scan()
+	assert.True(t, token.IsEOF())
+}
+
+func TestEBNFLexerDirectiveWithoutName(t *testing.T) {
+	lexer := NewEBNFLexerFromString("% left")
+
+	token := lexer.Scan()
+	assert.True(t, token.IsError())
+}
+
+func TestEBNFLexerAction(t *testing.T) {
+	lexer := NewEBNFLexerFromString("A ::= b << f($0) > 1, nil >> ;")
+
+	token := lexer.Scan()
+	assert.Equal(t, "A", token.LexemeText())
+	token = lexer.Scan()
+	assert.Equal(t, EBNFLexerTypeAssign, token.Type)
+	token = lexer.Scan()
+	assert.Equal(t, "b", token.LexemeText())
+
+	token = lexer.Scan()
+	assert.Equal(t, EBNFLexerTypeAction, token.Type)
+	assert.Equal(t, " f($0) > 1, nil ", token.LexemeText())
+
+	token = lexer.Scan()
+	assert.Equal(t, EBNFLexerTypeSemicolon, token.Type)
+	token = lexer.Scan()
+	assert.True(t, token.IsEOF())
+}
+
+func TestEBNFLexerActionGoSyntax(t *testing.T) {
+	for input, expected := range map[string]string{
+		`<< fmt.Sprintf(">>%d", $0) >>`:       ` fmt.Sprintf(">>%d", $0) `,
+		"<< `a >> b`, nil >>":                 " `a >> b`, nil ",
+		`<< '>', nil >> ;`:                    ` '>', nil `,
+		`<< "\">>", nil >>`:                   ` "\">>", nil `,
+		`<< ($0.(uint) >> $2.(uint)), nil >>`: ` ($0.(uint) >> $2.(uint)), nil `,
+		`<< m[x>>1] /* >> */, nil >>`:         ` m[x>>1] /* >> */, nil `,
+		"<< x, nil // >>\n >>":                " x, nil // >>\n ",
+	} {
+		token := NewEBNFLexerFromString(input).Scan()
+		assert.Equal(t, EBNFLexerTypeAction, token.Type, input)
+		assert.Equal(t, expected, token.LexemeText(), input)
+	}
+}
+
+func TestEBNFLexerActionErrors(t *testing.T) {
+	token := NewEBNFLexerFromString("< x").Scan()
+	assert.True(t, token.IsError())
+
+	token = NewEBNFLexerFromString("<< x > y").Scan()
+	assert.True(t, token.IsError())
+
+	token = NewEBNFLexerFromString(`<< "x >>`).Scan()
+	assert.True(t, token.IsError())
+	assert.Equal(t, "EBNF lexer: unterminated literal in action block", token.LexemeText())
+
+	token = NewEBNFLexerFromString(`<< x /* >>`).Scan()
+	assert.True(t, token.IsError())
+	assert.Equal(t, "EBNF lexer: unterminated comment in action block", token.LexemeText())
+}
+
+func TestEBNFLexerCaselessString(t *testing.T) {
+	lexer := NewEBNFLexerFromString(`end ::= "end"i 'x'i "y" i;`)
+
+	for _, want := range []ebnfExpectedToken{
+		{"end", EBNFLexerTypeIdentifier},
+		{"::=", EBNFLexerTypeAssign},
+		{`"end"i`, EBNFLexerTypeCaselessString},
+		{`'x'i`, EBNFLexerTypeCaselessString},
+		{`"y"`, EBNFLexerTypeString},
+		{"i", EBNFLexerTypeIdentifier},
+		{";", EBNFLexerTypeSemicolon},
+	} {
+		token := lexer.Scan()
+		assert.Equal(t, want.lexeme, token.LexemeText())
+		assert.Equal(t, want.typ, token.Type)
+	}
+
+	token := NewEBNFLexerFromString(`"a"if`).Scan()
+	assert.True(t, token.IsError())
+}
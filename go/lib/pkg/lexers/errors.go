@@ -0,0 +1,29 @@
+package lexers
+
+import (
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+// LexError is a lexer error: input which the lexer could not make a token of. Lexers report
+// these as tokens of type tokens.TokenTypeError holding the message, and parsers return them
+// as LexErrors, so that callers can get at the location with errors.As.
+type LexError struct {
+	// SourceName names the input, such as a file name, or is empty.
+	SourceName string
+	Location   tokens.TokenLocation
+	// Message is the lexer's description of the error, without its location.
+	Message string
+}
+
+// NewLexError returns the LexError for an error token.
+func NewLexError(token *tokens.Token, sourceName string) *LexError {
+	return &LexError{
+		SourceName: sourceName,
+		Location:   token.Location,
+		Message:    string(token.Lexeme),
+	}
+}
+
+func (err *LexError) Error() string {
+	return err.Location.Describe(err.SourceName) + ": " + err.Message
+}
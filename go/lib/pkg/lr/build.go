@@ -0,0 +1,102 @@
+package lr
+
+import (
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+// buildNode builds the AST node for a reduction by prod, from the nodes of its right-hand side.
+// Productions' hints are applied if the tables are in hint mode and astMode is not "fullast".
+func (tables *Tables) buildNode(prod *Production, rhsNodes []*asts.ASTNode, astMode string) *asts.ASTNode {
+	if tables.HintMode != "hints" {
+		if prod.RHSCount == 0 {
+			rhsNodes = []*asts.ASTNode{}
+		}
+		return asts.NewASTNode(nil, prod.LHS, rhsNodes)
+	}
+	hint := prod.Hint
+	if astMode == "fullast" {
+		hint = nil
+	}
+	switch {
+	case hint != nil && hint.Passthrough:
+		return rhsNodes[hint.PassthroughIndex]
+	case hint != nil && len(hint.WithAppendedChildren) > 0:
+		parent, parentToken, nodeType := hintParent(hint, rhsNodes)
+		newChildren := make([]*asts.ASTNode, 0)
+		if parent != nil && parent.Children != nil {
+			newChildren = append(newChildren, parent.Children...)
+		}
+		for _, ci := range hint.WithAppendedChildren {
+			newChildren = append(newChildren, rhsNodes[ci])
+		}
+		return asts.NewASTNode(parentToken, nodeType, newChildren)
+	case hint != nil && len(hint.WithPrependedChildren) > 0:
+		parent, parentToken, nodeType := hintParent(hint, rhsNodes)
+		newChildren := make([]*asts.ASTNode, 0)
+		for _, ci := range hint.WithPrependedChildren {
+			newChildren = append(newChildren, rhsNodes[ci])
+		}
+		if parent != nil && parent.Children != nil {
+			newChildren = append(newChildren, parent.Children...)
+		}
+		return asts.NewASTNode(parentToken, nodeType, newChildren)
+	case hint != nil && len(hint.WithAdoptedGrandchildren) > 0:
+		_, parentToken, nodeType := hintParent(hint, rhsNodes)
+		newChildren := make([]*asts.ASTNode, 0)
+		for _, ci := range hint.WithAdoptedGrandchildren {
+			childNode := rhsNodes[ci]
+			if childNode != nil && childNode.Children != nil {
+				newChildren = append(newChildren, childNode.Children...)
+			}
+		}
+		return asts.NewASTNode(parentToken, nodeType, newChildren)
+	case hint != nil:
+		nodeType := hint.NodeType
+		if nodeType == "" {
+			nodeType = prod.LHS
+		}
+		var parentToken *tokens.Token
+		if hint.HasParentLiteral {
+			parentToken = literalToken(hint.ParentLiteral)
+		} else if hint.ParentIndex >= 0 && hint.ParentIndex < len(rhsNodes) {
+			parentToken = rhsNodes[hint.ParentIndex].Token
+		}
+		hintChildren := make([]*asts.ASTNode, len(hint.ChildIndices))
+		for i, ci := range hint.ChildIndices {
+			hintChildren[i] = rhsNodes[ci]
+		}
+		return asts.NewASTNode(parentToken, nodeType, hintChildren)
+	case prod.RHSCount == 1:
+		return rhsNodes[0]
+	case prod.RHSCount == 0:
+		return asts.NewASTNode(nil, prod.LHS, []*asts.ASTNode{})
+	default:
+		return asts.NewASTNode(nil, prod.LHS, rhsNodes)
+	}
+}
+
+// hintParent returns the parent node of a hint with appended, prepended, or adopted children, or
+// nil if the hint gives a parent literal, with the new node's token and type.
+func hintParent(hint *Hint, rhsNodes []*asts.ASTNode) (*asts.ASTNode, *tokens.Token, asts.NodeType) {
+	var parent *asts.ASTNode
+	var parentToken *tokens.Token
+	var parentType asts.NodeType
+	if hint.HasParentLiteral {
+		parentToken = literalToken(hint.ParentLiteral)
+		parentType = asts.NodeType(hint.ParentLiteral)
+	} else {
+		parent = rhsNodes[hint.ParentIndex]
+		parentToken = parent.Token
+		parentType = parent.Type
+	}
+	nodeType := hint.NodeType
+	if nodeType == "" {
+		nodeType = parentType
+	}
+	return parent, parentToken, nodeType
+}
+
+func literalToken(literal string) *tokens.Token {
+	return tokens.NewToken([]rune(literal), tokens.TokenType(literal), tokens.NewTokenLocation())
+}
@@ -0,0 +1,82 @@
+package lr
+
+import (
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/glr"
+	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+// ParseAll parses with a GLR driver, following every action of the tables' conflicting entries,
+// and returns all parses. Parse and ParseOne instead take each conflict's default resolution. For
+// tables without conflicting entries, as from parsegen-tables without -glr, there is one parse.
+func (parser *Parser) ParseAll(lexer liblexers.AbstractLexer, astMode string) ([]*asts.AST, error) {
+	return parser.ParseAllFrom("", lexer, astMode)
+}
+
+// ParseAllFrom is ParseAll for input derived from symbol, one of the grammar's start symbols, as
+// ParseFrom is for Parse.
+func (parser *Parser) ParseAllFrom(symbol string, lexer liblexers.AbstractLexer, astMode string) ([]*asts.AST, error) {
+	startState, err := parser.Tables.startState(symbol)
+	if err != nil {
+		return nil, err
+	}
+	glrParser := glr.NewParser(&glrTables{tables: parser.Tables, astMode: astMode})
+	glrParser.Select = parser.Select
+	glrParser.MaxParses = parser.MaxParses
+	return glrParser.ParseAll(startState, lexer)
+}
+
+// glrTables adapts the tables to the GLR driver.
+type glrTables struct {
+	tables  *Tables
+	astMode string
+}
+
+func (adapter *glrTables) Actions(state int, lookahead tokens.TokenType) []glr.Action {
+	if actions, ok := adapter.tables.ConflictActions[state][lookahead]; ok {
+		glrActions := make([]glr.Action, 0, len(actions))
+		for _, action := range actions {
+			if glrAction, ok := toGLRAction(action); ok {
+				glrActions = append(glrActions, glrAction)
+			}
+		}
+		return glrActions
+	}
+	action, ok := adapter.tables.Action(state, lookahead)
+	if !ok {
+		return nil
+	}
+	if glrAction, ok := toGLRAction(action); ok {
+		return []glr.Action{glrAction}
+	}
+	return nil
+}
+
+func toGLRAction(action Action) (glr.Action, bool) {
+	switch action.Kind {
+	case ActionShift:
+		return glr.Action{Kind: glr.ActionShift, Target: action.Target}, true
+	case ActionReduce:
+		return glr.Action{Kind: glr.ActionReduce, Target: action.Target}, true
+	case ActionAccept:
+		return glr.Action{Kind: glr.ActionAccept}, true
+	default:
+		return glr.Action{}, false
+	}
+}
+
+func (adapter *glrTables) Goto(state int, lhs asts.NodeType) (int, bool) {
+	return adapter.tables.Goto(state, lhs)
+}
+
+func (adapter *glrTables) Production(prod int) (asts.NodeType, int) {
+	return adapter.tables.Productions[prod].LHS, adapter.tables.Productions[prod].RHSCount
+}
+
+func (adapter *glrTables) BuildNode(prod int, rhsNodes []*asts.ASTNode) *asts.ASTNode {
+	if adapter.astMode == "noast" {
+		return noASTSentinel
+	}
+	return adapter.tables.buildNode(&adapter.tables.Productions[prod], rhsNodes, adapter.astMode)
+}
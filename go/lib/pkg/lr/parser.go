@@ -0,0 +1,400 @@
+package lr
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/glr"
+	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/repair"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+// Parser runs LR tables. Generated parsers embed it, with their own tables.
+type Parser struct {
+	Tables *Tables
+	Trace  *TraceHooks
+	// SourceName, if set, names the input, such as a file name, in errors.
+	SourceName string
+	// Select, if non-nil, is called by ParseAll at each ambiguity to choose among alternatives.
+	Select glr.SelectFunc
+	// MaxParses, if positive, bounds the number of parses ParseAll builds for any one span.
+	MaxParses int
+	// RepairErrors, if set, makes each syntax error try single-token repairs of the input: inserting,
+	// deleting, or replacing a token. The repair found is reported with the error, and made, and
+	// parsing continues.
+	RepairErrors bool
+	// MaxErrors, if positive, stops parsing at that many syntax errors.
+	MaxErrors int
+	// Errors holds the syntax errors of the last Parse or ParseOne call, including those repaired
+	// or recovered from using the grammar's error productions.
+	Errors []error
+	// recovering counts down the tokens to shift after an error before reporting another.
+	recovering int
+	// pending holds tokens read ahead of the lookahead, to be scanned before the lexer's next.
+	pending []*tokens.Token
+}
+
+// NewParser returns a parser running the given tables.
+func NewParser(tables *Tables) *Parser {
+	return &Parser{Tables: tables}
+}
+
+// noASTSentinel is used as a placeholder on the node stack when astMode == "noast".
+var noASTSentinel = &asts.ASTNode{}
+
+// Parse parses input derived from the tables' start symbol, or from the first start symbol if the
+// grammar has several.
+func (parser *Parser) Parse(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {
+	return parser.ParseFrom("", lexer, astMode)
+}
+
+// ParseFrom parses input derived from symbol, one of the grammar's start symbols.
+func (parser *Parser) ParseFrom(symbol string, lexer liblexers.AbstractLexer, astMode string) (*asts.AST, error) {
+	startState, err := parser.Tables.startState(symbol)
+	if err != nil {
+		return nil, err
+	}
+	parser.pending = nil
+	ast, _, err := parser.run(startState, lexer, astMode, false)
+	return ast, err
+}
+
+// ParseOne parses one record from the lexer. It is for multi-object input, as declared by the
+// grammar's %records directive: call in a loop until done. It returns (ast, true, nil) on EOF after
+// a record, (ast, false, nil) when more input follows, or (nil, false, err) on error. A separator
+// after each record is consumed; a trailing separator before EOF is allowed.
+func (parser *Parser) ParseOne(lexer liblexers.AbstractLexer, astMode string) (*asts.AST, bool, error) {
+	return parser.run(0, lexer, astMode, true)
+}
+
+// run parses from startState. Unless multi is set, input with more than one record is an error.
+func (parser *Parser) run(startState int, lexer liblexers.AbstractLexer, astMode string, multi bool) (*asts.AST, bool, error) {
+	if lexer == nil {
+		return nil, false, fmt.Errorf("parser: nil lexer")
+	}
+	stateStack := []int{startState}
+	nodeStack := []*asts.ASTNode{}
+	parser.Errors = nil
+	parser.recovering = 0
+	parser.setTokenKinds(lexer)
+	kinds := parser.Tables.Terminals
+	lookahead := parser.scan(lexer)
+	for {
+		if lookahead == nil {
+			return nil, false, fmt.Errorf("parser: lexer returned nil token")
+		}
+		if lookahead.Type == tokens.TokenTypeError {
+			return nil, false, liblexers.NewLexError(lookahead, parser.SourceName)
+		}
+		state := stateStack[len(stateStack)-1]
+		action, ok := parser.Tables.action(state, kinds.Of(lookahead))
+		if !ok {
+			var err error
+			stateStack, nodeStack, lookahead, err = parser.handleSyntaxError(lexer, stateStack, nodeStack, lookahead, astMode)
+			if err != nil {
+				return nil, false, err
+			}
+			continue
+		}
+		if parser.Trace != nil && parser.Trace.OnAction != nil {
+			parser.Trace.OnAction(state, action, lookahead)
+		}
+		switch action.Kind {
+		case ActionShift:
+			if astMode == "noast" {
+				nodeStack = append(nodeStack, noASTSentinel)
+			} else {
+				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType(lookahead.Type)))
+			}
+			stateStack = append(stateStack, action.Target)
+			if parser.recovering > 0 {
+				parser.recovering--
+			}
+			lookahead = parser.scan(lexer)
+			if parser.Trace != nil && parser.Trace.OnStack != nil {
+				parser.Trace.OnStack(stateStack, nodeStack)
+			}
+		case ActionReduce:
+			prod := &parser.Tables.Productions[action.Target]
+			rhsNodes := make([]*asts.ASTNode, prod.RHSCount)
+			for i := prod.RHSCount - 1; i >= 0; i-- {
+				stateStack = stateStack[:len(stateStack)-1]
+				rhsNodes[i] = nodeStack[len(nodeStack)-1]
+				nodeStack = nodeStack[:len(nodeStack)-1]
+			}
+			if astMode == "noast" {
+				nodeStack = append(nodeStack, noASTSentinel)
+			} else {
+				nodeStack = append(nodeStack, parser.Tables.buildNode(prod, rhsNodes, astMode))
+			}
+			state = stateStack[len(stateStack)-1]
+			nextState, ok := parser.Tables.gotoState(state, prod.LHSID)
+			if !ok {
+				return nil, false, fmt.Errorf("parse error: missing goto for %s", prod.LHS)
+			}
+			stateStack = append(stateStack, nextState)
+			if parser.Trace != nil && parser.Trace.OnStack != nil {
+				parser.Trace.OnStack(stateStack, nodeStack)
+			}
+		case ActionAccept:
+			return parser.accept(stateStack, nodeStack, astMode, true)
+		case ActionAcceptAndYield:
+			if !multi {
+				return nil, false, fmt.Errorf("parse error: multiple objects; use ParseOne for multi-object input")
+			}
+			separator := parser.Tables.RecordSeparator
+			if separator != "" && lookahead.Type == separator {
+				lookahead = parser.scan(lexer)
+				if lookahead != nil && lookahead.Type == tokens.TokenTypeEOF {
+					return parser.accept(stateStack, nodeStack, astMode, true)
+				}
+			}
+			parser.pending = append([]*tokens.Token{lookahead}, parser.pending...)
+			return parser.accept(stateStack, nodeStack, astMode, false)
+		default:
+			return nil, false, fmt.Errorf("parse error: no action")
+		}
+	}
+}
+
+// accept returns the AST on the node stack, with any syntax errors repaired or recovered from.
+func (parser *Parser) accept(stateStack []int, nodeStack []*asts.ASTNode, astMode string, done bool) (*asts.AST, bool, error) {
+	if len(nodeStack) != 1 {
+		return nil, false, fmt.Errorf("parse error: unexpected parse stack size %d", len(nodeStack))
+	}
+	if parser.Trace != nil && parser.Trace.OnStack != nil {
+		parser.Trace.OnStack(stateStack, nodeStack)
+	}
+	if astMode == "noast" {
+		return nil, done, errors.Join(parser.Errors...)
+	}
+	return asts.NewAST(nodeStack[0]), done, errors.Join(parser.Errors...)
+}
+
+// setTokenKinds hands the lexer the tables' terminal kinds, if it can set tokens' Kind.
+func (parser *Parser) setTokenKinds(lexer liblexers.AbstractLexer) {
+	if setter, ok := lexer.(liblexers.KindSetter); ok {
+		setter.SetTokenKinds(parser.Tables.Terminals)
+	}
+}
+
+// scan returns the next token: the first of those read ahead, if any, else the lexer's next.
+func (parser *Parser) scan(lexer liblexers.AbstractLexer) *tokens.Token {
+	var token *tokens.Token
+	if len(parser.pending) > 0 {
+		token = parser.pending[0]
+		parser.pending = parser.pending[1:]
+	} else {
+		token = lexer.Scan()
+	}
+	if parser.Trace != nil && parser.Trace.OnToken != nil {
+		parser.Trace.OnToken(token)
+	}
+	return token
+}
+
+// upcomingTokens returns the lookahead followed by up to repair.CheckDistance more tokens, read
+// ahead into parser.pending. It stops early at EOF or a lexer error.
+func (parser *Parser) upcomingTokens(lexer liblexers.AbstractLexer, lookahead *tokens.Token) []*tokens.Token {
+	upcoming := []*tokens.Token{lookahead}
+	for i := 0; i < repair.CheckDistance && upcoming[i].Type != tokens.TokenTypeEOF; i++ {
+		if i == len(parser.pending) {
+			parser.pending = append(parser.pending, lexer.Scan())
+		}
+		next := parser.pending[i]
+		if next == nil || next.Type == tokens.TokenTypeError {
+			break
+		}
+		upcoming = append(upcoming, next)
+	}
+	return upcoming
+}
+
+// findRepair records the syntax error err, and returns the repair of the input to make, if
+// RepairErrors is set and one is found. It returns an error listing all syntax errors if
+// MaxErrors is reached.
+func (parser *Parser) findRepair(
+	lexer liblexers.AbstractLexer,
+	stateStack []int,
+	lookahead *tokens.Token,
+	err error,
+) (*repair.Repair, error) {
+	if !parser.RepairErrors {
+		return nil, nil
+	}
+	found := repair.Find(repairTables{parser.Tables}, stateStack, parser.upcomingTokens(lexer, lookahead))
+	if found == nil {
+		return nil, nil
+	}
+	parser.Errors = append(parser.Errors, fmt.Errorf("%w; %s", err, found.Describe(parser.Tables.DisplayName)))
+	if parser.MaxErrors > 0 && len(parser.Errors) >= parser.MaxErrors {
+		return nil, errors.Join(parser.Errors...)
+	}
+	return found, nil
+}
+
+// handleSyntaxError handles a syntax error at lookahead, recording it in parser.Errors. If
+// RepairErrors is set and a repair is found, the repair is made and parsing continues. Otherwise
+// the parser recovers using the grammar's error productions, if any. It returns the updated
+// stacks and lookahead, or all syntax errors if parsing stops.
+func (parser *Parser) handleSyntaxError(
+	lexer liblexers.AbstractLexer,
+	stateStack []int,
+	nodeStack []*asts.ASTNode,
+	lookahead *tokens.Token,
+	astMode string,
+) ([]int, []*asts.ASTNode, *tokens.Token, error) {
+	err := parser.syntaxError(stateStack[len(stateStack)-1], lookahead)
+	if parser.recovering > 0 {
+		return parser.recoverFromError(lexer, stateStack, nodeStack, lookahead, astMode, err)
+	}
+	found, stop := parser.findRepair(lexer, stateStack, lookahead, err)
+	if stop != nil {
+		return nil, nil, nil, stop
+	}
+	if found != nil {
+		return stateStack, nodeStack, parser.applyRepair(lexer, found), nil
+	}
+	return parser.recoverFromError(lexer, stateStack, nodeStack, lookahead, astMode, err)
+}
+
+// syntaxError returns the ParseError for lookahead in state, describing the unexpected token and
+// the terminals which the state has actions for, by display name, unless the grammar's messages
+// file gives a message for the state.
+func (parser *Parser) syntaxError(state int, lookahead *tokens.Token) error {
+	unexpected := parser.Tables.DisplayName(lookahead.Type)
+	// The lexeme is left out where the name already shows it, as for '+' or a literal terminal.
+	if lookahead.Type != tokens.TokenTypeEOF && strings.Trim(unexpected, `'"`) != string(lookahead.Lexeme) {
+		unexpected = fmt.Sprintf("%s %q", unexpected, string(lookahead.Lexeme))
+	}
+	lookaheads := parser.Tables.Lookaheads(state)
+	expected := make([]string, 0, len(lookaheads))
+	for _, terminal := range lookaheads {
+		if terminal != tokens.TokenType("error") {
+			expected = append(expected, parser.Tables.DisplayName(terminal))
+		}
+	}
+	sort.Strings(expected)
+	message := "unexpected " + unexpected
+	switch len(expected) {
+	case 0:
+	case 1:
+		message += "; expected " + expected[0]
+	default:
+		message += "; expected one of " + strings.Join(expected, ", ")
+	}
+	if custom, ok := parser.Tables.ErrorMessages[state]; ok {
+		message = custom
+	}
+	return &libparsers.ParseError{
+		SourceName: parser.SourceName,
+		Location:   lookahead.Location,
+		Token:      lookahead,
+		Expected:   expected,
+		State:      state,
+		Message:    message,
+	}
+}
+
+// applyRepair makes the repair to the input at the lookahead, and returns the new lookahead.
+func (parser *Parser) applyRepair(lexer liblexers.AbstractLexer, found *repair.Repair) *tokens.Token {
+	switch found.Kind {
+	case repair.Insert:
+		parser.pending = append([]*tokens.Token{found.Original}, parser.pending...)
+	case repair.Delete:
+		return parser.scan(lexer)
+	}
+	if parser.Trace != nil && parser.Trace.OnToken != nil {
+		parser.Trace.OnToken(found.Token)
+	}
+	return found.Token
+}
+
+// recoverFromError handles the syntax error err at lookahead, as yacc does. The error is recorded in
+// parser.Errors unless the parser is still resynchronizing after an earlier one. States are
+// popped until one can shift the grammar's error token, which is shifted as an "error" AST
+// node; then tokens are discarded until one can follow it. It returns the updated stacks and
+// lookahead, or all syntax errors if no state on the stack can shift the error token, as is
+// always so for grammars without error productions.
+func (parser *Parser) recoverFromError(
+	lexer liblexers.AbstractLexer,
+	stateStack []int,
+	nodeStack []*asts.ASTNode,
+	lookahead *tokens.Token,
+	astMode string,
+	err error,
+) ([]int, []*asts.ASTNode, *tokens.Token, error) {
+	if parser.recovering == 0 {
+		parser.Errors = append(parser.Errors, err)
+		if parser.MaxErrors > 0 && len(parser.Errors) >= parser.MaxErrors {
+			return nil, nil, nil, errors.Join(parser.Errors...)
+		}
+	}
+	if parser.recovering == 3 {
+		// The error token was just shifted, and lookahead cannot follow it.
+		if lookahead.Type == tokens.TokenTypeEOF {
+			return nil, nil, nil, errors.Join(parser.Errors...)
+		}
+		lookahead = parser.scan(lexer)
+		return stateStack, nodeStack, lookahead, nil
+	}
+	// Three tokens must be shifted after the error token before further errors are reported.
+	parser.recovering = 3
+	for {
+		state := stateStack[len(stateStack)-1]
+		if action, ok := parser.Tables.Action(state, tokens.TokenType("error")); ok && action.Kind == ActionShift {
+			if astMode == "noast" {
+				nodeStack = append(nodeStack, noASTSentinel)
+			} else {
+				nodeStack = append(nodeStack, asts.NewASTNodeTerminal(lookahead, asts.NodeType("error")))
+			}
+			stateStack = append(stateStack, action.Target)
+			if parser.Trace != nil && parser.Trace.OnStack != nil {
+				parser.Trace.OnStack(stateStack, nodeStack)
+			}
+			return stateStack, nodeStack, lookahead, nil
+		}
+		if len(stateStack) == 1 {
+			return nil, nil, nil, errors.Join(parser.Errors...)
+		}
+		stateStack = stateStack[:len(stateStack)-1]
+		nodeStack = nodeStack[:len(nodeStack)-1]
+	}
+}
+
+// repairTables adapts the tables to the repair search.
+type repairTables struct {
+	tables *Tables
+}
+
+func (adapter repairTables) Action(state int, lookahead tokens.TokenType) (repair.Action, bool) {
+	action, ok := adapter.tables.Action(state, lookahead)
+	if !ok {
+		return repair.Action{}, false
+	}
+	switch action.Kind {
+	case ActionShift:
+		return repair.Action{Kind: repair.ActionShift, Target: action.Target}, true
+	case ActionReduce:
+		return repair.Action{Kind: repair.ActionReduce, Target: action.Target}, true
+	default:
+		return repair.Action{Kind: repair.ActionAccept}, true
+	}
+}
+
+func (adapter repairTables) Lookaheads(state int) []tokens.TokenType {
+	return adapter.tables.Lookaheads(state)
+}
+
+func (adapter repairTables) Goto(state int, lhs asts.NodeType) (int, bool) {
+	return adapter.tables.Goto(state, lhs)
+}
+
+func (adapter repairTables) Production(prod int) (asts.NodeType, int) {
+	return adapter.tables.Productions[prod].LHS, adapter.tables.Productions[prod].RHSCount
+}
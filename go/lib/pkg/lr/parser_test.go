@@ -0,0 +1,224 @@
+package lr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+	"github.com/stretchr/testify/assert"
+)
+
+// listTablesJSON are hand-built LR tables for the grammar
+//
+//	S' ::= L ;  L ::= L int semi -> { "parent": 2, "children": [0, 1], "type": "list" } | int semi -> $0 ;
+const listTablesJSON = `{
+  "start_symbol": "L",
+  "actions": {
+    "0": {"int": {"type": "shift", "target": 2}},
+    "1": {"int": {"type": "shift", "target": 3}, "EOF": {"type": "accept"}},
+    "2": {"semi": {"type": "shift", "target": 4}},
+    "3": {"semi": {"type": "shift", "target": 5}},
+    "4": {"int": {"type": "reduce", "target": 2}, "EOF": {"type": "reduce", "target": 2}},
+    "5": {"int": {"type": "reduce", "target": 1}, "EOF": {"type": "reduce", "target": 1}}
+  },
+  "gotos": {"0": {"L": 1}},
+  "productions": [
+    {"lhs": "S'", "rhs": [{"name": "L", "terminal": false}]},
+    {"lhs": "L", "rhs": [{"name": "L", "terminal": false}, {"name": "int", "terminal": true}, {"name": "semi", "terminal": true}],
+     "hint": {"parent": 2, "children": [0, 1], "type": "list"}},
+    {"lhs": "L", "rhs": [{"name": "int", "terminal": true}, {"name": "semi", "terminal": true}],
+     "hint": {"parent": 0, "children": null, "pass-through": 0}}
+  ],
+  "hint_mode": "hints",
+  "display_names": {"semi": "';'"}
+}`
+
+func newListParser(t *testing.T) *Parser {
+	t.Helper()
+	tables, err := DecodeTables([]byte(listTablesJSON))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return NewParser(tables)
+}
+
+// sliceLexer returns one token per whitespace-separated field, of that type. A field written
+// type:lexeme sets the lexeme too.
+type sliceLexer struct {
+	fields []string
+}
+
+func (lexer *sliceLexer) Scan() *tokens.Token {
+	if len(lexer.fields) == 0 {
+		return tokens.NewEOFToken(tokens.NewTokenLocation())
+	}
+	field := lexer.fields[0]
+	lexer.fields = lexer.fields[1:]
+	tokenType, lexeme, found := strings.Cut(field, ":")
+	if !found {
+		lexeme = tokenType
+	}
+	return tokens.NewToken([]rune(lexeme), tokens.TokenType(tokenType), tokens.NewTokenLocation())
+}
+
+func lex(input string) *sliceLexer {
+	return &sliceLexer{fields: strings.Fields(input)}
+}
+
+// sexpr renders a node as its lexeme if it is a leaf, else as (type children...).
+func sexpr(node *asts.ASTNode) string {
+	if node.Children == nil {
+		return string(node.Token.Lexeme)
+	}
+	parts := []string{string(node.Type)}
+	for _, child := range node.Children {
+		parts = append(parts, sexpr(child))
+	}
+	return "(" + strings.Join(parts, " ") + ")"
+}
+
+func TestDecodeTables(t *testing.T) {
+	parser := newListParser(t)
+	tables := parser.Tables
+	assert.Equal(t, "L", tables.StartSymbol)
+	assert.Equal(t, []tokens.TokenType{"", "EOF", "int", "semi"}, tables.Terminals.Types)
+	assert.Equal(t, []asts.NodeType{"L", "S'"}, tables.Nonterminals)
+	action, ok := tables.Action(1, "int")
+	assert.True(t, ok)
+	assert.Equal(t, Action{Kind: ActionShift, Target: 3}, action)
+	action, ok = tables.Action(1, tokens.TokenTypeEOF)
+	assert.True(t, ok)
+	assert.Equal(t, Action{Kind: ActionAccept}, action)
+	_, ok = tables.Action(1, "semi")
+	assert.False(t, ok)
+	_, ok = tables.Action(1, "float")
+	assert.False(t, ok)
+	assert.Equal(t, []tokens.TokenType{"EOF", "int"}, tables.Lookaheads(4))
+	target, ok := tables.Goto(0, "L")
+	assert.True(t, ok)
+	assert.Equal(t, 1, target)
+	_, ok = tables.Goto(1, "L")
+	assert.False(t, ok)
+	assert.Len(t, tables.Productions, 3)
+	assert.Equal(t, 1, tables.Productions[0].LHSID)
+	assert.Equal(t, 0, tables.Productions[1].LHSID)
+	assert.Nil(t, tables.Productions[0].Hint)
+	assert.Equal(t, &Hint{ParentIndex: 2, ChildIndices: []int{0, 1}, NodeType: "list"}, tables.Productions[1].Hint)
+	assert.Equal(t, &Hint{Passthrough: true}, tables.Productions[2].Hint)
+	assert.Equal(t, "';'", tables.DisplayName("semi"))
+	assert.Equal(t, "end of input", tables.DisplayName(tokens.TokenTypeEOF))
+
+	_, err := DecodeTables([]byte(`{"actions": {"0": {"int": {"type": "jump"}}}}`))
+	assert.ErrorContains(t, err, `unknown action type "jump"`)
+	_, err = DecodeTables([]byte(`{"gotos": {"zero": {}}}`))
+	assert.ErrorContains(t, err, `state "zero" is not an integer`)
+}
+
+func TestDisplace(t *testing.T) {
+	rows := [][]cell{
+		{{column: 1, value: 10}, {column: 2, value: 20}},
+		{},
+		{{column: 1, value: 30}, {column: 3, value: 40}},
+		{{column: 2, value: 50}},
+	}
+	base, check, values := displace(rows)
+	assert.Len(t, base, 4)
+	for r, row := range rows {
+		for column := 0; column <= 3; column++ {
+			slot := int(base[r]) + column
+			found := slot >= 0 && slot < len(check) && int(check[slot]) == r
+			want := int32(0)
+			for _, c := range row {
+				if c.column == column {
+					want = c.value
+				}
+			}
+			if assert.Equal(t, want != 0, found, "row %d, column %d", r, column) && found {
+				assert.Equal(t, want, values[slot], "row %d, column %d", r, column)
+			}
+		}
+	}
+	// Rows 0 and 2 interleave, and row 3 fills the gap between them.
+	assert.Len(t, check, 5)
+}
+
+func TestParse(t *testing.T) {
+	parser := newListParser(t)
+	ast, err := parser.Parse(lex("int:1 semi int:2 semi int:3 semi"), "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "(list (list 1 2) 3)", sexpr(ast.RootNode))
+	}
+
+	ast, err = parser.Parse(lex("int:1 semi int:2 semi"), "fullast")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "(L (L 1 semi) 2 semi)", sexpr(ast.RootNode))
+	}
+
+	ast, err = parser.Parse(lex("int:1 semi"), "noast")
+	assert.NoError(t, err)
+	assert.Nil(t, ast)
+
+	_, err = parser.ParseFrom("Statement", lex("int semi"), "")
+	assert.ErrorContains(t, err, `"Statement" is not a start symbol`)
+}
+
+func TestParseSyntaxError(t *testing.T) {
+	parser := newListParser(t)
+	_, err := parser.Parse(lex("int semi semi:;"), "")
+	assert.ErrorContains(t, err, "unexpected ';'; expected one of end of input, int")
+	var parseError *libparsers.ParseError
+	if assert.ErrorAs(t, err, &parseError) {
+		assert.Equal(t, 4, parseError.State)
+	}
+	assert.Len(t, parser.Errors, 1)
+
+	parser.Tables.ErrorMessages = map[int]string{4: "expected another item"}
+	_, err = parser.Parse(lex("int semi semi:;"), "")
+	assert.ErrorContains(t, err, "expected another item")
+}
+
+func TestParseRepair(t *testing.T) {
+	parser := newListParser(t)
+	parser.RepairErrors = true
+	ast, err := parser.Parse(lex("int:1 int:2 semi"), "")
+	assert.ErrorContains(t, err, "inserted ';'")
+	if assert.NotNil(t, ast) {
+		assert.Equal(t, "(list 1 2)", sexpr(ast.RootNode))
+	}
+	assert.Len(t, parser.Errors, 1)
+}
+
+func TestParseWith(t *testing.T) {
+	parser := newListParser(t)
+	count := libparsers.ValueHandlerFuncs[int]{
+		ShiftFunc: func(token *tokens.Token) (int, error) {
+			if token.Type == "int" {
+				return 1, nil
+			}
+			return 0, nil
+		},
+		ReduceFunc: func(production int, lhs asts.NodeType, rhs []int) (int, error) {
+			sum := 0
+			for _, value := range rhs {
+				sum += value
+			}
+			return sum, nil
+		},
+	}
+	value, err := ParseWith[int](parser, lex("int semi int semi int semi"), count)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, value)
+
+	_, err = ParseWith[int](parser, lex("int int"), count)
+	assert.ErrorContains(t, err, "unexpected int")
+}
+
+func TestParseAll(t *testing.T) {
+	parser := newListParser(t)
+	parses, err := parser.ParseAll(lex("int:1 semi int:2 semi"), "")
+	if assert.NoError(t, err) && assert.Len(t, parses, 1) {
+		assert.Equal(t, "(list 1 2)", sexpr(parses[0].RootNode))
+	}
+}
@@ -0,0 +1,489 @@
+// Package lr runs LR parser tables, as generated by parsegen-tables, to parse tokens from any
+// lexer. The tables can be decoded from their JSON encoding, or declared in Go, as the parsers
+// generated by parsegen-code do; either way all parsing logic lives here.
+package lr
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+type ActionKind int
+
+const (
+	ActionShift ActionKind = iota
+	ActionReduce
+	ActionAccept
+	// ActionAcceptAndYield ends a record of multi-record input, leaving the lookahead for the next.
+	ActionAcceptAndYield
+)
+
+// actionKindBits is the number of low bits holding an action's kind in a packed action table
+// entry, the rest holding its target.
+const actionKindBits = 2
+
+// Action is a shift to state Target, a reduce by production Target, or an accept.
+type Action struct {
+	Kind   ActionKind
+	Target int
+}
+
+// Production is a grammar production, as much of it as parsing needs.
+type Production struct {
+	LHS asts.NodeType
+	// LHSID is the ID of LHS among Tables.Nonterminals, indexing the goto table.
+	LHSID    int
+	RHSCount int
+	// Hint is the production's AST hint, or nil if it has none.
+	Hint *Hint
+}
+
+// Hint is a production's AST hint, as written in the grammar's "-> { ... }" block.
+type Hint struct {
+	// Passthrough is set for a pass-through hint, making the node that of the right-hand-side
+	// symbol at PassthroughIndex. The other fields are then unused.
+	Passthrough      bool
+	PassthroughIndex int
+	// ParentIndex is the right-hand-side symbol giving the node's token, unless HasParentLiteral
+	// is set, in which case the token is made from ParentLiteral.
+	ParentIndex      int
+	HasParentLiteral bool
+	ParentLiteral    string
+	ChildIndices     []int
+	// WithAppendedChildren, WithPrependedChildren, and WithAdoptedGrandchildren, at most one of
+	// them non-empty, take the place of ChildIndices.
+	WithAppendedChildren     []int
+	WithPrependedChildren    []int
+	WithAdoptedGrandchildren []int
+	// NodeType, if non-empty, overrides the node's type.
+	NodeType asts.NodeType
+}
+
+// EntryPoint is a start symbol and the state in which parses of it begin.
+type EntryPoint struct {
+	Symbol string
+	State  int
+}
+
+// Tables are an LR parser's tables.
+//
+// The action and goto tables are indexed by state and by integer IDs of the terminals and
+// nonterminals, and are row-displaced: each state's row is placed at an offset, its base, in one
+// array shared by all rows, which overlap wherever their entries do not collide, and a check array
+// records which state owns each slot.
+type Tables struct {
+	StartSymbol string
+	// EntryPoints lists each start symbol with its start state, for grammars with more than one.
+	EntryPoints []EntryPoint
+	// Terminals numbers the terminals, in sorted order; a token's kind indexes the action table.
+	Terminals *tokens.Kinds
+	// Nonterminals lists the nonterminals in sorted order; a nonterminal's ID, indexing the goto
+	// table, is its index.
+	Nonterminals []asts.NodeType
+	// The action of state s on the terminal with ID t is ActionEntries[ActionBase[s]+t], if
+	// ActionCheck holds s at that index. An entry packs the action as Target<<2 | Kind.
+	ActionBase    []int32
+	ActionCheck   []int32
+	ActionEntries []int32
+	// The goto of state s on the nonterminal with ID n is GotoTargets[GotoBase[s]+n], if GotoCheck
+	// holds s at that index.
+	GotoBase    []int32
+	GotoCheck   []int32
+	GotoTargets []int32
+	Productions []Production
+	// HintMode is "hints" if productions' AST hints are applied, else nodes are built for every
+	// production.
+	HintMode string
+	// RecordSeparator is the terminal separating records of multi-record input, if any.
+	RecordSeparator tokens.TokenType
+	// DisplayNames holds the terminals' names in syntax errors, where they differ from their types.
+	DisplayNames map[tokens.TokenType]string
+	// ErrorMessages holds the messages for syntax errors in particular states.
+	ErrorMessages map[int]string
+	// ConflictActions holds every action of each conflicting table entry, for ParseAll. The action
+	// table holds the default resolution of these entries.
+	ConflictActions map[int]map[tokens.TokenType][]Action
+}
+
+// jsonTables is the JSON encoding of Tables written by parsegen-tables.
+type jsonTables struct {
+	StartSymbol     string                             `json:"start_symbol"`
+	EntryPoints     []jsonEntryPoint                   `json:"entry_points"`
+	Actions         map[string]map[string]jsonAction   `json:"actions"`
+	Gotos           map[string]map[string]int          `json:"gotos"`
+	Productions     []jsonProduction                   `json:"productions"`
+	HintMode        string                             `json:"hint_mode"`
+	RecordSeparator string                             `json:"record_separator"`
+	DisplayNames    map[string]string                  `json:"display_names"`
+	ErrorMessages   map[string]string                  `json:"error_messages"`
+	ConflictActions map[string]map[string][]jsonAction `json:"conflict_actions"`
+}
+
+type jsonEntryPoint struct {
+	Symbol string `json:"symbol"`
+	State  int    `json:"state"`
+}
+
+type jsonAction struct {
+	Type   string `json:"type"`
+	Target int    `json:"target"`
+}
+
+type jsonProduction struct {
+	LHS  string    `json:"lhs"`
+	RHS  []any     `json:"rhs"`
+	Hint *jsonHint `json:"hint"`
+}
+
+type jsonHint struct {
+	ParentIndex              int     `json:"parent"`
+	ChildIndices             []int   `json:"children"`
+	WithAppendedChildren     []int   `json:"with_appended_children"`
+	WithPrependedChildren    []int   `json:"with_prepended_children"`
+	WithAdoptedGrandchildren []int   `json:"with_adopted_grandchildren"`
+	ParentLiteral            *string `json:"parent_literal"`
+	PassthroughIndex         *int    `json:"pass-through"`
+	NodeType                 string  `json:"type"`
+}
+
+// DecodeTables reads tables from their JSON encoding, as written by parsegen-tables.
+func DecodeTables(data []byte) (*Tables, error) {
+	var encoded jsonTables
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, fmt.Errorf("decode parser tables: %w", err)
+	}
+	tables := &Tables{
+		StartSymbol:     encoded.StartSymbol,
+		Productions:     make([]Production, len(encoded.Productions)),
+		HintMode:        encoded.HintMode,
+		RecordSeparator: tokens.TokenType(encoded.RecordSeparator),
+	}
+	for _, entryPoint := range encoded.EntryPoints {
+		tables.EntryPoints = append(tables.EntryPoints, EntryPoint{Symbol: entryPoint.Symbol, State: entryPoint.State})
+	}
+	actions := make(map[int]map[tokens.TokenType]Action, len(encoded.Actions))
+	for key, stateActions := range encoded.Actions {
+		state, err := decodeState(key)
+		if err != nil {
+			return nil, err
+		}
+		actions[state] = make(map[tokens.TokenType]Action, len(stateActions))
+		for terminal, action := range stateActions {
+			decoded, err := decodeAction(action)
+			if err != nil {
+				return nil, fmt.Errorf("decode parser tables: state %d, %s: %w", state, terminal, err)
+			}
+			actions[state][tokens.TokenType(terminal)] = decoded
+		}
+	}
+	gotos := make(map[int]map[asts.NodeType]int, len(encoded.Gotos))
+	for key, stateGotos := range encoded.Gotos {
+		state, err := decodeState(key)
+		if err != nil {
+			return nil, err
+		}
+		gotos[state] = make(map[asts.NodeType]int, len(stateGotos))
+		for nonterminal, target := range stateGotos {
+			gotos[state][asts.NodeType(nonterminal)] = target
+		}
+	}
+	for i, prod := range encoded.Productions {
+		tables.Productions[i] = Production{LHS: asts.NodeType(prod.LHS), RHSCount: len(prod.RHS)}
+		if h := prod.Hint; h != nil {
+			tables.Productions[i].Hint = decodeHint(h)
+		}
+	}
+	tables.setActionsAndGotos(actions, gotos)
+	if len(encoded.DisplayNames) > 0 {
+		tables.DisplayNames = make(map[tokens.TokenType]string, len(encoded.DisplayNames))
+		for terminal, name := range encoded.DisplayNames {
+			tables.DisplayNames[tokens.TokenType(terminal)] = name
+		}
+	}
+	if len(encoded.ErrorMessages) > 0 {
+		tables.ErrorMessages = make(map[int]string, len(encoded.ErrorMessages))
+		for key, message := range encoded.ErrorMessages {
+			state, err := decodeState(key)
+			if err != nil {
+				return nil, err
+			}
+			tables.ErrorMessages[state] = message
+		}
+	}
+	if len(encoded.ConflictActions) > 0 {
+		tables.ConflictActions = make(map[int]map[tokens.TokenType][]Action, len(encoded.ConflictActions))
+		for key, stateActions := range encoded.ConflictActions {
+			state, err := decodeState(key)
+			if err != nil {
+				return nil, err
+			}
+			tables.ConflictActions[state] = make(map[tokens.TokenType][]Action, len(stateActions))
+			for terminal, actions := range stateActions {
+				for _, action := range actions {
+					decoded, err := decodeAction(action)
+					if err != nil {
+						return nil, fmt.Errorf("decode parser tables: state %d, %s: %w", state, terminal, err)
+					}
+					tables.ConflictActions[state][tokens.TokenType(terminal)] = append(
+						tables.ConflictActions[state][tokens.TokenType(terminal)], decoded)
+				}
+			}
+		}
+	}
+	return tables, nil
+}
+
+// setActionsAndGotos numbers the terminals and nonterminals, setting the productions' LHSIDs, and
+// fills in the row-displaced action and goto tables.
+func (tables *Tables) setActionsAndGotos(actions map[int]map[tokens.TokenType]Action, gotos map[int]map[asts.NodeType]int) {
+	numStates := 1
+	for _, entryPoint := range tables.EntryPoints {
+		numStates = max(numStates, entryPoint.State+1)
+	}
+	terminalSet := map[tokens.TokenType]bool{}
+	for state, stateActions := range actions {
+		numStates = max(numStates, state+1)
+		for terminal, action := range stateActions {
+			terminalSet[terminal] = true
+			if action.Kind == ActionShift {
+				numStates = max(numStates, action.Target+1)
+			}
+		}
+	}
+	nonterminalSet := map[asts.NodeType]bool{}
+	for state, stateGotos := range gotos {
+		numStates = max(numStates, state+1)
+		for nonterminal, target := range stateGotos {
+			nonterminalSet[nonterminal] = true
+			numStates = max(numStates, target+1)
+		}
+	}
+	for _, prod := range tables.Productions {
+		nonterminalSet[prod.LHS] = true
+	}
+
+	terminals := make([]tokens.TokenType, 0, len(terminalSet))
+	for terminal := range terminalSet {
+		terminals = append(terminals, terminal)
+	}
+	sort.Slice(terminals, func(i, j int) bool { return terminals[i] < terminals[j] })
+	tables.Terminals = tokens.NewKinds(terminals...)
+	tables.Nonterminals = make([]asts.NodeType, 0, len(nonterminalSet))
+	for nonterminal := range nonterminalSet {
+		tables.Nonterminals = append(tables.Nonterminals, nonterminal)
+	}
+	sort.Slice(tables.Nonterminals, func(i, j int) bool { return tables.Nonterminals[i] < tables.Nonterminals[j] })
+	for i := range tables.Productions {
+		tables.Productions[i].LHSID, _ = tables.nonterminalID(tables.Productions[i].LHS)
+	}
+
+	actionRows := make([][]cell, numStates)
+	for state, stateActions := range actions {
+		for terminal, action := range stateActions {
+			entry := int32(action.Target)<<actionKindBits | int32(action.Kind)
+			actionRows[state] = append(actionRows[state], cell{column: tables.Terminals.IDs[terminal], value: entry})
+		}
+	}
+	gotoRows := make([][]cell, numStates)
+	for state, stateGotos := range gotos {
+		for nonterminal, target := range stateGotos {
+			id, _ := tables.nonterminalID(nonterminal)
+			gotoRows[state] = append(gotoRows[state], cell{column: id, value: int32(target)})
+		}
+	}
+	tables.ActionBase, tables.ActionCheck, tables.ActionEntries = displace(actionRows)
+	tables.GotoBase, tables.GotoCheck, tables.GotoTargets = displace(gotoRows)
+}
+
+// cell is an entry of a table row, before displacement.
+type cell struct {
+	column int
+	value  int32
+}
+
+// displace packs the rows of a sparse table into row-displaced arrays: row r's value in column c
+// is values[base[r]+c] if check[base[r]+c] is r. Unused slots have check -1. Rows are placed first
+// fit, longest first, each at the lowest base where it collides with no row already placed.
+func displace(rows [][]cell) (base, check, values []int32) {
+	order := make([]int, len(rows))
+	for r := range rows {
+		order[r] = r
+		sort.Slice(rows[r], func(i, j int) bool { return rows[r][i].column < rows[r][j].column })
+	}
+	sort.SliceStable(order, func(i, j int) bool { return len(rows[order[i]]) > len(rows[order[j]]) })
+
+	base = make([]int32, len(rows))
+	firstFree := 0
+	for _, r := range order {
+		row := rows[r]
+		if len(row) == 0 {
+			continue
+		}
+		offset := firstFree - row[0].column
+		for !fits(check, row, offset) {
+			offset++
+		}
+		for _, c := range row {
+			slot := offset + c.column
+			for slot >= len(check) {
+				check = append(check, -1)
+				values = append(values, 0)
+			}
+			check[slot] = int32(r)
+			values[slot] = c.value
+		}
+		base[r] = int32(offset)
+		for firstFree < len(check) && check[firstFree] >= 0 {
+			firstFree++
+		}
+	}
+	return base, check, values
+}
+
+// fits tells whether row can be placed at offset without colliding with rows already placed.
+func fits(check []int32, row []cell, offset int) bool {
+	for _, c := range row {
+		slot := offset + c.column
+		if slot < len(check) && check[slot] >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeHint returns the Hint for a production's JSON hint, leaving unused fields zero and empty
+// index lists nil, as in generated tables.
+func decodeHint(h *jsonHint) *Hint {
+	if h.PassthroughIndex != nil {
+		return &Hint{Passthrough: true, PassthroughIndex: *h.PassthroughIndex}
+	}
+	hint := &Hint{
+		ChildIndices:             nonEmpty(h.ChildIndices),
+		WithAppendedChildren:     nonEmpty(h.WithAppendedChildren),
+		WithPrependedChildren:    nonEmpty(h.WithPrependedChildren),
+		WithAdoptedGrandchildren: nonEmpty(h.WithAdoptedGrandchildren),
+		NodeType:                 asts.NodeType(h.NodeType),
+	}
+	if h.ParentLiteral != nil {
+		hint.HasParentLiteral = true
+		hint.ParentLiteral = *h.ParentLiteral
+	} else {
+		hint.ParentIndex = h.ParentIndex
+	}
+	return hint
+}
+
+func nonEmpty(indices []int) []int {
+	if len(indices) == 0 {
+		return nil
+	}
+	return indices
+}
+
+func decodeState(key string) (int, error) {
+	state, err := strconv.Atoi(key)
+	if err != nil {
+		return 0, fmt.Errorf("decode parser tables: state %q is not an integer", key)
+	}
+	return state, nil
+}
+
+func decodeAction(action jsonAction) (Action, error) {
+	switch action.Type {
+	case "shift":
+		return Action{Kind: ActionShift, Target: action.Target}, nil
+	case "reduce":
+		return Action{Kind: ActionReduce, Target: action.Target}, nil
+	case "accept":
+		return Action{Kind: ActionAccept}, nil
+	case "accept_and_yield":
+		return Action{Kind: ActionAcceptAndYield}, nil
+	default:
+		return Action{}, fmt.Errorf("unknown action type %q", action.Type)
+	}
+}
+
+// action returns the action of state on the terminal with ID kind, if any.
+func (tables *Tables) action(state, kind int) (Action, bool) {
+	slot := int(tables.ActionBase[state]) + kind
+	if slot < 0 || slot >= len(tables.ActionCheck) || int(tables.ActionCheck[slot]) != state {
+		return Action{}, false
+	}
+	entry := tables.ActionEntries[slot]
+	return Action{Kind: ActionKind(entry & (1<<actionKindBits - 1)), Target: int(entry >> actionKindBits)}, true
+}
+
+// gotoState returns the goto of state on the nonterminal with ID id, if any.
+func (tables *Tables) gotoState(state, id int) (int, bool) {
+	slot := int(tables.GotoBase[state]) + id
+	if slot < 0 || slot >= len(tables.GotoCheck) || int(tables.GotoCheck[slot]) != state {
+		return 0, false
+	}
+	return int(tables.GotoTargets[slot]), true
+}
+
+// nonterminalID returns the ID of nonterminal, if it is one.
+func (tables *Tables) nonterminalID(nonterminal asts.NodeType) (int, bool) {
+	id := sort.Search(len(tables.Nonterminals), func(i int) bool { return tables.Nonterminals[i] >= nonterminal })
+	return id, id < len(tables.Nonterminals) && tables.Nonterminals[id] == nonterminal
+}
+
+// Action returns the action of state on terminal, if any.
+func (tables *Tables) Action(state int, terminal tokens.TokenType) (Action, bool) {
+	kind, ok := tables.Terminals.IDs[terminal]
+	if !ok {
+		return Action{}, false
+	}
+	return tables.action(state, kind)
+}
+
+// Goto returns the state reached from state on nonterminal, if any.
+func (tables *Tables) Goto(state int, nonterminal asts.NodeType) (int, bool) {
+	id, ok := tables.nonterminalID(nonterminal)
+	if !ok {
+		return 0, false
+	}
+	return tables.gotoState(state, id)
+}
+
+// Lookaheads returns the terminals on which state has actions, in order of their IDs.
+func (tables *Tables) Lookaheads(state int) []tokens.TokenType {
+	var lookaheads []tokens.TokenType
+	for kind := 1; kind < len(tables.Terminals.Types); kind++ {
+		if _, ok := tables.action(state, kind); ok {
+			lookaheads = append(lookaheads, tables.Terminals.Types[kind])
+		}
+	}
+	return lookaheads
+}
+
+// startState returns the start state of the start symbol, or of the first start symbol if
+// symbol is empty.
+func (tables *Tables) startState(symbol string) (int, error) {
+	if symbol == "" || symbol == tables.StartSymbol {
+		return 0, nil
+	}
+	for _, entryPoint := range tables.EntryPoints {
+		if entryPoint.Symbol == symbol {
+			return entryPoint.State, nil
+		}
+	}
+	return 0, fmt.Errorf("parser: %q is not a start symbol", symbol)
+}
+
+// DisplayName returns the name of a terminal in syntax errors: its display name if the grammar
+// declares one, else its token type.
+func (tables *Tables) DisplayName(tokenType tokens.TokenType) string {
+	if name, ok := tables.DisplayNames[tokenType]; ok {
+		return name
+	}
+	if tokenType == tokens.TokenTypeEOF {
+		return "end of input"
+	}
+	return string(tokenType)
+}
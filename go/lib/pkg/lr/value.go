@@ -0,0 +1,104 @@
+package lr
+
+import (
+	"errors"
+	"fmt"
+
+	liblexers "github.com/johnkerl/pgpg/go/lib/pkg/lexers"
+	libparsers "github.com/johnkerl/pgpg/go/lib/pkg/parsers"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+// ParseWith parses the input, computing values of type T with the handler in place of building
+// an AST, and returns the start symbol's value. Syntax errors may be repaired, as with
+// RepairErrors, but error productions are not used.
+func ParseWith[T any](parser *Parser, lexer liblexers.AbstractLexer, handler libparsers.ValueHandler[T]) (T, error) {
+	return ParseFromWith(parser, "", lexer, handler)
+}
+
+// ParseFromWith parses input derived from symbol, one of the grammar's start symbols, as
+// ParseWith does.
+func ParseFromWith[T any](
+	parser *Parser,
+	symbol string,
+	lexer liblexers.AbstractLexer,
+	handler libparsers.ValueHandler[T],
+) (T, error) {
+	var zero T
+	startState, err := parser.Tables.startState(symbol)
+	if err != nil {
+		return zero, err
+	}
+	if lexer == nil {
+		return zero, fmt.Errorf("parser: nil lexer")
+	}
+	stateStack := []int{startState}
+	valueStack := []T{}
+	parser.Errors = nil
+	parser.pending = nil
+	parser.setTokenKinds(lexer)
+	kinds := parser.Tables.Terminals
+	lookahead := parser.scan(lexer)
+	for {
+		if lookahead == nil {
+			return zero, fmt.Errorf("parser: lexer returned nil token")
+		}
+		if lookahead.Type == tokens.TokenTypeError {
+			return zero, liblexers.NewLexError(lookahead, parser.SourceName)
+		}
+		state := stateStack[len(stateStack)-1]
+		action, ok := parser.Tables.action(state, kinds.Of(lookahead))
+		if !ok {
+			err := parser.syntaxError(state, lookahead)
+			found, stop := parser.findRepair(lexer, stateStack, lookahead, err)
+			if stop != nil {
+				return zero, stop
+			}
+			if found != nil {
+				lookahead = parser.applyRepair(lexer, found)
+				continue
+			}
+			parser.Errors = append(parser.Errors, err)
+			return zero, errors.Join(parser.Errors...)
+		}
+		if parser.Trace != nil && parser.Trace.OnAction != nil {
+			parser.Trace.OnAction(state, action, lookahead)
+		}
+		switch action.Kind {
+		case ActionShift:
+			value, err := handler.Shift(lookahead)
+			if err != nil {
+				return zero, err
+			}
+			valueStack = append(valueStack, value)
+			stateStack = append(stateStack, action.Target)
+			lookahead = parser.scan(lexer)
+		case ActionReduce:
+			prod := &parser.Tables.Productions[action.Target]
+			rhs := make([]T, prod.RHSCount)
+			copy(rhs, valueStack[len(valueStack)-prod.RHSCount:])
+			valueStack = valueStack[:len(valueStack)-prod.RHSCount]
+			stateStack = stateStack[:len(stateStack)-prod.RHSCount]
+			value, err := handler.Reduce(action.Target, prod.LHS, rhs)
+			if err != nil {
+				return zero, err
+			}
+			valueStack = append(valueStack, value)
+			state = stateStack[len(stateStack)-1]
+			nextState, ok := parser.Tables.gotoState(state, prod.LHSID)
+			if !ok {
+				return zero, fmt.Errorf("parse error: missing goto for %s", prod.LHS)
+			}
+			stateStack = append(stateStack, nextState)
+		case ActionAccept:
+			if len(valueStack) != 1 {
+				return zero, fmt.Errorf("parse error: unexpected parse stack size %d", len(valueStack))
+			}
+			return valueStack[0], errors.Join(parser.Errors...)
+		case ActionAcceptAndYield:
+			return zero, fmt.Errorf("parse error: multiple objects; value parsing does not support multi-object input")
+		default:
+			return zero, fmt.Errorf("parse error: no action")
+		}
+	}
+}
@@ -1,9 +1,9 @@
 package parsers
 
 import (
-	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
 	"github.com/johnkerl/pgpg/go/lib/pkg/lexers"
@@ -33,8 +33,68 @@ const (
 	EBNFParserNodeTypeHintString     asts.NodeType = "hint_string"
 	EBNFParserNodeTypeHintArray      asts.NodeType = "hint_array"
 	EBNFParserNodeTypeEmpty          asts.NodeType = "empty"
+	EBNFParserNodeTypeDirective      asts.NodeType = "directive"
+	EBNFParserNodeTypePrecSequence   asts.NodeType = "prec_sequence"
+	EBNFParserNodeTypeInteger        asts.NodeType = "integer"
+	EBNFParserNodeTypeAction         asts.NodeType = "action"
+	EBNFParserNodeTypeActionSequence asts.NodeType = "action_sequence"
+	// Lexer rules only: a sequence matched only if followed by its second child, which is not
+	// part of the token ("r / s"), and a sequence matched only at the start of a line ("^ r").
+	EBNFParserNodeTypeTrailingContext asts.NodeType = "trailing_context"
+	EBNFParserNodeTypeLineStart       asts.NodeType = "line_start"
+	// Lexer rules only: the characters matched by the first child but not the second ("a - b"),
+	// and the characters not matched by the child ("~ a"), both children being character sets.
+	EBNFParserNodeTypeDifference asts.NodeType = "difference"
+	EBNFParserNodeTypeComplement asts.NodeType = "complement"
+	// Lexer rules only: its child matched regardless of case, as for the literal "end"i, whose
+	// child is the literal "end".
+	EBNFParserNodeTypeCaseless asts.NodeType = "caseless"
 )
 
+// Grammar directives are written "%name arg arg ... ;" between rules. Arguments are
+// identifiers, string literals, or integers. The directive node's token is the "%name" token.
+const (
+	EBNFDirectiveLeft     = "left"     // %left sym ... ; a left-associative precedence level
+	EBNFDirectiveRight    = "right"    // %right sym ... ; a right-associative precedence level
+	EBNFDirectiveNonassoc = "nonassoc" // %nonassoc sym ... ; a non-associative precedence level
+	EBNFDirectiveExpect   = "expect"   // %expect n ; the number of expected shift/reduce conflicts
+	EBNFDirectiveRecords  = "records"  // %records [separator] ; input is a sequence of start-symbol records
+	EBNFDirectiveStart    = "start"    // %start Sym ... ; the start symbol, then any further entry points
+	EBNFDirectiveDisplay  = "display"  // %display sym "name" ... ; terminals' names in error messages
+	EBNFDirectiveMode     = "mode"     // %mode name rule ... ; lexer token rules scanned only in the named mode
+	EBNFDirectivePush     = "push"     // %push mode rule ... ; tokens after which the lexer enters mode
+	EBNFDirectivePop      = "pop"      // %pop rule ... ; tokens after which the lexer returns to the mode it left
+	EBNFDirectiveSwitch   = "switch"   // %switch mode rule ... ; tokens after which mode replaces the current one
+	EBNFDirectiveCaseless = "caseless" // %caseless rule ... ; lexer rules matched regardless of case
+)
+
+// EBNFDirectivePrec is the in-production precedence override, written after a sequence
+// (before any AST hint) as "%prec sym".
+const EBNFDirectivePrec = "prec"
+
+var ebnfGrammarDirectives = map[string]bool{
+	EBNFDirectiveLeft:     true,
+	EBNFDirectiveRight:    true,
+	EBNFDirectiveNonassoc: true,
+	EBNFDirectiveExpect:   true,
+	EBNFDirectiveRecords:  true,
+	EBNFDirectiveStart:    true,
+	EBNFDirectiveDisplay:  true,
+	EBNFDirectiveMode:     true,
+	EBNFDirectivePush:     true,
+	EBNFDirectivePop:      true,
+	EBNFDirectiveSwitch:   true,
+	EBNFDirectiveCaseless: true,
+}
+
+// DirectiveName returns the name of a directive node, without the leading '%'.
+func DirectiveName(node *asts.ASTNode) string {
+	if node == nil || node.Token == nil {
+		return ""
+	}
+	return strings.TrimPrefix(string(node.Token.Lexeme), "%")
+}
+
 func NewEBNFParser() AbstractParser {
 	return &EBNFParser{}
 }
@@ -44,7 +104,7 @@ func NewEBNFParserWithSourceName(sourceName string) AbstractParser {
 }
 
 func (parser *EBNFParser) Parse(r io.Reader) (*asts.AST, error) {
-	parser.lexer = lexers.NewLookaheadLexer(lexers.NewEBNFLexerWithSourceName(r, parser.sourceName))
+	parser.lexer = lexers.NewLookaheadLexer(lexers.NewEBNFLexer(r))
 
 	rootNode, err := parser.parseGrammar()
 	if err != nil {
@@ -59,21 +119,99 @@ func (parser *EBNFParser) Parse(r io.Reader) (*asts.AST, error) {
 }
 
 func (parser *EBNFParser) parseGrammar() (*asts.ASTNode, error) {
-	var rules []*asts.ASTNode
+	var children []*asts.ASTNode
+	ruleCount := 0
 	for {
-		if parser.lexer.LookAhead().Type == tokens.TokenTypeEOF {
+		lookaheadType := parser.lexer.LookAhead().Type
+		if lookaheadType == tokens.TokenTypeEOF {
 			break
 		}
+		if lookaheadType == lexers.EBNFLexerTypeAction {
+			// A semantic action block between rules is a header for the actions' Go code,
+			// such as its imports.
+			_, actionToken, err := parser.accept(lexers.EBNFLexerTypeAction)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, asts.NewASTNode(actionToken, EBNFParserNodeTypeAction, nil))
+			continue
+		}
+		if lookaheadType == lexers.EBNFLexerTypeDirective {
+			directive, err := parser.parseDirective()
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, directive)
+			continue
+		}
 		rule, err := parser.parseRule()
 		if err != nil {
 			return nil, err
 		}
-		rules = append(rules, rule)
+		children = append(children, rule)
+		ruleCount++
 	}
-	if len(rules) == 0 {
-		return nil, errors.New("syntax error: expected one or more rules")
+	if ruleCount == 0 {
+		return nil, parser.syntaxError(parser.lexer.LookAhead(), "expected one or more rules")
 	}
-	return asts.NewASTNode(nil, EBNFParserNodeTypeGrammar, rules), nil
+	return asts.NewASTNode(nil, EBNFParserNodeTypeGrammar, children), nil
+}
+
+func (parser *EBNFParser) parseDirective() (*asts.ASTNode, error) {
+	// Directive : '%name' { identifier | string | integer } ';' ;
+	directiveToken := parser.lexer.LookAhead()
+	name := strings.TrimPrefix(string(directiveToken.Lexeme), "%")
+	if name == EBNFDirectivePrec {
+		return nil, parser.syntaxError(directiveToken, "%%%s is only allowed within a production", name)
+	}
+	if !ebnfGrammarDirectives[name] {
+		return nil, parser.syntaxError(directiveToken, "unknown directive %%%s", name)
+	}
+	if err := parser.expect(lexers.EBNFLexerTypeDirective); err != nil {
+		return nil, err
+	}
+
+	var args []*asts.ASTNode
+	for {
+		accepted, _, err := parser.accept(lexers.EBNFLexerTypeSemicolon)
+		if err != nil {
+			return nil, err
+		}
+		if accepted {
+			break
+		}
+		arg, err := parser.parseDirectiveArg()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	return asts.NewASTNode(directiveToken, EBNFParserNodeTypeDirective, args), nil
+}
+
+func (parser *EBNFParser) parseDirectiveArg() (*asts.ASTNode, error) {
+	accepted, token, err := parser.accept(lexers.EBNFLexerTypeIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	if accepted {
+		return asts.NewASTNode(token, EBNFParserNodeTypeIdentifier, nil), nil
+	}
+	accepted, token, err = parser.accept(lexers.EBNFLexerTypeString)
+	if err != nil {
+		return nil, err
+	}
+	if accepted {
+		return asts.NewASTNode(token, EBNFParserNodeTypeLiteral, nil), nil
+	}
+	accepted, token, err = parser.accept(lexers.EBNFLexerTypeInteger)
+	if err != nil {
+		return nil, err
+	}
+	if accepted {
+		return asts.NewASTNode(token, EBNFParserNodeTypeInteger, nil), nil
+	}
+	return nil, parser.syntaxError(parser.lexer.LookAhead(), "expected directive argument or ';'")
 }
 
 func (parser *EBNFParser) parseRule() (*asts.ASTNode, error) {
@@ -83,10 +221,7 @@ func (parser *EBNFParser) parseRule() (*asts.ASTNode, error) {
 	}
 	if !accepted {
 		lookaheadToken := parser.lexer.LookAhead()
-		return nil, fmt.Errorf(
-			"syntax error: expected rule name at \"%s\"",
-			parser.formatTokenLocation(lookaheadToken),
-		)
+		return nil, parser.syntaxError(lookaheadToken, "expected rule name")
 	}
 
 	if err := parser.expect(lexers.EBNFLexerTypeAssign); err != nil {
@@ -138,7 +273,63 @@ func (parser *EBNFParser) parseExpression() (*asts.ASTNode, error) {
 }
 
 func (parser *EBNFParser) parseSequence() (*asts.ASTNode, error) {
-	// Sequence : Term+ [HintBlock] ;
+	// Sequence : [ '^' ] Terms [ '/' Terms ] [ '%prec' Symbol ] [HintBlock] [ActionBlock] ;
+	lineStart, _, err := parser.accept(lexers.EBNFLexerTypeCaret)
+	if err != nil {
+		return nil, err
+	}
+	seqNode, err := parser.parseTerms()
+	if err != nil {
+		return nil, err
+	}
+	slash, _, err := parser.accept(lexers.EBNFLexerTypeSlash)
+	if err != nil {
+		return nil, err
+	}
+	if slash {
+		contextNode, err := parser.parseTerms()
+		if err != nil {
+			return nil, err
+		}
+		seqNode = asts.NewASTNode(nil, EBNFParserNodeTypeTrailingContext,
+			[]*asts.ASTNode{seqNode, contextNode})
+	}
+	if lineStart {
+		seqNode = asts.NewASTNode(nil, EBNFParserNodeTypeLineStart, []*asts.ASTNode{seqNode})
+	}
+
+	precNode, err := parser.parsePrecIfPresent()
+	if err != nil {
+		return nil, err
+	}
+	if precNode != nil {
+		seqNode = asts.NewASTNode(nil, EBNFParserNodeTypePrecSequence,
+			[]*asts.ASTNode{seqNode, precNode})
+	}
+
+	hintNode, err := parser.parseHintIfPresent()
+	if err != nil {
+		return nil, err
+	}
+	if hintNode != nil {
+		seqNode = asts.NewASTNode(nil, EBNFParserNodeTypeHintedSequence,
+			[]*asts.ASTNode{seqNode, hintNode})
+	}
+
+	accepted, actionToken, err := parser.accept(lexers.EBNFLexerTypeAction)
+	if err != nil {
+		return nil, err
+	}
+	if accepted {
+		actionNode := asts.NewASTNode(actionToken, EBNFParserNodeTypeAction, nil)
+		seqNode = asts.NewASTNode(nil, EBNFParserNodeTypeActionSequence,
+			[]*asts.ASTNode{seqNode, actionNode})
+	}
+	return seqNode, nil
+}
+
+// parseTerms parses one or more terms, returning the term or a sequence node.
+func (parser *EBNFParser) parseTerms() (*asts.ASTNode, error) {
 	var terms []*asts.ASTNode
 	for {
 		term, ok, err := parser.parseTermIfPresent()
@@ -151,26 +342,72 @@ func (parser *EBNFParser) parseSequence() (*asts.ASTNode, error) {
 		terms = append(terms, term)
 	}
 	if len(terms) == 0 {
-		return nil, errors.New("syntax error: expected term")
+		return nil, parser.syntaxError(parser.lexer.LookAhead(), "expected term")
+	}
+	if len(terms) == 1 {
+		return terms[0], nil
 	}
+	return asts.NewASTNode(nil, EBNFParserNodeTypeSequence, terms), nil
+}
 
-	seqNode := terms[0]
-	if len(terms) != 1 {
-		seqNode = asts.NewASTNode(nil, EBNFParserNodeTypeSequence, terms)
+// parseTermIfPresent parses a term, which may be a difference of character sets.
+func (parser *EBNFParser) parseTermIfPresent() (*asts.ASTNode, bool, error) {
+	// Term : Factor { '-' Factor } ;
+	term, ok, err := parser.parseFactorIfPresent()
+	if err != nil || !ok {
+		return nil, ok, err
 	}
+	for {
+		accepted, _, err := parser.accept(lexers.EBNFLexerTypeDash)
+		if err != nil {
+			return nil, false, err
+		}
+		if !accepted {
+			return term, true, nil
+		}
+		term, err = parser.parseDifference(term)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+}
 
-	hintNode, err := parser.parseHintIfPresent()
+// parseDifference parses the right side of a difference whose left side and '-' are parsed.
+func (parser *EBNFParser) parseDifference(left *asts.ASTNode) (*asts.ASTNode, error) {
+	right, ok, err := parser.parseFactorIfPresent()
 	if err != nil {
 		return nil, err
 	}
-	if hintNode != nil {
-		return asts.NewASTNode(nil, EBNFParserNodeTypeHintedSequence,
-			[]*asts.ASTNode{seqNode, hintNode}), nil
+	if !ok {
+		return nil, parser.syntaxError(parser.lexer.LookAhead(), "expected term after '-'")
 	}
-	return seqNode, nil
+	return asts.NewASTNode(nil, EBNFParserNodeTypeDifference, []*asts.ASTNode{left, right}), nil
 }
 
-func (parser *EBNFParser) parseTermIfPresent() (*asts.ASTNode, bool, error) {
+// parseFactorIfPresent parses a primary term, which may be complemented.
+func (parser *EBNFParser) parseFactorIfPresent() (*asts.ASTNode, bool, error) {
+	// Factor : '~' Factor | Primary ;
+	accepted, _, err := parser.accept(lexers.EBNFLexerTypeTilde)
+	if err != nil {
+		return nil, false, err
+	}
+	if !accepted {
+		return parser.parsePrimaryIfPresent()
+	}
+	operand, ok, err := parser.parseFactorIfPresent()
+	if err != nil {
+		return nil, false, err
+	}
+	if !ok {
+		return nil, false, parser.syntaxError(parser.lexer.LookAhead(), "expected term after '~'")
+	}
+	return asts.NewASTNode(nil, EBNFParserNodeTypeComplement, []*asts.ASTNode{operand}), true, nil
+}
+
+// parsePrimaryIfPresent parses an identifier, literal, range, wildcard, or bracketed expression.
+// A literal followed by '-' and another literal is a range; followed by '-' and any other term, it
+// is the left side of a difference.
+func (parser *EBNFParser) parsePrimaryIfPresent() (*asts.ASTNode, bool, error) {
 	accepted, token, err := parser.accept(lexers.EBNFLexerTypeIdentifier)
 	if err != nil {
 		return nil, false, err
@@ -200,12 +437,28 @@ func (parser *EBNFParser) parseTermIfPresent() (*asts.ASTNode, bool, error) {
 			return nil, false, err
 		}
 		if !acceptedEnd {
-			return nil, false, fmt.Errorf("syntax error: expected string literal after '-' at %s", parser.formatTokenLocation(parser.lexer.LookAhead()))
+			difference, err := parser.parseDifference(literalNode)
+			if err != nil {
+				return nil, false, err
+			}
+			return difference, true, nil
 		}
 		endNode := asts.NewASTNode(endToken, EBNFParserNodeTypeLiteral, nil)
 		return asts.NewASTNode(nil, EBNFParserNodeTypeRange, []*asts.ASTNode{literalNode, endNode}), true, nil
 	}
 
+	accepted, token, err = parser.accept(lexers.EBNFLexerTypeCaselessString)
+	if err != nil {
+		return nil, false, err
+	}
+	if accepted {
+		quoted := token.Lexeme[:len(token.Lexeme)-1]
+		literalNode := asts.NewASTNode(
+			tokens.NewToken(quoted, lexers.EBNFLexerTypeString, &token.Location),
+			EBNFParserNodeTypeLiteral, nil)
+		return asts.NewASTNode(nil, EBNFParserNodeTypeCaseless, []*asts.ASTNode{literalNode}), true, nil
+	}
+
 	accepted, token, err = parser.accept(lexers.EBNFLexerTypeDot)
 	if err != nil {
 		return nil, false, err
@@ -262,6 +515,32 @@ func (parser *EBNFParser) parseTermIfPresent() (*asts.ASTNode, bool, error) {
 	return nil, false, nil
 }
 
+// parsePrecIfPresent parses "%prec sym", returning the identifier or literal node for sym.
+func (parser *EBNFParser) parsePrecIfPresent() (*asts.ASTNode, error) {
+	lookaheadToken := parser.lexer.LookAhead()
+	if lookaheadToken.Type != lexers.EBNFLexerTypeDirective || string(lookaheadToken.Lexeme) != "%"+EBNFDirectivePrec {
+		return nil, nil
+	}
+	if err := parser.expect(lexers.EBNFLexerTypeDirective); err != nil {
+		return nil, err
+	}
+	accepted, token, err := parser.accept(lexers.EBNFLexerTypeIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	if accepted {
+		return asts.NewASTNode(token, EBNFParserNodeTypeIdentifier, nil), nil
+	}
+	accepted, token, err = parser.accept(lexers.EBNFLexerTypeString)
+	if err != nil {
+		return nil, err
+	}
+	if accepted {
+		return asts.NewASTNode(token, EBNFParserNodeTypeLiteral, nil), nil
+	}
+	return nil, parser.syntaxError(parser.lexer.LookAhead(), "expected symbol after %%prec")
+}
+
 func (parser *EBNFParser) parseHintIfPresent() (*asts.ASTNode, error) {
 	accepted, _, err := parser.accept(lexers.EBNFLexerTypeArrow)
 	if err != nil {
@@ -299,8 +578,7 @@ func (parser *EBNFParser) parseHintIfPresent() (*asts.ASTNode, error) {
 			return nil, err
 		}
 		if !accepted {
-			return nil, fmt.Errorf("syntax error: expected hint field name at %s",
-				parser.formatTokenLocation(parser.lexer.LookAhead()))
+			return nil, parser.syntaxError(parser.lexer.LookAhead(), "expected hint field name")
 		}
 
 		if err := parser.expect(lexers.EBNFLexerTypeColon); err != nil {
@@ -364,16 +642,14 @@ func (parser *EBNFParser) parseHintValue() (*asts.ASTNode, error) {
 				return nil, err
 			}
 			if !accepted {
-				return nil, fmt.Errorf("syntax error: expected integer in hint array at %s",
-					parser.formatTokenLocation(parser.lexer.LookAhead()))
+				return nil, parser.syntaxError(parser.lexer.LookAhead(), "expected integer in hint array")
 			}
 			elements = append(elements, asts.NewASTNode(intToken, EBNFParserNodeTypeHintInt, nil))
 		}
 		return asts.NewASTNode(nil, EBNFParserNodeTypeHintArray, elements), nil
 	}
 
-	return nil, fmt.Errorf("syntax error: expected hint value (integer, string, or array) at %s",
-		parser.formatTokenLocation(parser.lexer.LookAhead()))
+	return nil, parser.syntaxError(parser.lexer.LookAhead(), "expected hint value (integer, string, or array)")
 }
 
 func (parser *EBNFParser) accept(tokenType tokens.TokenType) (bool, *tokens.Token, error) {
@@ -398,13 +674,10 @@ func (parser *EBNFParser) expect(tokenType tokens.TokenType) error {
 		// No lex error getting the next token, but the current
 		// token isn't of the expected type
 		lookaheadToken := parser.lexer.LookAhead()
-		return fmt.Errorf(
-			"expect: expected %s; got %s (%q) at %s",
-			tokenType,
-			lookaheadToken.Type,
-			string(lookaheadToken.Lexeme),
-			parser.formatTokenLocation(lookaheadToken),
-		)
+		err := NewParseError(lookaheadToken, parser.sourceName,
+			fmt.Sprintf("expect: expected %s; got %s (%q)", tokenType, lookaheadToken.Type, string(lookaheadToken.Lexeme)))
+		err.Expected = []string{string(tokenType)}
+		return err
 	}
 	return nil
 }
@@ -413,29 +686,16 @@ func (parser *EBNFParser) getAndValidateLookaheadToken() error {
 	parser.lexer.Advance()
 	lookaheadToken := parser.lexer.LookAhead()
 	if lookaheadToken.IsError() {
-		return fmt.Errorf("lexer at %s: %s",
-			parser.formatTokenLocation(lookaheadToken),
-			string(lookaheadToken.Lexeme))
+		return lexers.NewLexError(lookaheadToken, parser.sourceName)
 	}
 
 	return nil
 }
 
-func (parser *EBNFParser) formatTokenLocation(token *tokens.Token) string {
-	if token == nil {
-		return "unknown location"
-	}
-	if parser.sourceName != "" {
-		return fmt.Sprintf(
-			"%s, line %d, column %d",
-			parser.sourceName,
-			token.Location.LineNumber,
-			token.Location.ColumnNumber,
-		)
-	}
-	return fmt.Sprintf(
-		"line %d, column %d",
-		token.Location.LineNumber,
-		token.Location.ColumnNumber,
-	)
+// syntaxError returns a ParseError at token, or the LexError if token is a lexer error token.
+func (parser *EBNFParser) syntaxError(token *tokens.Token, format string, args ...any) error {
+	if token.IsError() {
+		return lexers.NewLexError(token, parser.sourceName)
+	}
+	return NewParseError(token, parser.sourceName, "syntax error: "+fmt.Sprintf(format, args...))
 }
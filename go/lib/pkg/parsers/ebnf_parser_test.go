@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/lexers"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -209,3 +210,210 @@ func TestEBNFParserEmptyAsAlternative(t *testing.T) {
 	assertEBNFNodeType(t, expr.Children[0], EBNFParserNodeTypeEmpty)
 	assertEBNFNodeType(t, expr.Children[1], EBNFParserNodeTypeLiteral)
 }
+
+func TestEBNFParserPrecedenceDirectives(t *testing.T) {
+	parser := NewEBNFParser()
+	ast, err := parser.Parse(strings.NewReader(`
+%left plus "-" ;
+%right UMINUS ;
+E ::= E plus E | minus E %prec UMINUS -> { "parent": 0, "children": [1] } | int ;
+`))
+	assert.NoError(t, err)
+
+	root := ast.RootNode
+	assert.Len(t, root.Children, 3)
+
+	left := root.Children[0]
+	assertEBNFNodeType(t, left, EBNFParserNodeTypeDirective)
+	assert.Equal(t, EBNFDirectiveLeft, DirectiveName(left))
+	assert.Len(t, left.Children, 2)
+	assertEBNFNodeType(t, left.Children[0], EBNFParserNodeTypeIdentifier)
+	assertEBNFNodeType(t, left.Children[1], EBNFParserNodeTypeLiteral)
+
+	right := root.Children[1]
+	assert.Equal(t, EBNFDirectiveRight, DirectiveName(right))
+
+	rule := root.Children[2]
+	expr := rule.Children[1]
+	assertEBNFNodeType(t, expr, EBNFParserNodeTypeAlternates)
+	hinted := expr.Children[1]
+	assertEBNFNodeType(t, hinted, EBNFParserNodeTypeHintedSequence)
+	prec := hinted.Children[0]
+	assertEBNFNodeType(t, prec, EBNFParserNodeTypePrecSequence)
+	assert.Len(t, prec.Children, 2)
+	assertEBNFNodeType(t, prec.Children[0], EBNFParserNodeTypeSequence)
+	assert.Equal(t, "UMINUS", prec.Children[1].Token.LexemeText())
+}
+
+func TestEBNFParserUnknownDirective(t *testing.T) {
+	parser := NewEBNFParser()
+	_, err := parser.Parse(strings.NewReader("%bogus x ; A ::= \"a\" ;"))
+	assert.Error(t, err)
+}
+
+func TestEBNFParserPrecOutsideProduction(t *testing.T) {
+	parser := NewEBNFParser()
+	_, err := parser.Parse(strings.NewReader("%prec x ; A ::= \"a\" ;"))
+	assert.Error(t, err)
+}
+
+func TestEBNFParserDirectivesOnly(t *testing.T) {
+	parser := NewEBNFParser()
+	_, err := parser.Parse(strings.NewReader("%left x ;"))
+	assert.Error(t, err)
+}
+
+func TestEBNFParserRecordsDirective(t *testing.T) {
+	parser := NewEBNFParser()
+	ast, err := parser.Parse(strings.NewReader("%records ;\n%records newline ;\nA ::= \"a\" ;"))
+	assert.NoError(t, err)
+
+	root := ast.RootNode
+	assert.Len(t, root.Children, 3)
+	assert.Equal(t, EBNFDirectiveRecords, DirectiveName(root.Children[0]))
+	assert.Len(t, root.Children[0].Children, 0)
+	assert.Len(t, root.Children[1].Children, 1)
+	assertEBNFNodeType(t, root.Children[1].Children[0], EBNFParserNodeTypeIdentifier)
+}
+
+func TestEBNFParserDisplayDirective(t *testing.T) {
+	parser := NewEBNFParser()
+	ast, err := parser.Parse(strings.NewReader("%display plus \"'+'\" ;\nplus ::= \"+\" ;"))
+	assert.NoError(t, err)
+
+	root := ast.RootNode
+	assert.Len(t, root.Children, 2)
+	assert.Equal(t, EBNFDirectiveDisplay, DirectiveName(root.Children[0]))
+	assert.Len(t, root.Children[0].Children, 2)
+	assertEBNFNodeType(t, root.Children[0].Children[0], EBNFParserNodeTypeIdentifier)
+	assertEBNFNodeType(t, root.Children[0].Children[1], EBNFParserNodeTypeLiteral)
+}
+
+func TestEBNFParserModeDirectives(t *testing.T) {
+	parser := NewEBNFParser()
+	ast, err := parser.Parse(strings.NewReader(`
+%mode string text close ;
+%push string open ;
+%pop close ;
+%switch string other ;
+open ::= "\"" ; close ::= "\"" ; text ::= "a" ; other ::= "b" ;
+`))
+	assert.NoError(t, err)
+
+	root := ast.RootNode
+	assert.Len(t, root.Children, 8)
+	assert.Equal(t, EBNFDirectiveMode, DirectiveName(root.Children[0]))
+	assert.Len(t, root.Children[0].Children, 3)
+	assert.Equal(t, EBNFDirectivePush, DirectiveName(root.Children[1]))
+	assert.Equal(t, EBNFDirectivePop, DirectiveName(root.Children[2]))
+	assert.Len(t, root.Children[2].Children, 1)
+	assert.Equal(t, EBNFDirectiveSwitch, DirectiveName(root.Children[3]))
+}
+
+func TestEBNFParserLineStartAndTrailingContext(t *testing.T) {
+	parser := NewEBNFParser()
+	ast, err := parser.Parse(strings.NewReader(`int ::= d { d } / "." "." | ^ "#" d | d ;`))
+	assert.NoError(t, err)
+
+	expr := ast.RootNode.Children[0].Children[1]
+	assertEBNFNodeType(t, expr, EBNFParserNodeTypeAlternates)
+	trailing := expr.Children[0]
+	assertEBNFNodeType(t, trailing, EBNFParserNodeTypeTrailingContext)
+	assert.Len(t, trailing.Children, 2)
+	assertEBNFNodeType(t, trailing.Children[0], EBNFParserNodeTypeSequence)
+	assertEBNFNodeType(t, trailing.Children[1], EBNFParserNodeTypeSequence)
+	lineStart := expr.Children[1]
+	assertEBNFNodeType(t, lineStart, EBNFParserNodeTypeLineStart)
+	assertEBNFNodeType(t, lineStart.Children[0], EBNFParserNodeTypeSequence)
+	assertEBNFNodeType(t, expr.Children[2], EBNFParserNodeTypeIdentifier)
+
+	_, err = parser.Parse(strings.NewReader(`int ::= d / ;`))
+	assert.Error(t, err)
+}
+
+func TestEBNFParserDifferenceAndComplement(t *testing.T) {
+	parser := NewEBNFParser()
+	ast, err := parser.Parse(strings.NewReader(`c ::= . - ( "a" | "b" ) - "c" | "a"-"z" - "q" | ~ "x" | "x" - ~ d ;`))
+	assert.NoError(t, err)
+
+	expr := ast.RootNode.Children[0].Children[1]
+	assertEBNFNodeType(t, expr, EBNFParserNodeTypeAlternates)
+	outer := expr.Children[0]
+	assertEBNFNodeType(t, outer, EBNFParserNodeTypeDifference)
+	assertEBNFNodeType(t, outer.Children[0], EBNFParserNodeTypeDifference)
+	assertEBNFNodeType(t, outer.Children[0].Children[0], EBNFParserNodeTypeWildcard)
+	assertEBNFNodeType(t, outer.Children[0].Children[1], EBNFParserNodeTypeAlternates)
+	assertEBNFNodeType(t, outer.Children[1], EBNFParserNodeTypeLiteral)
+	assertEBNFNodeType(t, expr.Children[1], EBNFParserNodeTypeDifference)
+	assertEBNFNodeType(t, expr.Children[1].Children[0], EBNFParserNodeTypeRange)
+	assertEBNFNodeType(t, expr.Children[2], EBNFParserNodeTypeComplement)
+	assertEBNFNodeType(t, expr.Children[3], EBNFParserNodeTypeDifference)
+	assertEBNFNodeType(t, expr.Children[3].Children[0], EBNFParserNodeTypeLiteral)
+	assertEBNFNodeType(t, expr.Children[3].Children[1], EBNFParserNodeTypeComplement)
+
+	_, err = parser.Parse(strings.NewReader(`c ::= . - ;`))
+	assert.Error(t, err)
+	_, err = parser.Parse(strings.NewReader(`c ::= ~ ;`))
+	assert.Error(t, err)
+}
+
+func TestEBNFParserCaseless(t *testing.T) {
+	parser := NewEBNFParser()
+	ast, err := parser.Parse(strings.NewReader(`%caseless id ; end ::= "end"i ; id ::= "a"-"z" ;`))
+	assert.NoError(t, err)
+
+	root := ast.RootNode
+	assert.Equal(t, EBNFDirectiveCaseless, DirectiveName(root.Children[0]))
+	caseless := root.Children[1].Children[1]
+	assertEBNFNodeType(t, caseless, EBNFParserNodeTypeCaseless)
+	assert.Len(t, caseless.Children, 1)
+	assertEBNFNodeType(t, caseless.Children[0], EBNFParserNodeTypeLiteral)
+	assert.Equal(t, `"end"`, caseless.Children[0].Token.LexemeText())
+}
+
+func TestEBNFParserParseError(t *testing.T) {
+	parser := NewEBNFParserWithSourceName("test.bnf")
+	_, err := parser.Parse(strings.NewReader("A ::= \"a\" ;\nB \"b\" ;"))
+	var parseError *ParseError
+	if assert.ErrorAs(t, err, &parseError) {
+		assert.Equal(t, "test.bnf", parseError.SourceName)
+		assert.Equal(t, 2, parseError.Location.LineNumber)
+		assert.Equal(t, 3, parseError.Location.ColumnNumber)
+		assert.Equal(t, -1, parseError.State)
+		assert.Equal(t, `"b"`, string(parseError.Token.Lexeme))
+	}
+	assert.True(t, strings.HasPrefix(err.Error(), "test.bnf, line 2, column 3: "), err.Error())
+}
+
+func TestEBNFParserLexError(t *testing.T) {
+	parser := NewEBNFParser()
+	_, err := parser.Parse(strings.NewReader("A ::= \"a\" ;\nB ::= \"b ;"))
+	var lexError *lexers.LexError
+	if assert.ErrorAs(t, err, &lexError) {
+		assert.Equal(t, 2, lexError.Location.LineNumber)
+		assert.Equal(t, 7, lexError.Location.ColumnNumber)
+	}
+}
+
+func TestEBNFParserActions(t *testing.T) {
+	parser := NewEBNFParser()
+	ast, err := parser.Parse(strings.NewReader(`
+<< import "strconv" >>
+A ::= b c -> { "parent": 0 } << $1, nil >> | b << strconv.Atoi("1") >> ;
+`))
+	assert.NoError(t, err)
+
+	root := ast.RootNode
+	assert.Len(t, root.Children, 2)
+	assertEBNFNodeType(t, root.Children[0], EBNFParserNodeTypeAction)
+	assert.Equal(t, ` import "strconv" `, string(root.Children[0].Token.Lexeme))
+
+	alternates := root.Children[1].Children[1]
+	assertEBNFNodeType(t, alternates, EBNFParserNodeTypeAlternates)
+	for _, alternate := range alternates.Children {
+		assertEBNFNodeType(t, alternate, EBNFParserNodeTypeActionSequence)
+		assertEBNFNodeType(t, alternate.Children[1], EBNFParserNodeTypeAction)
+	}
+	assertEBNFNodeType(t, alternates.Children[0].Children[0], EBNFParserNodeTypeHintedSequence)
+	assert.Equal(t, " $1, nil ", string(alternates.Children[0].Children[1].Token.Lexeme))
+}
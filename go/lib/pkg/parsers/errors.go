@@ -0,0 +1,37 @@
+package parsers
+
+import (
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+// ParseError is a syntax error: a token which the parser could not accept where it was found.
+// Parsers return these, possibly wrapped or joined with others, so that callers can render
+// their own diagnostics using errors.As.
+type ParseError struct {
+	// SourceName names the input, such as a file name, or is empty.
+	SourceName string
+	Location   tokens.TokenLocation
+	// Token is the offending token, of type tokens.TokenTypeEOF at end of input.
+	Token *tokens.Token
+	// Expected names the terminals the parser could have accepted instead, where it knows them.
+	Expected []string
+	// State is the LR parser state at the error, or -1 for parsers without states.
+	State int
+	// Message describes the error, without its location.
+	Message string
+}
+
+// NewParseError returns a ParseError at token, for a parser without states.
+func NewParseError(token *tokens.Token, sourceName string, message string) *ParseError {
+	return &ParseError{
+		SourceName: sourceName,
+		Location:   token.Location,
+		Token:      token,
+		State:      -1,
+		Message:    message,
+	}
+}
+
+func (err *ParseError) Error() string {
+	return err.Location.Describe(err.SourceName) + ": " + err.Message
+}
@@ -0,0 +1,32 @@
+package parsers
+
+import (
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+// ValueHandler computes values of type T as an LR parser runs, in place of building AST nodes:
+// the parser keeps a stack of T, pushing the value of each shifted token and replacing the values
+// of each reduced production's right-hand side by the value of the production. The value of the
+// start symbol is the result of the parse. An error from either method stops parsing.
+type ValueHandler[T any] interface {
+	// Shift returns the value of a shifted terminal.
+	Shift(token *tokens.Token) (T, error)
+	// Reduce returns the value of a reduction by the production with the given index in the
+	// parser's tables and left-hand side lhs, from the values of its right-hand-side symbols.
+	Reduce(production int, lhs asts.NodeType, rhs []T) (T, error)
+}
+
+// ValueHandlerFuncs is a ValueHandler made of two functions.
+type ValueHandlerFuncs[T any] struct {
+	ShiftFunc  func(token *tokens.Token) (T, error)
+	ReduceFunc func(production int, lhs asts.NodeType, rhs []T) (T, error)
+}
+
+func (handler ValueHandlerFuncs[T]) Shift(token *tokens.Token) (T, error) {
+	return handler.ShiftFunc(token)
+}
+
+func (handler ValueHandlerFuncs[T]) Reduce(production int, lhs asts.NodeType, rhs []T) (T, error) {
+	return handler.ReduceFunc(production, lhs, rhs)
+}
@@ -0,0 +1,179 @@
+// Package repair finds repairs for syntax errors in LR parsing, in the style of Burke and Fisher.
+// At a syntax error, each single-token edit of the input at the error token is tried: inserting a
+// terminal before it, deleting it, or replacing it with another terminal. Each is checked by
+// parsing ahead over the next few tokens, without building anything, and the one letting the
+// parser get farthest is chosen.
+//
+// Grammar-specific tables are supplied through the Tables interface, which generated parsers
+// implement.
+package repair
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+)
+
+// CheckDistance is the number of tokens after the error token against which repairs are checked.
+const CheckDistance = 4
+
+// minProgress is the number of tokens, starting at the error token, which a repair must get the
+// parser past to be chosen, when there are that many before EOF.
+const minProgress = 3
+
+// errorTerminal is the grammar's error-recovery terminal, which is never inserted.
+const errorTerminal = tokens.TokenType("error")
+
+type ActionKind int
+
+const (
+	ActionShift ActionKind = iota
+	ActionReduce
+	ActionAccept
+)
+
+// Action is a shift to state Target, a reduce by production Target, or an accept.
+type Action struct {
+	Kind   ActionKind
+	Target int
+}
+
+// Tables is the grammar-specific part of the repair search.
+type Tables interface {
+	// Action returns the action for the state and lookahead terminal, if there is one.
+	Action(state int, lookahead tokens.TokenType) (Action, bool)
+	// Lookaheads returns the terminals having an action in the state.
+	Lookaheads(state int) []tokens.TokenType
+	// Goto returns the state reached from state on the nonterminal lhs.
+	Goto(state int, lhs asts.NodeType) (int, bool)
+	// Production returns the left-hand side and right-hand-side length of a production.
+	Production(prod int) (lhs asts.NodeType, rhsCount int)
+}
+
+type Kind int
+
+const (
+	Insert Kind = iota
+	Delete
+	Replace
+)
+
+// Repair is a single-token edit of the input at the token Original, where a syntax error was found.
+type Repair struct {
+	Kind Kind
+	// Original is the token at the error: the one deleted or replaced, or the one an insertion precedes.
+	Original *tokens.Token
+	// Token is the token inserted or substituted, at Original's location. Its lexeme is its type,
+	// which for a literal terminal is the literal text. It is nil for a deletion.
+	Token *tokens.Token
+}
+
+// String describes the repair and where it was made, naming terminals by token type.
+func (repair *Repair) String() string {
+	return fmt.Sprintf("%s at line %d column %d",
+		repair.Describe(func(tokenType tokens.TokenType) string { return string(tokenType) }),
+		repair.Original.Location.LineNumber, repair.Original.Location.ColumnNumber)
+}
+
+// Describe describes the repair, naming terminals with the given function.
+func (repair *Repair) Describe(name func(tokens.TokenType) string) string {
+	switch repair.Kind {
+	case Insert:
+		return fmt.Sprintf("inserted %s", name(repair.Token.Type))
+	case Delete:
+		return fmt.Sprintf("deleted %s %q", name(repair.Original.Type), string(repair.Original.Lexeme))
+	default:
+		return fmt.Sprintf("replaced %s %q with %s", name(repair.Original.Type), string(repair.Original.Lexeme), name(repair.Token.Type))
+	}
+}
+
+// Find returns the best repair for a syntax error, or nil if none gets the parser far enough.
+// stateStack is the parser's state stack at the error, and upcoming holds the error token
+// followed by up to CheckDistance more, ending early at EOF. The best repair is the one getting the
+// parser past the most of upcoming, accepting counting as getting past all of it; ties go to
+// insertions, then deletions, then replacements, each in order of terminal name.
+func Find(tables Tables, stateStack []int, upcoming []*tokens.Token) *Repair {
+	if len(upcoming) == 0 {
+		return nil
+	}
+	original := upcoming[0]
+	candidates := candidateTerminals(tables, stateStack[len(stateStack)-1])
+
+	var best *Repair
+	bestProgress := min(minProgress, len(upcoming)) - 1
+	try := func(repair *Repair, types []tokens.TokenType, skipped int) {
+		progress := skipped + parseAhead(tables, stateStack, types)
+		if progress > bestProgress {
+			best, bestProgress = repair, progress
+		}
+	}
+
+	rest := make([]tokens.TokenType, len(upcoming)-1)
+	for i, token := range upcoming[1:] {
+		rest[i] = token.Type
+	}
+	for _, terminal := range candidates {
+		// The inserted terminal itself is not counted toward progress.
+		types := append([]tokens.TokenType{terminal, original.Type}, rest...)
+		try(&Repair{Kind: Insert, Original: original, Token: newToken(terminal, original)}, types, -1)
+	}
+	if original.Type != tokens.TokenTypeEOF {
+		try(&Repair{Kind: Delete, Original: original}, rest, 1)
+		for _, terminal := range candidates {
+			if terminal == original.Type {
+				continue
+			}
+			types := append([]tokens.TokenType{terminal}, rest...)
+			try(&Repair{Kind: Replace, Original: original, Token: newToken(terminal, original)}, types, 0)
+		}
+	}
+	return best
+}
+
+// candidateTerminals returns the terminals which could be inserted or substituted in the state,
+// sorted by name.
+func candidateTerminals(tables Tables, state int) []tokens.TokenType {
+	candidates := make([]tokens.TokenType, 0)
+	for _, terminal := range tables.Lookaheads(state) {
+		if terminal != tokens.TokenTypeEOF && terminal != errorTerminal {
+			candidates = append(candidates, terminal)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+	return candidates
+}
+
+func newToken(terminal tokens.TokenType, original *tokens.Token) *tokens.Token {
+	return tokens.NewToken([]rune(terminal), terminal, &original.Location)
+}
+
+// parseAhead runs the tables on the terminals from a copy of the state stack, and returns how
+// many of them are shifted before a syntax error, or all of them if the parser accepts.
+func parseAhead(tables Tables, stateStack []int, types []tokens.TokenType) int {
+	stack := append([]int(nil), stateStack...)
+	shifted := 0
+	for shifted < len(types) {
+		action, ok := tables.Action(stack[len(stack)-1], types[shifted])
+		if !ok {
+			return shifted
+		}
+		switch action.Kind {
+		case ActionShift:
+			stack = append(stack, action.Target)
+			shifted++
+		case ActionReduce:
+			lhs, rhsCount := tables.Production(action.Target)
+			stack = stack[:len(stack)-rhsCount]
+			target, ok := tables.Goto(stack[len(stack)-1], lhs)
+			if !ok {
+				return shifted
+			}
+			stack = append(stack, target)
+		default:
+			return len(types)
+		}
+	}
+	return shifted
+}
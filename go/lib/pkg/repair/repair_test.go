@@ -0,0 +1,117 @@
+package repair
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/johnkerl/pgpg/go/lib/pkg/asts"
+	"github.com/johnkerl/pgpg/go/lib/pkg/tokens"
+	"github.com/stretchr/testify/assert"
+)
+
+// listTables are hand-built LR tables for the grammar
+//
+//	S' ::= L ;  L ::= L int semi | int semi ;
+type listTables struct{}
+
+var listActions = map[int]map[tokens.TokenType]Action{
+	0: {"int": {Kind: ActionShift, Target: 2}},
+	1: {"int": {Kind: ActionShift, Target: 3}, tokens.TokenTypeEOF: {Kind: ActionAccept}},
+	2: {"semi": {Kind: ActionShift, Target: 4}},
+	3: {"semi": {Kind: ActionShift, Target: 5}},
+	4: {"int": {Kind: ActionReduce, Target: 2}, tokens.TokenTypeEOF: {Kind: ActionReduce, Target: 2}},
+	5: {"int": {Kind: ActionReduce, Target: 1}, tokens.TokenTypeEOF: {Kind: ActionReduce, Target: 1}},
+}
+
+var listGotos = map[int]map[asts.NodeType]int{0: {"L": 1}}
+
+var listProductions = []struct {
+	lhs      asts.NodeType
+	rhsCount int
+}{{"S'", 1}, {"L", 3}, {"L", 2}}
+
+func (listTables) Action(state int, lookahead tokens.TokenType) (Action, bool) {
+	action, ok := listActions[state][lookahead]
+	return action, ok
+}
+
+func (listTables) Lookaheads(state int) []tokens.TokenType {
+	lookaheads := make([]tokens.TokenType, 0)
+	for terminal := range listActions[state] {
+		lookaheads = append(lookaheads, terminal)
+	}
+	return lookaheads
+}
+
+func (listTables) Goto(state int, lhs asts.NodeType) (int, bool) {
+	target, ok := listGotos[state][lhs]
+	return target, ok
+}
+
+func (listTables) Production(prod int) (asts.NodeType, int) {
+	return listProductions[prod].lhs, listProductions[prod].rhsCount
+}
+
+// findAt runs the tables on the space-separated token types, each at column = its index + 1, up to
+// the first syntax error, and returns the repair found there.
+func findAt(t *testing.T, input string) *Repair {
+	t.Helper()
+	upcoming := make([]*tokens.Token, 0)
+	for i, field := range strings.Fields(input) {
+		upcoming = append(upcoming, tokens.NewToken([]rune(field), tokens.TokenType(field), tokens.NewNonDefaultTokenLocation(1, i+1)))
+	}
+	upcoming = append(upcoming, tokens.NewEOFToken(tokens.NewNonDefaultTokenLocation(1, len(upcoming)+1)))
+
+	tables := listTables{}
+	stack := []int{0}
+	for {
+		action, ok := tables.Action(stack[len(stack)-1], upcoming[0].Type)
+		if !ok {
+			return Find(tables, stack, upcoming[:min(len(upcoming), CheckDistance+1)])
+		}
+		switch action.Kind {
+		case ActionShift:
+			stack = append(stack, action.Target)
+			upcoming = upcoming[1:]
+		case ActionReduce:
+			lhs, rhsCount := tables.Production(action.Target)
+			stack = stack[:len(stack)-rhsCount]
+			target, _ := tables.Goto(stack[len(stack)-1], lhs)
+			stack = append(stack, target)
+		default:
+			t.Fatalf("%q: no syntax error", input)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	for input, expected := range map[string]string{
+		"int semi int int semi": "inserted semi at line 1 column 4",
+		"int semi int":          "inserted semi at line 1 column 4",
+		"int plus semi":         `deleted plus "plus" at line 1 column 2`,
+		"int plus int semi":     `replaced plus "plus" with semi at line 1 column 2`,
+	} {
+		repair := findAt(t, input)
+		if assert.NotNil(t, repair, input) {
+			assert.Equal(t, expected, repair.String(), input)
+		}
+	}
+}
+
+func TestFindInsertedToken(t *testing.T) {
+	repair := findAt(t, "int semi int int semi")
+	assert.Equal(t, Insert, repair.Kind)
+	assert.Equal(t, "int", string(repair.Original.Type))
+	assert.Equal(t, "semi", string(repair.Token.Type))
+	assert.Equal(t, "semi", string(repair.Token.Lexeme))
+	assert.Equal(t, 4, repair.Token.Location.ColumnNumber)
+	assert.Equal(t, "inserted ';'", repair.Describe(func(tokenType tokens.TokenType) string {
+		return map[tokens.TokenType]string{"semi": "';'"}[tokenType]
+	}))
+}
+
+func TestFindNone(t *testing.T) {
+	// Each single-token edit fails again within the next few tokens.
+	assert.Nil(t, findAt(t, "semi semi semi semi"))
+	assert.Nil(t, Find(listTables{}, []int{0}, nil))
+}
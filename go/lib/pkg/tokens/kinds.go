@@ -0,0 +1,32 @@
+package tokens
+
+// Kinds numbers a parser's terminals, so that its tables can be indexed by a token's integer Kind
+// rather than by its string Type. IDs start from 1; Kind 0 is for tokens whose kind is unset.
+// Lexers which support it are handed a parser's Kinds, and set each token's Kind from them.
+type Kinds struct {
+	// Types lists the terminals by ID, with Types[0] empty.
+	Types []TokenType
+	// IDs maps each terminal to its ID.
+	IDs map[TokenType]int
+}
+
+// NewKinds numbers the given token types from 1, in order.
+func NewKinds(types ...TokenType) *Kinds {
+	kinds := &Kinds{
+		Types: append([]TokenType{""}, types...),
+		IDs:   make(map[TokenType]int, len(types)),
+	}
+	for i, tokenType := range types {
+		kinds.IDs[tokenType] = i + 1
+	}
+	return kinds
+}
+
+// Of returns the token's kind: its Kind if set, else the ID of its type, or 0 if its type is not
+// one of the terminals.
+func (kinds *Kinds) Of(token *Token) int {
+	if token.Kind != 0 {
+		return token.Kind
+	}
+	return kinds.IDs[token.Type]
+}
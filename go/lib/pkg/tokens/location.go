@@ -1,5 +1,9 @@
 package tokens
 
+import (
+	"fmt"
+)
+
 // NewTokenLocation is the normal use-case for a lexer starting at the beginning of input text.
 func NewTokenLocation() *TokenLocation {
 	return &TokenLocation{
@@ -29,3 +33,12 @@ func (loc *TokenLocation) LocateRune(r rune, runeWidth int) {
 	}
 	loc.ByteOffset += runeWidth
 }
+
+// Describe returns the location for error messages, as "line 3, column 9", or as
+// "input.txt, line 3, column 9" if sourceName is non-empty.
+func (loc *TokenLocation) Describe(sourceName string) string {
+	if sourceName != "" {
+		return fmt.Sprintf("%s, line %d, column %d", sourceName, loc.LineNumber, loc.ColumnNumber)
+	}
+	return fmt.Sprintf("line %d, column %d", loc.LineNumber, loc.ColumnNumber)
+}
@@ -55,3 +55,25 @@ func TestIsEOF_IsError(t *testing.T) {
 		t.Error("Normal token: IsEOF and IsError should be false")
 	}
 }
+
+func TestKinds(t *testing.T) {
+	kinds := NewKinds("int", TokenTypeEOF)
+	if len(kinds.Types) != 3 || kinds.Types[0] != "" || kinds.Types[2] != TokenTypeEOF {
+		t.Errorf("NewKinds: Types = %v", kinds.Types)
+	}
+	loc := NewTokenLocation()
+	if kind := kinds.Of(NewToken([]rune("1"), "int", loc)); kind != 1 {
+		t.Errorf("Of(int) = %d, want 1", kind)
+	}
+	if kind := kinds.Of(NewEOFToken(loc)); kind != 2 {
+		t.Errorf("Of(EOF) = %d, want 2", kind)
+	}
+	if kind := kinds.Of(NewToken([]rune("x"), "id", loc)); kind != 0 {
+		t.Errorf("Of(id) = %d, want 0", kind)
+	}
+	tok := NewToken([]rune("1"), "int", loc)
+	tok.Kind = 7
+	if kind := kinds.Of(tok); kind != 7 {
+		t.Errorf("Of(token with Kind set) = %d, want 7", kind)
+	}
+}
@@ -23,4 +23,7 @@ type Token struct {
 	Lexeme   []rune
 	Type     TokenType
 	Location TokenLocation
+	// Kind is the ID of Type among the terminals of the parser reading the token, if the lexer
+	// sets it; see Kinds. Zero means unset, leaving the parser to look Type up.
+	Kind int
 }